@@ -0,0 +1,159 @@
+// Command sendly-eventgen generates typed webhook payload structs and handler
+// stubs from the Sendly event schema registry.
+//
+// Usage with go generate:
+//
+//	//go:generate go run github.com/SendlyHQ/sendly-go/v3/cmd/sendly-eventgen -events message.delivered,verify.completed -api-version 2024-01-01 -package handlers -o sendly_events_gen.go
+//
+// Schemas are fetched with the API key in SENDLY_API_KEY. Pass -schemas to
+// read previously downloaded schema files (<event type>.json) instead.
+//
+// With -catalog, it instead generates the sendly package's Event* constants
+// from the event catalog file.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/SendlyHQ/sendly-go/v3/internal/eventgen"
+	"github.com/SendlyHQ/sendly-go/v3/sendly"
+)
+
+func main() {
+	var (
+		events     = flag.String("events", "", "comma-separated event types to generate (default: all)")
+		apiVersion = flag.String("api-version", "", "event API version (default: account version)")
+		pkg        = flag.String("package", os.Getenv("GOPACKAGE"), "Go package name of the generated file")
+		output     = flag.String("o", "sendly_events_gen.go", "output file")
+		schemaDir  = flag.String("schemas", "", "read schemas from this directory instead of the API")
+		baseURL    = flag.String("base-url", sendly.DefaultBaseURL, "Sendly API base URL")
+		catalog    = flag.String("catalog", "", "generate event type constants from this catalog file")
+	)
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("sendly-eventgen: ")
+
+	if *pkg == "" {
+		log.Fatal("-package is required outside of go generate")
+	}
+
+	if *catalog != "" {
+		if err := generateCatalog(*catalog, *pkg, *output); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	var types []string
+	if *events != "" {
+		types = strings.Split(*events, ",")
+	}
+
+	var (
+		loaded []eventgen.Event
+		err    error
+	)
+	if *schemaDir != "" {
+		loaded, err = loadFromDir(*schemaDir, types, *apiVersion)
+	} else {
+		loaded, err = loadFromAPI(*baseURL, types, *apiVersion)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := eventgen.Generate(*pkg, loaded)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadFromAPI fetches schemas from the registry. With no types, every event
+// type available for apiVersion is loaded.
+func loadFromAPI(baseURL string, types []string, apiVersion string) ([]eventgen.Event, error) {
+	apiKey := os.Getenv("SENDLY_API_KEY")
+	if apiKey == "" {
+		return nil, fmt.Errorf("SENDLY_API_KEY is not set")
+	}
+	client := sendly.NewClient(apiKey, sendly.WithBaseURL(baseURL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if len(types) == 0 {
+		summaries, err := client.Events.ListSchemas(ctx, apiVersion)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range summaries {
+			types = append(types, string(s.Type))
+		}
+	}
+
+	var events []eventgen.Event
+	for _, t := range types {
+		schema, err := client.Events.Schema(ctx, sendly.WebhookEventType(strings.TrimSpace(t)), apiVersion)
+		if err != nil {
+			return nil, fmt.Errorf("fetching schema for %s: %w", t, err)
+		}
+		events = append(events, eventgen.Event{
+			Type:       string(schema.Type),
+			APIVersion: schema.APIVersion,
+			Schema:     schema.Schema,
+		})
+	}
+	return events, nil
+}
+
+// loadFromDir reads <event type>.json schema files from dir.
+func loadFromDir(dir string, types []string, apiVersion string) ([]eventgen.Event, error) {
+	if len(types) == 0 {
+		matches, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range matches {
+			types = append(types, strings.TrimSuffix(filepath.Base(m), ".json"))
+		}
+	}
+
+	var events []eventgen.Event
+	for _, t := range types {
+		t = strings.TrimSpace(t)
+		data, err := os.ReadFile(filepath.Join(dir, t+".json"))
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, eventgen.Event{Type: t, APIVersion: apiVersion, Schema: data})
+	}
+	return events, nil
+}
+
+// generateCatalog writes the event type constants for the catalog file at
+// path, a JSON array of {"type", "description"} objects.
+func generateCatalog(path, pkg, output string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var entries []eventgen.CatalogEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+	src, err := eventgen.GenerateCatalog(pkg, entries)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(output, src, 0o644)
+}
@@ -0,0 +1,248 @@
+// Package eventgen generates typed Go payload structs and handler stubs from
+// Sendly event JSON Schemas.
+package eventgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Schema is the subset of JSON Schema used by the Sendly event registry.
+type Schema struct {
+	Type        interface{}        `json:"type"`
+	Description string             `json:"description,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+	Enum        []interface{}      `json:"enum,omitempty"`
+}
+
+// Event is one event type to generate code for.
+type Event struct {
+	// Type is the event type, e.g. "message.delivered".
+	Type string
+	// APIVersion is the schema's API version.
+	APIVersion string
+	// Schema is the JSON Schema of the full event envelope.
+	Schema json.RawMessage
+}
+
+// Generate returns gofmt-formatted Go source declaring a payload struct per
+// event, an EventHandlers struct with one typed callback per event, and a
+// Handle method that dispatches a *sendly.Event to the matching callback.
+func Generate(pkg string, events []Event) ([]byte, error) {
+	sort.Slice(events, func(i, j int) bool { return events[i].Type < events[j].Type })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by sendly-eventgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&buf, "import (\n\t\"context\"\n\t\"encoding/json\"\n\n\t\"github.com/SendlyHQ/sendly-go/v3/sendly\"\n)\n\n")
+
+	for _, e := range events {
+		var envelope Schema
+		if err := json.Unmarshal(e.Schema, &envelope); err != nil {
+			return nil, fmt.Errorf("eventgen: %s: invalid schema: %w", e.Type, err)
+		}
+		data := envelope.Properties["data"]
+		if data == nil {
+			return nil, fmt.Errorf("eventgen: %s: schema has no data property", e.Type)
+		}
+
+		name := typeName(e.Type) + "Data"
+		fmt.Fprintf(&buf, "// %s is the data payload of %s events (API version %s).\n", name, e.Type, e.APIVersion)
+		fmt.Fprintf(&buf, "type %s struct {\n", name)
+		if err := writeFields(&buf, data); err != nil {
+			return nil, fmt.Errorf("eventgen: %s: %w", e.Type, err)
+		}
+		fmt.Fprintf(&buf, "}\n\n")
+	}
+
+	fmt.Fprintf(&buf, "// EventHandlers dispatches events to typed callbacks. Nil callbacks are skipped.\n")
+	fmt.Fprintf(&buf, "type EventHandlers struct {\n")
+	for _, e := range events {
+		name := typeName(e.Type)
+		fmt.Fprintf(&buf, "\t// On%s handles %s events.\n", name, e.Type)
+		fmt.Fprintf(&buf, "\tOn%s func(ctx context.Context, event *sendly.Event, data *%sData) error\n", name, name)
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// Handle decodes event data and calls the matching callback.\n")
+	fmt.Fprintf(&buf, "func (h *EventHandlers) Handle(ctx context.Context, event *sendly.Event) error {\n")
+	fmt.Fprintf(&buf, "\tswitch event.Type {\n")
+	for _, e := range events {
+		name := typeName(e.Type)
+		fmt.Fprintf(&buf, "\tcase %q:\n", e.Type)
+		fmt.Fprintf(&buf, "\t\tif h.On%s == nil {\n\t\t\treturn nil\n\t\t}\n", name)
+		fmt.Fprintf(&buf, "\t\tvar data %sData\n", name)
+		fmt.Fprintf(&buf, "\t\tif err := json.Unmarshal(event.Data, &data); err != nil {\n\t\t\treturn err\n\t\t}\n")
+		fmt.Fprintf(&buf, "\t\treturn h.On%s(ctx, event, &data)\n", name)
+	}
+	fmt.Fprintf(&buf, "\t}\n\treturn nil\n}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("eventgen: formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+// CatalogEntry is one event type in the Sendly event catalog.
+type CatalogEntry struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+// GenerateCatalog returns gofmt-formatted Go source for the sendly package
+// declaring an Event* WebhookEventType constant per catalog entry and an
+// eventCatalog slice listing them.
+func GenerateCatalog(pkg string, entries []CatalogEntry) ([]byte, error) {
+	entries = append([]CatalogEntry(nil), entries...)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Type < entries[j].Type })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by sendly-eventgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+
+	fmt.Fprintf(&buf, "// Event types in the Sendly event catalog.\n")
+	fmt.Fprintf(&buf, "const (\n")
+	for i, e := range entries {
+		if e.Type == "" || strings.Contains(e.Type, "*") {
+			return nil, fmt.Errorf("eventgen: invalid catalog event type %q", e.Type)
+		}
+		if i > 0 && entries[i-1].Type == e.Type {
+			return nil, fmt.Errorf("eventgen: duplicate catalog event type %q", e.Type)
+		}
+		if e.Description != "" {
+			writeComment(&buf, "\t", "Event"+typeName(e.Type)+" "+e.Description)
+		}
+		fmt.Fprintf(&buf, "\tEvent%s WebhookEventType = %q\n", typeName(e.Type), e.Type)
+	}
+	fmt.Fprintf(&buf, ")\n\n")
+
+	fmt.Fprintf(&buf, "// eventCatalog lists every catalog event type, sorted.\n")
+	fmt.Fprintf(&buf, "var eventCatalog = []WebhookEventType{\n")
+	for _, e := range entries {
+		fmt.Fprintf(&buf, "\tEvent%s,\n", typeName(e.Type))
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("eventgen: formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+// writeFields writes one struct field per property of an object schema.
+func writeFields(buf *bytes.Buffer, s *Schema) error {
+	required := make(map[string]bool, len(s.Required))
+	for _, r := range s.Required {
+		required[r] = true
+	}
+
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		prop := s.Properties[name]
+		typ, err := goType(prop)
+		if err != nil {
+			return fmt.Errorf("property %s: %w", name, err)
+		}
+		if prop.Description != "" {
+			writeComment(buf, "\t", prop.Description)
+		}
+		tag := name
+		if !required[name] {
+			tag += ",omitempty"
+		}
+		fmt.Fprintf(buf, "\t%s %s `json:%q`\n", typeName(name), typ, tag)
+	}
+	return nil
+}
+
+// writeComment writes text as a line comment, prefixing every line with
+// "//" so multi-line descriptions stay valid Go.
+func writeComment(buf *bytes.Buffer, indent, text string) {
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			fmt.Fprintf(buf, "%s//\n", indent)
+		} else {
+			fmt.Fprintf(buf, "%s// %s\n", indent, line)
+		}
+	}
+}
+
+// goType maps a JSON Schema type to a Go type. A nil schema, such as a
+// property declared as null, is an error.
+func goType(s *Schema) (string, error) {
+	if s == nil {
+		return "", errors.New("missing schema")
+	}
+	switch schemaType(s) {
+	case "string":
+		return "string", nil
+	case "integer":
+		return "int64", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		if s.Items == nil {
+			return "[]interface{}", nil
+		}
+		item, err := goType(s.Items)
+		if err != nil {
+			return "", fmt.Errorf("items: %w", err)
+		}
+		return "[]" + item, nil
+	case "object":
+		return "map[string]interface{}", nil
+	default:
+		return "interface{}", nil
+	}
+}
+
+// schemaType returns the non-null type of a schema. Nullable types such as
+// ["string", "null"] map to their non-null member.
+func schemaType(s *Schema) string {
+	switch t := s.Type.(type) {
+	case string:
+		return t
+	case []interface{}:
+		for _, v := range t {
+			if str, ok := v.(string); ok && str != "null" {
+				return str
+			}
+		}
+	}
+	return ""
+}
+
+// typeName converts identifiers such as "message.delivered" or "message_id"
+// to exported Go names ("MessageDelivered", "MessageID").
+func typeName(s string) string {
+	parts := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var b strings.Builder
+	for _, p := range parts {
+		switch upper := strings.ToUpper(p); upper {
+		case "ID", "URL", "API", "HTTP", "SMS", "OTP":
+			b.WriteString(upper)
+		default:
+			b.WriteString(strings.ToUpper(p[:1]) + p[1:])
+		}
+	}
+	return b.String()
+}
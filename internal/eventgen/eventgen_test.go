@@ -0,0 +1,114 @@
+package eventgen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"id": {"type": "string"},
+			"data": {
+				"type": "object",
+				"required": ["message_id", "status"],
+				"properties": {
+					"message_id": {"type": "string", "description": "The message ID."},
+					"status": {"type": "string"},
+					"segments": {"type": "integer"},
+					"delivered_at": {"type": ["string", "null"]},
+					"tags": {"type": "array", "items": {"type": "string"}}
+				}
+			}
+		}
+	}`
+
+	src, err := Generate("handlers", []Event{{Type: "message.delivered", APIVersion: "2024-01-01", Schema: []byte(schema)}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := strings.Join(strings.Fields(string(src)), " ")
+	for _, want := range []string{
+		"package handlers",
+		"type MessageDeliveredData struct",
+		"MessageID string `json:\"message_id\"`",
+		"Segments int64 `json:\"segments,omitempty\"`",
+		"DeliveredAt string `json:\"delivered_at,omitempty\"`",
+		"Tags []string `json:\"tags,omitempty\"`",
+		"OnMessageDelivered func(ctx context.Context, event *sendly.Event, data *MessageDeliveredData) error",
+		`case "message.delivered":`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("generated code missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestGenerate_MissingData(t *testing.T) {
+	_, err := Generate("handlers", []Event{{Type: "x.y", Schema: []byte(`{"type":"object"}`)}})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestGenerate_NilSchema(t *testing.T) {
+	schema := `{"type":"object","properties":{"data":{"type":"object","properties":{"status":null}}}}`
+	_, err := Generate("handlers", []Event{{Type: "x.y", Schema: []byte(schema)}})
+	if err == nil || !strings.Contains(err.Error(), "status") {
+		t.Fatalf("expected error naming the property, got %v", err)
+	}
+}
+
+func TestGenerate_MultiLineDescription(t *testing.T) {
+	schema := `{"type":"object","properties":{"data":{"type":"object","properties":{
+		"status":{"type":"string","description":"The delivery status.\n\nOne of delivered or failed."}
+	}}}}`
+	src, err := Generate("handlers", []Event{{Type: "message.delivered", Schema: []byte(schema)}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(src), "\t// The delivery status.\n\t//\n\t// One of delivered or failed.\n") {
+		t.Errorf("expected every description line to be commented:\n%s", src)
+	}
+
+	src, err = GenerateCatalog("sendly", []CatalogEntry{{Type: "message.delivered", Description: "is sent when a message\nis delivered."}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(src), "// EventMessageDelivered is sent when a message\n\t// is delivered.\n") {
+		t.Errorf("expected every description line to be commented:\n%s", src)
+	}
+}
+
+func TestGenerateCatalog(t *testing.T) {
+	src, err := GenerateCatalog("sendly", []CatalogEntry{
+		{Type: "verify.session.completed", Description: "is sent when a session is completed."},
+		{Type: "message.delivered"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := strings.Join(strings.Fields(string(src)), " ")
+	for _, want := range []string{
+		"package sendly",
+		"// EventVerifySessionCompleted is sent when a session is completed.",
+		`EventVerifySessionCompleted WebhookEventType = "verify.session.completed"`,
+		"var eventCatalog = []WebhookEventType{ EventMessageDelivered, EventVerifySessionCompleted, }",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("generated code missing %q:\n%s", want, out)
+		}
+	}
+
+	for _, entries := range [][]CatalogEntry{
+		{{Type: "message.*"}},
+		{{Type: "message.sent"}, {Type: "message.sent"}},
+	} {
+		if _, err := GenerateCatalog("sendly", entries); err == nil {
+			t.Errorf("expected error for %v", entries)
+		}
+	}
+}
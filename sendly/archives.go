@@ -0,0 +1,211 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// ArchivesService retrieves records that are older than the hot retention
+// window from cold storage, for audits and investigations.
+type ArchivesService struct {
+	client *Client
+}
+
+// ArchiveRetrievalStatus represents the state of an archive retrieval job.
+type ArchiveRetrievalStatus string
+
+const (
+	ArchiveRetrievalPending ArchiveRetrievalStatus = "pending"
+	// ArchiveRetrievalRestoring means records are being restored from cold
+	// storage, which can take several hours.
+	ArchiveRetrievalRestoring ArchiveRetrievalStatus = "restoring"
+	ArchiveRetrievalCompleted ArchiveRetrievalStatus = "completed"
+	ArchiveRetrievalFailed    ArchiveRetrievalStatus = "failed"
+	ArchiveRetrievalCancelled ArchiveRetrievalStatus = "cancelled"
+)
+
+// IsTerminal reports whether the retrieval job has finished.
+func (s ArchiveRetrievalStatus) IsTerminal() bool {
+	switch s {
+	case ArchiveRetrievalCompleted, ArchiveRetrievalFailed, ArchiveRetrievalCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// CreateArchiveRetrievalRequest represents the parameters for retrieving
+// archived records.
+type CreateArchiveRetrievalRequest struct {
+	// Resource is ExportMessages or ExportWebhookDeliveries.
+	Resource ExportResource `json:"resource"`
+	// Start and End bound the retrieval by creation time (RFC 3339 or
+	// YYYY-MM-DD). Both are required; narrower ranges restore faster and
+	// cost less.
+	Start string `json:"start"`
+	End   string `json:"end"`
+	// Format defaults to ExportJSONL.
+	Format ExportFormat `json:"format,omitempty"`
+	// WebhookID limits a webhook delivery retrieval to one webhook.
+	WebhookID string `json:"webhook_id,omitempty"`
+	// Destination writes the file straight to a customer-owned bucket. If
+	// nil, the file is hosted by Sendly and fetched from
+	// ArchiveRetrieval.DownloadURL.
+	Destination *StorageDestination `json:"destination,omitempty"`
+}
+
+// ArchiveRetrieval is an asynchronous job that restores archived records
+// from cold storage and writes them to a file.
+type ArchiveRetrieval struct {
+	ID          string                 `json:"id"`
+	Resource    ExportResource         `json:"resource"`
+	Format      ExportFormat           `json:"format"`
+	Status      ArchiveRetrievalStatus `json:"status"`
+	Start       string                 `json:"start"`
+	End         string                 `json:"end"`
+	WebhookID   string                 `json:"webhook_id,omitempty"`
+	Destination *ExportDestination     `json:"destination,omitempty"`
+	// EstimatedReadyAt is when the restore is expected to finish.
+	EstimatedReadyAt string `json:"estimated_ready_at,omitempty"`
+	RowCount         int64  `json:"row_count"`
+	SizeBytes        int64  `json:"size_bytes"`
+	// DownloadURL is a pre-signed URL for files hosted by Sendly, valid
+	// until DownloadURLExpiresAt. It is empty for bucket destinations.
+	DownloadURL          string `json:"download_url,omitempty"`
+	DownloadURLExpiresAt string `json:"download_url_expires_at,omitempty"`
+	Error                string `json:"error,omitempty"`
+	CreatedAt            string `json:"created_at"`
+	CompletedAt          string `json:"completed_at,omitempty"`
+}
+
+// ArchiveRetrievalListOptions are options for listing archive retrievals.
+type ArchiveRetrievalListOptions struct {
+	// Limit is the maximum number of retrievals per page (default: 50, max: 200).
+	Limit int
+	// Cursor continues from a previous page's NextCursor.
+	Cursor string
+	// Status filters by job status.
+	Status ArchiveRetrievalStatus
+}
+
+// ArchiveRetrievalListResponse is a page of archive retrievals.
+type ArchiveRetrievalListResponse struct {
+	Data       []ArchiveRetrieval `json:"data"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+	HasMore    bool               `json:"has_more"`
+}
+
+// CreateRetrieval starts restoring archived records from cold storage.
+// Poll GetRetrieval until Status.IsTerminal(); restores usually take a few
+// hours.
+//
+// Example:
+//
+//	job, err := client.Archives.CreateRetrieval(ctx, &sendly.CreateArchiveRetrievalRequest{
+//	    Resource:  sendly.ExportWebhookDeliveries,
+//	    Start:     "2023-03-01",
+//	    End:       "2023-04-01",
+//	    WebhookID: "whk_xxx",
+//	})
+//	fmt.Println("ready around", job.EstimatedReadyAt)
+func (s *ArchivesService) CreateRetrieval(ctx context.Context, req *CreateArchiveRetrievalRequest) (*ArchiveRetrieval, error) {
+	if req == nil {
+		return nil, invalidParamError("resource", "resource is required")
+	}
+	switch req.Resource {
+	case ExportMessages, ExportWebhookDeliveries:
+	default:
+		return nil, invalidParamError("resource", "resource must be messages or webhook_deliveries")
+	}
+	if req.Start == "" || req.End == "" {
+		return nil, invalidParamError("start", "start and end are required")
+	}
+	switch req.Format {
+	case "", ExportCSV, ExportJSONL:
+	default:
+		return nil, invalidParamError("format", "format must be csv or jsonl")
+	}
+	if req.WebhookID != "" && req.Resource != ExportWebhookDeliveries {
+		return nil, invalidParamError("webhook_id", "webhook_id requires resource webhook_deliveries")
+	}
+	if req.Destination != nil {
+		if err := req.Destination.validate("destination"); err != nil {
+			return nil, err
+		}
+	}
+
+	var resp ArchiveRetrieval
+	if err := s.client.request(ctx, "POST", "/archives/retrievals", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetRetrieval retrieves an archive retrieval job by ID.
+func (s *ArchivesService) GetRetrieval(ctx context.Context, id string) (*ArchiveRetrieval, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "retrieval ID is required")
+	}
+
+	var resp ArchiveRetrieval
+	if err := s.client.request(ctx, "GET", "/archives/retrievals/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListRetrievals retrieves a page of archive retrieval jobs, newest first.
+func (s *ArchivesService) ListRetrievals(ctx context.Context, opts *ArchiveRetrievalListOptions) (*ArchiveRetrievalListResponse, error) {
+	params := make(map[string]string)
+	if opts != nil {
+		if opts.Limit > 0 {
+			params["limit"] = strconv.Itoa(opts.Limit)
+		}
+		params["cursor"] = opts.Cursor
+		params["status"] = string(opts.Status)
+	}
+
+	var resp ArchiveRetrievalListResponse
+	if err := s.client.request(ctx, "GET", "/archives/retrievals"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAllRetrievals returns every archive retrieval job matching opts as an
+// Iter, fetching pages as needed.
+func (s *ArchivesService) ListAllRetrievals(ctx context.Context, opts *ArchiveRetrievalListOptions) Iter[ArchiveRetrieval] {
+	var o ArchiveRetrievalListOptions
+	if opts != nil {
+		o = *opts
+	}
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[ArchiveRetrieval], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.ListRetrievals(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[ArchiveRetrieval]{Items: resp.Data}
+		if resp.HasMore {
+			page.NextCursor = resp.NextCursor
+		}
+		return page, nil
+	}).Iter()
+}
+
+// CancelRetrieval cancels a retrieval job that has not finished. Restore
+// work already done is still billed.
+func (s *ArchivesService) CancelRetrieval(ctx context.Context, id string) (*ArchiveRetrieval, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "retrieval ID is required")
+	}
+
+	var resp ArchiveRetrieval
+	if err := s.client.request(ctx, "POST", "/archives/retrievals/"+url.PathEscape(id)+"/cancel", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
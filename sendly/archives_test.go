@@ -0,0 +1,58 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestArchivesService_CreateRetrieval(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/archives/retrievals" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var req CreateArchiveRetrievalRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Resource != ExportWebhookDeliveries || req.WebhookID != "whk_1" || req.Start != "2023-03-01" {
+			t.Errorf("unexpected request body: %+v", req)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"arc_1","resource":"webhook_deliveries","format":"jsonl","status":"restoring","start":"2023-03-01","end":"2023-04-01","estimated_ready_at":"2025-01-15T14:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	job, err := client.Archives.CreateRetrieval(context.Background(), &CreateArchiveRetrievalRequest{
+		Resource:  ExportWebhookDeliveries,
+		Start:     "2023-03-01",
+		End:       "2023-04-01",
+		WebhookID: "whk_1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.Status != ArchiveRetrievalRestoring || job.Status.IsTerminal() || job.EstimatedReadyAt == "" {
+		t.Errorf("unexpected retrieval: %+v", job)
+	}
+}
+
+func TestArchivesService_Validation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	tests := []*CreateArchiveRetrievalRequest{
+		nil,
+		{Resource: ExportContacts, Start: "2023-01-01", End: "2023-02-01"},
+		{Resource: ExportMessages, Start: "2023-01-01"},
+		{Resource: ExportMessages, Start: "2023-01-01", End: "2023-02-01", Format: "xml"},
+		{Resource: ExportMessages, Start: "2023-01-01", End: "2023-02-01", WebhookID: "whk_1"},
+		{Resource: ExportMessages, Start: "2023-01-01", End: "2023-02-01", Destination: &StorageDestination{Provider: "azure"}},
+	}
+	for i, req := range tests {
+		if _, err := client.Archives.CreateRetrieval(ctx, req); !IsValidationError(err) {
+			t.Errorf("case %d: expected validation error, got %v", i, err)
+		}
+	}
+}
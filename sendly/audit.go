@@ -0,0 +1,149 @@
+package sendly
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// AuditRecord describes one HTTP attempt made by the client. Bodies are
+// never recorded, only their size and a hash of the body with sensitive
+// values redacted.
+type AuditRecord struct {
+	Time   time.Time
+	Method string
+	// Path is the request path without the query string.
+	Path string
+	// Actor is the value attached with WithAuditActor, or "" if none.
+	Actor string
+	// APIKey identifies the key used by its prefix and last four
+	// characters, e.g. "sk_live_...wxyz".
+	APIKey         string
+	IdempotencyKey string
+	// BodySize is the size of the request body in bytes.
+	BodySize int
+	// BodySHA256 is the hex SHA-256 of the request body after redacting the
+	// same keys as logging, so equal requests hash equally without the
+	// hash revealing OTP codes or credentials. It is empty for requests
+	// without a body.
+	BodySHA256 string
+	// Attempt is the attempt number within the call, starting at 1.
+	Attempt int
+	// StatusCode is the HTTP status, or 0 if no response was received.
+	StatusCode int
+	RequestID  string
+	// Error is the transport error, if any.
+	Error string
+}
+
+// AuditSink receives an AuditRecord for every HTTP attempt. Record is
+// called synchronously after each attempt, so sinks that write to slow
+// storage should buffer. Implementations must be safe for concurrent use.
+type AuditSink interface {
+	Record(ctx context.Context, rec AuditRecord) error
+}
+
+// AuditSinkFunc adapts a function to an AuditSink.
+type AuditSinkFunc func(ctx context.Context, rec AuditRecord) error
+
+// Record calls f(ctx, rec).
+func (f AuditSinkFunc) Record(ctx context.Context, rec AuditRecord) error {
+	return f(ctx, rec)
+}
+
+// WithAudit records every API call the client makes, including retries, to
+// sink. A failing sink never fails the API call, since the call has already
+// been made; its error is logged at slog.LevelError instead.
+//
+// Example:
+//
+//	client := sendly.NewClient(apiKey, sendly.WithAudit(sendly.AuditSinkFunc(
+//	    func(ctx context.Context, rec sendly.AuditRecord) error {
+//	        return auditLog.Append(ctx, rec)
+//	    },
+//	)))
+//	ctx = sendly.WithAuditActor(ctx, "user:"+userID)
+func WithAudit(sink AuditSink) ClientOption {
+	return func(c *Client) {
+		c.AuditSink = sink
+	}
+}
+
+type auditActorKey struct{}
+
+// WithAuditActor returns a context that attributes API calls made with it
+// to actor in audit records, such as the user or job that caused them.
+func WithAuditActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// auditAttempt reports one HTTP attempt to the audit sink. resp is nil for
+// network errors.
+func (c *Client) auditAttempt(ctx context.Context, req *http.Request, resp *http.Response, err error, reqBody []byte) {
+	if c.AuditSink == nil {
+		return
+	}
+
+	rec := AuditRecord{
+		Time:           time.Now().UTC(),
+		Method:         req.Method,
+		Path:           req.URL.Path,
+		APIKey:         maskAPIKey(strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")),
+		IdempotencyKey: req.Header.Get(idempotencyKeyHeader),
+		BodySize:       len(reqBody),
+		Attempt:        1,
+	}
+	rec.Actor, _ = ctx.Value(auditActorKey{}).(string)
+	if len(reqBody) > 0 {
+		rec.BodySHA256 = auditBodyHash(reqBody)
+	}
+	if info := callInfoFromContext(ctx); info != nil {
+		rec.Attempt = info.Attempts
+	}
+	if resp != nil {
+		rec.StatusCode = resp.StatusCode
+		rec.RequestID = resp.Header.Get("X-Request-Id")
+	}
+	if err != nil {
+		rec.Error = err.Error()
+	}
+
+	if err := c.AuditSink.Record(ctx, rec); err != nil {
+		logger, _ := c.logger()
+		if logger == nil {
+			logger = slog.Default()
+		}
+		logger.LogAttrs(ctx, slog.LevelError, "sendly audit sink failed",
+			slog.String("method", rec.Method),
+			slog.String("path", rec.Path),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// auditBodyHash hashes body with sensitive values redacted. JSON is
+// re-encoded with sorted keys; other bodies are hashed as is.
+func auditBodyHash(body []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err == nil {
+		if redactedBody, err := json.Marshal(redactValue(v)); err == nil {
+			body = redactedBody
+		}
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// maskAPIKey keeps the prefix and last four characters of key.
+func maskAPIKey(key string) string {
+	if len(key) <= 8 {
+		return "..."
+	}
+	prefix := key[:strings.LastIndex(key[:len(key)-4], "_")+1]
+	return prefix + "..." + key[len(key)-4:]
+}
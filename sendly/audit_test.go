@@ -0,0 +1,98 @@
+package sendly
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithAudit(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("X-Request-Id", "req_"+string(rune('0'+attempts)))
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error":"unavailable","message":"try again"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"ver_1","status":"pending","phone":"+15551234567"}`))
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var records []AuditRecord
+	client := NewClient("sk_test_v1_abcdefghwxyz",
+		WithBaseURL(server.URL),
+		WithRetry(2, time.Millisecond),
+		WithAudit(AuditSinkFunc(func(ctx context.Context, rec AuditRecord) error {
+			mu.Lock()
+			records = append(records, rec)
+			mu.Unlock()
+			return nil
+		})),
+	)
+
+	ctx := WithAuditActor(context.Background(), "user:42")
+	if _, err := client.Verify.Send(ctx, &SendVerificationRequest{To: "+15551234567"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+	for i, rec := range records {
+		if rec.Method != "POST" || rec.Path != "/verify" || rec.Actor != "user:42" {
+			t.Errorf("unexpected record %d: %+v", i, rec)
+		}
+		if rec.Attempt != i+1 {
+			t.Errorf("expected attempt %d, got %d", i+1, rec.Attempt)
+		}
+		if rec.APIKey != "sk_test_v1_...wxyz" {
+			t.Errorf("expected API key to be 'sk_test_v1_...wxyz', got '%s'", rec.APIKey)
+		}
+		if rec.BodySize == 0 || len(rec.BodySHA256) != 64 {
+			t.Errorf("expected body size and hash, got %d, '%s'", rec.BodySize, rec.BodySHA256)
+		}
+	}
+	if records[0].StatusCode != http.StatusServiceUnavailable || records[1].StatusCode != http.StatusOK {
+		t.Errorf("unexpected status codes: %d, %d", records[0].StatusCode, records[1].StatusCode)
+	}
+	if records[0].BodySHA256 != records[1].BodySHA256 {
+		t.Error("expected retried attempts to hash equally")
+	}
+}
+
+func TestAuditBodyHash_Redacts(t *testing.T) {
+	a := auditBodyHash([]byte(`{"code":"123456","id":"ver_1"}`))
+	b := auditBodyHash([]byte(`{"id":"ver_1","code":"654321"}`))
+	if a != b {
+		t.Error("expected bodies differing only in redacted values to hash equally")
+	}
+	if a == auditBodyHash([]byte(`{"id":"ver_2","code":"123456"}`)) {
+		t.Error("expected bodies with different values to hash differently")
+	}
+}
+
+func TestWithAudit_SinkErrorDoesNotFailCall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"msg_1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key",
+		WithBaseURL(server.URL),
+		WithAudit(AuditSinkFunc(func(ctx context.Context, rec AuditRecord) error {
+			return errors.New("disk full")
+		})),
+	)
+	if _, err := client.Messages.Get(context.Background(), "msg_1"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
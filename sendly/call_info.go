@@ -0,0 +1,82 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// CallInfo contains telemetry about a single SDK call, including retries.
+type CallInfo struct {
+	// Attempts is the number of HTTP attempts made, including retries.
+	Attempts int
+	// Latency is the total time spent on the call, including backoff.
+	Latency time.Duration
+	// StatusCode is the HTTP status code of the last attempt.
+	StatusCode int
+	// RequestID is the server-assigned request ID of the last attempt.
+	RequestID string
+	// ServerTime is the server-side processing time of the last attempt, or
+	// 0 if the server did not report it. Latency minus ServerTime approximates
+	// time spent on the network and in backoff.
+	ServerTime time.Duration
+	// RateLimitRemaining is the number of requests left in the current window,
+	// or -1 if the server did not report it.
+	RateLimitRemaining int
+	// RateLimit is the full rate limit window of the last attempt, or nil if
+	// the server did not report it.
+	RateLimit *RateLimit
+	// ConsistencyToken is the read-your-writes token returned by mutating
+	// calls. Pass it to WithConsistencyToken for subsequent reads.
+	ConsistencyToken string
+
+	start time.Time
+}
+
+type callInfoKey struct{}
+
+// WithCallInfo returns a context that makes the client record telemetry for
+// the call into info. Use a fresh CallInfo for each call.
+//
+// Example:
+//
+//	var info sendly.CallInfo
+//	msg, err := client.Messages.Send(sendly.WithCallInfo(ctx, &info), req)
+//	log.Printf("attempts=%d latency=%s", info.Attempts, info.Latency)
+func WithCallInfo(ctx context.Context, info *CallInfo) context.Context {
+	return context.WithValue(ctx, callInfoKey{}, info)
+}
+
+// callInfoFromContext returns the CallInfo attached to ctx, if any.
+func callInfoFromContext(ctx context.Context) *CallInfo {
+	info, _ := ctx.Value(callInfoKey{}).(*CallInfo)
+	return info
+}
+
+// beginAttempt records the start of an HTTP attempt.
+func (i *CallInfo) beginAttempt() {
+	if i.start.IsZero() {
+		i.start = time.Now()
+	}
+	i.Attempts++
+}
+
+// endAttempt records the outcome of an HTTP attempt. resp may be nil.
+func (i *CallInfo) endAttempt(resp *http.Response) {
+	i.Latency = time.Since(i.start)
+	i.RateLimitRemaining = -1
+	if resp == nil {
+		return
+	}
+	i.StatusCode = resp.StatusCode
+	i.RequestID = resp.Header.Get("X-Request-Id")
+	i.ServerTime = parseProcessingTime(resp.Header)
+	i.ConsistencyToken = resp.Header.Get(consistencyTokenHeader)
+	i.RateLimit = parseRateLimit(resp.Header)
+	if remaining := resp.Header.Get("X-RateLimit-Remaining"); remaining != "" {
+		if n, err := strconv.Atoi(remaining); err == nil {
+			i.RateLimitRemaining = n
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package sendly
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// MaxCampaignRecipients is the maximum size of an uploaded recipient list.
+const MaxCampaignRecipients = 100000
+
+// CampaignsService provides bulk broadcast sends to groups or recipient lists.
+type CampaignsService struct {
+	client *Client
+}
+
+// CampaignStatus represents the lifecycle state of a campaign.
+type CampaignStatus string
+
+const (
+	CampaignStatusDraft     CampaignStatus = "draft"
+	CampaignStatusScheduled CampaignStatus = "scheduled"
+	CampaignStatusSending   CampaignStatus = "sending"
+	CampaignStatusPaused    CampaignStatus = "paused"
+	CampaignStatusCompleted CampaignStatus = "completed"
+	CampaignStatusCancelled CampaignStatus = "cancelled"
+	CampaignStatusFailed    CampaignStatus = "failed"
+)
+
+// IsTerminal reports whether the campaign has stopped sending for good.
+func (s CampaignStatus) IsTerminal() bool {
+	switch s {
+	case CampaignStatusCompleted, CampaignStatusCancelled, CampaignStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// CampaignRecipient is a single entry in an uploaded recipient list.
+type CampaignRecipient struct {
+	// To is the recipient phone number in E.164 format.
+	To string `json:"to"`
+	// Variables override the campaign's template variables for this recipient.
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// CreateCampaignRequest represents the parameters for creating a campaign.
+// Target either a GroupID or an uploaded Recipients list, and provide either
+// Text or a TemplateID.
+type CreateCampaignRequest struct {
+	// Name is the campaign name (required).
+	Name       string              `json:"name"`
+	GroupID    string              `json:"group_id,omitempty"`
+	Recipients []CampaignRecipient `json:"recipients,omitempty"`
+	Text       string              `json:"text,omitempty"`
+	TemplateID string              `json:"template_id,omitempty"`
+	// Variables are applied to every recipient. Contact attributes are
+	// available automatically for group campaigns.
+	Variables   map[string]string `json:"variables,omitempty"`
+	From        string            `json:"from,omitempty"`
+	MessageType MessageType       `json:"message_type,omitempty"`
+	Links       *LinkOptions      `json:"links,omitempty"`
+	// ScheduledAt starts the campaign at this ISO 8601 time. If empty the
+	// campaign is created as a draft.
+	ScheduledAt string `json:"scheduled_at,omitempty"`
+}
+
+// Campaign represents a broadcast campaign.
+type Campaign struct {
+	ID             string         `json:"id"`
+	Name           string         `json:"name"`
+	Status         CampaignStatus `json:"status"`
+	GroupID        string         `json:"group_id,omitempty"`
+	RecipientCount int            `json:"recipient_count"`
+	Text           string         `json:"text,omitempty"`
+	TemplateID     string         `json:"template_id,omitempty"`
+	From           string         `json:"from,omitempty"`
+	ScheduledAt    string         `json:"scheduled_at,omitempty"`
+	StartedAt      string         `json:"started_at,omitempty"`
+	CompletedAt    string         `json:"completed_at,omitempty"`
+	CreatedAt      string         `json:"created_at"`
+}
+
+// CampaignListOptions are options for listing campaigns.
+type CampaignListOptions struct {
+	// Limit is the maximum number of campaigns per page (default: 20, max: 100).
+	Limit int
+	// Cursor continues from a previous page's NextCursor.
+	Cursor string
+	// Status filters by campaign status.
+	Status CampaignStatus
+}
+
+// CampaignListResponse is a page of campaigns.
+type CampaignListResponse struct {
+	Data       []Campaign `json:"data"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+	HasMore    bool       `json:"has_more"`
+}
+
+// CampaignStats reports per-campaign delivery statistics.
+type CampaignStats struct {
+	CampaignID   string  `json:"campaign_id"`
+	Total        int     `json:"total"`
+	Pending      int     `json:"pending"`
+	Sent         int     `json:"sent"`
+	Delivered    int     `json:"delivered"`
+	Failed       int     `json:"failed"`
+	Suppressed   int     `json:"suppressed"`
+	OptedOut     int     `json:"opted_out"`
+	CreditsUsed  int     `json:"credits_used"`
+	DeliveryRate float64 `json:"delivery_rate"`
+}
+
+// Create creates a campaign.
+func (s *CampaignsService) Create(ctx context.Context, req *CreateCampaignRequest) (*Campaign, error) {
+	if req == nil {
+		return nil, &ValidationError{APIError: APIError{Message: "request is required"}}
+	}
+	if req.Name == "" {
+		return nil, invalidParamError("name", "campaign name is required")
+	}
+	if (req.GroupID == "") == (len(req.Recipients) == 0) {
+		return nil, invalidParamError("group_id", "exactly one of group ID or recipients is required")
+	}
+	if len(req.Recipients) > MaxCampaignRecipients {
+		return nil, invalidParamError("recipients", fmt.Sprintf("cannot upload more than %d recipients", MaxCampaignRecipients))
+	}
+	if req.Text == "" && req.TemplateID == "" {
+		return nil, invalidParamError("text", "text or template ID is required")
+	}
+	if req.Text != "" && req.TemplateID != "" {
+		return nil, invalidParamError("text", "text and template ID are mutually exclusive")
+	}
+
+	var resp Campaign
+	if err := s.client.request(ctx, "POST", "/campaigns", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get retrieves a campaign by ID.
+func (s *CampaignsService) Get(ctx context.Context, id string) (*Campaign, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "campaign ID is required")
+	}
+
+	var resp Campaign
+	if err := s.client.request(ctx, "GET", "/campaigns/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// List retrieves a page of campaigns.
+func (s *CampaignsService) List(ctx context.Context, opts *CampaignListOptions) (*CampaignListResponse, error) {
+	params := make(map[string]string)
+	if opts != nil {
+		if opts.Limit > 0 {
+			params["limit"] = strconv.Itoa(opts.Limit)
+		}
+		params["cursor"] = opts.Cursor
+		params["status"] = string(opts.Status)
+	}
+
+	var resp CampaignListResponse
+	if err := s.client.request(ctx, "GET", "/campaigns"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAll returns every campaign matching opts as an Iter, fetching pages
+// as needed.
+func (s *CampaignsService) ListAll(ctx context.Context, opts *CampaignListOptions) Iter[Campaign] {
+	var o CampaignListOptions
+	if opts != nil {
+		o = *opts
+	}
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[Campaign], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[Campaign]{Items: resp.Data}
+		if resp.HasMore {
+			page.NextCursor = resp.NextCursor
+		}
+		return page, nil
+	}).Iter()
+}
+
+// Schedule schedules a draft campaign, or moves a scheduled one, to start
+// at scheduledAt (ISO 8601).
+func (s *CampaignsService) Schedule(ctx context.Context, id, scheduledAt string) (*Campaign, error) {
+	if scheduledAt == "" {
+		return nil, invalidParamError("scheduled_at", "scheduledAt is required")
+	}
+	return s.action(ctx, id, "schedule", map[string]string{"scheduled_at": scheduledAt})
+}
+
+// Pause stops a sending campaign. Messages already handed to carriers are
+// not recalled.
+func (s *CampaignsService) Pause(ctx context.Context, id string) (*Campaign, error) {
+	return s.action(ctx, id, "pause", nil)
+}
+
+// Resume continues a paused campaign.
+func (s *CampaignsService) Resume(ctx context.Context, id string) (*Campaign, error) {
+	return s.action(ctx, id, "resume", nil)
+}
+
+// Cancel permanently stops a scheduled, sending or paused campaign.
+func (s *CampaignsService) Cancel(ctx context.Context, id string) (*Campaign, error) {
+	return s.action(ctx, id, "cancel", nil)
+}
+
+func (s *CampaignsService) action(ctx context.Context, id, action string, body interface{}) (*Campaign, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "campaign ID is required")
+	}
+
+	var resp Campaign
+	if err := s.client.request(ctx, "POST", "/campaigns/"+url.PathEscape(id)+"/"+action, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Stats retrieves delivery statistics for a campaign.
+func (s *CampaignsService) Stats(ctx context.Context, id string) (*CampaignStats, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "campaign ID is required")
+	}
+
+	var resp CampaignStats
+	if err := s.client.request(ctx, "GET", "/campaigns/"+url.PathEscape(id)+"/stats", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
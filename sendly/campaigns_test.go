@@ -0,0 +1,51 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCampaignsService_Create_Validation(t *testing.T) {
+	client := NewClient("test-api-key")
+
+	tests := []struct {
+		name string
+		req  *CreateCampaignRequest
+	}{
+		{name: "missing name", req: &CreateCampaignRequest{GroupID: "grp_1", Text: "hi"}},
+		{name: "no audience", req: &CreateCampaignRequest{Name: "x", Text: "hi"}},
+		{name: "both audiences", req: &CreateCampaignRequest{Name: "x", GroupID: "grp_1", Recipients: []CampaignRecipient{{To: "+15551234567"}}, Text: "hi"}},
+		{name: "no body", req: &CreateCampaignRequest{Name: "x", GroupID: "grp_1"}},
+		{name: "text and template", req: &CreateCampaignRequest{Name: "x", GroupID: "grp_1", Text: "hi", TemplateID: "tpl_1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.Campaigns.Create(context.Background(), tt.req); !IsValidationError(err) {
+				t.Errorf("expected validation error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCampaignsService_Pause(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/campaigns/cmp_1/pause" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"cmp_1","status":"paused"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	campaign, err := client.Campaigns.Pause(context.Background(), "cmp_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if campaign.Status != CampaignStatusPaused {
+		t.Errorf("expected status to be 'paused', got '%s'", campaign.Status)
+	}
+}
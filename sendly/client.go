@@ -4,8 +4,10 @@ package sendly
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
 	"io"
+	"log/slog"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -19,6 +21,12 @@ const (
 	DefaultBaseURL = "https://sendly.live/api/v1"
 	// DefaultTimeout is the default HTTP client timeout.
 	DefaultTimeout = 30 * time.Second
+	// DefaultRetryBackoff is the default base delay between retries.
+	DefaultRetryBackoff = time.Second
+	// DefaultMaxRetryBackoff is the default cap on the delay between retries.
+	DefaultMaxRetryBackoff = 30 * time.Second
+	// apiVersionHeader pins a request to a dated API version.
+	apiVersionHeader = "Sendly-Version"
 	// Version is the SDK version.
 	Version = "3.12.1"
 )
@@ -33,10 +41,69 @@ type Client struct {
 	HTTPClient *http.Client
 	// MaxRetries is the maximum number of retry attempts.
 	MaxRetries int
+	// RetryBackoff is the base delay before the first retry. Later retries
+	// double it, with jitter, up to MaxRetryBackoff.
+	RetryBackoff time.Duration
+	// MaxRetryBackoff caps the delay between retries.
+	MaxRetryBackoff time.Duration
+	// RetryObserver is told about every retry decision. See
+	// WithRetryObserver.
+	RetryObserver RetryObserver
 	// Timeout is the request timeout.
 	Timeout time.Duration
-	// Debug enables debug logging.
+	// Debug enables request logging to slog.Default when Logger is nil.
 	Debug bool
+	// SigningKeyID and SigningSecret sign every request when set. See
+	// WithRequestSigning.
+	SigningKeyID  string
+	SigningSecret string
+	// Metrics receives call health metrics. See WithMetrics.
+	Metrics MetricsCollector
+	// Logger receives structured request logs. See WithLogger.
+	Logger *slog.Logger
+	// AuditSink receives a record of every HTTP attempt. See WithAudit.
+	AuditSink AuditSink
+	// LogLevel is the level of successful request logs (default:
+	// slog.LevelDebug).
+	LogLevel slog.Level
+	// LoadTest marks every send as a load-test send. Such sends are accepted,
+	// priced, and emit synthetic status events but never reach a carrier.
+	LoadTest bool
+	// Codec encodes request bodies and decodes responses (default: StdCodec).
+	Codec Codec
+	// ReadYourWrites makes reads observe the client's own prior writes by
+	// automatically forwarding the latest consistency token.
+	ReadYourWrites bool
+	// AllowUnknownEventTypes lets webhook subscriptions name well-formed
+	// event types that are not in this SDK's catalog. See
+	// WithUnknownEventTypes.
+	AllowUnknownEventTypes bool
+	// AutoIdempotency sends a generated Idempotency-Key with every POST that
+	// doesn't already carry one, so retries can never repeat a send.
+	AutoIdempotency bool
+	// ValidateTemplates checks template variables client-side before sends
+	// that use a TemplateID, using cached template definitions.
+	ValidateTemplates bool
+	// TemplateCacheTTL is how long template definitions are cached for
+	// validation (default: DefaultTemplateCacheTTL).
+	TemplateCacheTTL time.Duration
+	// ValidatePhones normalizes recipient numbers to E.164 before
+	// Messages.Send and Verify.Send, rejecting invalid ones without an API
+	// call. See WithPhoneValidation.
+	ValidatePhones bool
+	// DefaultCountry is the ISO 3166-1 alpha-2 country used to read
+	// numbers without a country code when ValidatePhones is set.
+	DefaultCountry string
+	// UserAgent identifies the application. It is sent ahead of the SDK's
+	// own User-Agent token.
+	UserAgent string
+	// APIVersion pins requests to a dated API version. If empty the
+	// account's default version is used.
+	APIVersion string
+	// RequestInterceptors run on every outgoing HTTP request.
+	RequestInterceptors []RequestInterceptor
+	// ResponseInterceptors run on every HTTP response.
+	ResponseInterceptors []ResponseInterceptor
 
 	// Messages provides access to message operations.
 	Messages *MessagesService
@@ -48,13 +115,52 @@ type Client struct {
 	Verify *VerifyService
 	// Templates provides access to SMS template management.
 	Templates *TemplatesService
+	// OptIns provides access to managed double opt-in flows.
+	OptIns *OptInsService
+	// Reports provides access to delivery and usage reports.
+	Reports *ReportsService
+	// Events provides access to the webhook event schema registry.
+	Events *EventsService
+	// Contacts provides access to contact management.
+	Contacts *ContactsService
+	// Groups provides access to contact group management.
+	Groups *GroupsService
+	// Campaigns provides access to bulk broadcast campaigns.
+	Campaigns *CampaignsService
+	// Conversations provides access to inbound messages and conversations.
+	Conversations *ConversationsService
+	// Suppressions provides access to the opt-out suppression list.
+	Suppressions *SuppressionsService
+	// Lookup provides phone number carrier and line type lookups.
+	Lookup *LookupService
+	// Numbers provides access to owned numbers and sender IDs.
+	Numbers *NumbersService
+	// Media provides access to MMS media attachments.
+	Media *MediaService
+	// Usage provides access to usage, costs and spend alerts.
+	Usage *UsageService
+	// Settings provides access to account-wide defaults.
+	Settings *SettingsService
+	// Exports provides access to bulk data exports.
+	Exports *ExportsService
+	// Integrations provides access to analytics warehouse connectors.
+	Integrations *IntegrationsService
+	// Archives provides access to records older than the retention window.
+	Archives *ArchivesService
 
 	rateLimiter *rate.Limiter
+	consistency consistencyTracker
+	rateLimit   rateLimitTracker
+	keys        *keyPool
+	templates   templateCache
 }
 
 // ClientOption is a function that configures the client.
 type ClientOption func(*Client)
 
+// Option is an alias of ClientOption for use with New.
+type Option = ClientOption
+
 // WithBaseURL sets a custom base URL.
 func WithBaseURL(baseURL string) ClientOption {
 	return func(c *Client) {
@@ -70,10 +176,28 @@ func WithTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
-// WithHTTPClient sets a custom HTTP client.
+// WithHTTPClient sets a custom HTTP client, for example one with a custom
+// transport or proxy. A nil client is ignored.
 func WithHTTPClient(httpClient *http.Client) ClientOption {
 	return func(c *Client) {
-		c.HTTPClient = httpClient
+		if httpClient != nil {
+			c.HTTPClient = httpClient
+		}
+	}
+}
+
+// WithUserAgent identifies the application in the User-Agent header, e.g.
+// "acme-billing/2.3".
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.UserAgent = userAgent
+	}
+}
+
+// WithAPIVersion pins requests to a dated API version, e.g. "2025-01-15".
+func WithAPIVersion(version string) ClientOption {
+	return func(c *Client) {
+		c.APIVersion = version
 	}
 }
 
@@ -84,6 +208,21 @@ func WithMaxRetries(maxRetries int) ClientOption {
 	}
 }
 
+// WithRetry configures automatic retries of rate-limited (429), server (5xx),
+// and network errors where no response was received. maxAttempts is the
+// total number of attempts, including the first; backoff is the base delay,
+// doubled on each retry with jitter. Retry-After headers take precedence
+// over the computed delay.
+func WithRetry(maxAttempts int, backoff time.Duration) ClientOption {
+	return func(c *Client) {
+		if maxAttempts < 1 {
+			maxAttempts = 1
+		}
+		c.MaxRetries = maxAttempts - 1
+		c.RetryBackoff = backoff
+	}
+}
+
 // WithDebug enables debug mode.
 func WithDebug(debug bool) ClientOption {
 	return func(c *Client) {
@@ -91,6 +230,96 @@ func WithDebug(debug bool) ClientOption {
 	}
 }
 
+// WithLoadTestMode enables no-op sends for end-to-end load testing.
+func WithLoadTestMode(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.LoadTest = enabled
+	}
+}
+
+// WithUnknownEventTypes lets webhook Create, Update and BulkUpdate subscribe
+// to well-formed event types missing from this SDK's catalog, such as types
+// Sendly shipped after this SDK version. By default they are rejected with
+// a ValidationError, which catches typos like "message.deliverd".
+func WithUnknownEventTypes(allowed bool) ClientOption {
+	return func(c *Client) {
+		c.AllowUnknownEventTypes = allowed
+	}
+}
+
+// WithReadYourWrites makes reads issued by the client observe its own writes.
+func WithReadYourWrites(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.ReadYourWrites = enabled
+	}
+}
+
+// WithAutoIdempotency generates an Idempotency-Key for every POST request
+// that doesn't set one with WithIdempotencyKey.
+func WithAutoIdempotency(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.AutoIdempotency = enabled
+	}
+}
+
+// WithTemplateValidation validates template variables before sends that use
+// a TemplateID. Definitions are cached for cacheTTL, or
+// DefaultTemplateCacheTTL if cacheTTL is zero.
+func WithTemplateValidation(cacheTTL time.Duration) ClientOption {
+	return func(c *Client) {
+		c.ValidateTemplates = true
+		c.TemplateCacheTTL = cacheTTL
+	}
+}
+
+// WithPhoneValidation normalizes recipient numbers such as
+// "+1 (555) 123-4567" to E.164 before Messages.Send, SendBatch, SendMany and
+// Verify.Send, and rejects obviously invalid numbers with a
+// *ValidationError instead of sending them. Numbers without a country code
+// are read as national numbers of defaultCountry; if it is empty they are
+// rejected.
+func WithPhoneValidation(defaultCountry string) ClientOption {
+	return func(c *Client) {
+		c.ValidatePhones = true
+		c.DefaultCountry = defaultCountry
+	}
+}
+
+// WithCodec sets an alternative JSON codec. A nil codec is ignored.
+func WithCodec(codec Codec) ClientOption {
+	return func(c *Client) {
+		if codec != nil {
+			c.Codec = codec
+		}
+	}
+}
+
+// New creates a new Sendly API client, validating the API key and options.
+//
+// Example:
+//
+//	client, err := sendly.New(os.Getenv("SENDLY_API_KEY"),
+//	    sendly.WithTimeout(10*time.Second),
+//	    sendly.WithRetry(4, 500*time.Millisecond),
+//	    sendly.WithUserAgent("acme-billing/2.3"),
+//	)
+func New(apiKey string, opts ...Option) (*Client, error) {
+	c := NewClient(apiKey, opts...)
+	if c.APIKey == "" {
+		return nil, invalidParamError("api_key", "API key is required")
+	}
+	if u, err := url.Parse(c.BaseURL); err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, invalidParamError("base_url", "base URL must be an absolute URL")
+	}
+	if c.Timeout < 0 {
+		return nil, invalidParamError("timeout", "timeout must not be negative")
+	}
+	if c.SigningSecret != "" && c.SigningKeyID == "" {
+		return nil, invalidParamError("signing_key_id", "signing key ID is required with a signing secret")
+	}
+	return c, nil
+}
+
 // NewClient creates a new Sendly API client.
 func NewClient(apiKey string, opts ...ClientOption) *Client {
 	c := &Client{
@@ -99,24 +328,59 @@ func NewClient(apiKey string, opts ...ClientOption) *Client {
 		HTTPClient: &http.Client{
 			Timeout: DefaultTimeout,
 		},
-		MaxRetries:  3,
-		Timeout:     DefaultTimeout,
-		rateLimiter: rate.NewLimiter(rate.Every(time.Second), 10), // 10 requests per second
+		MaxRetries:      3,
+		RetryBackoff:    DefaultRetryBackoff,
+		MaxRetryBackoff: DefaultMaxRetryBackoff,
+		Timeout:         DefaultTimeout,
+		LogLevel:        slog.LevelDebug,
+		Codec:           StdCodec{},
+		rateLimiter:     newRateLimiter(1),
 	}
 
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.keys != nil {
+		c.rateLimiter = newRateLimiter(len(c.keys.keys))
+	}
 
 	c.Messages = &MessagesService{client: c}
 	c.WebhooksService = &WebhooksService{client: c}
 	c.Account = &AccountService{client: c}
-	c.Verify = &VerifyService{client: c, Sessions: &SessionsService{client: c}}
+	c.Verify = &VerifyService{
+		client:   c,
+		Sessions: &SessionsService{client: c},
+		Policies: &VerifyPoliciesService{client: c},
+		TOTP:     &VerifyTOTPService{client: c},
+	}
 	c.Templates = &TemplatesService{client: c}
+	c.OptIns = &OptInsService{client: c}
+	c.Reports = &ReportsService{client: c}
+	c.Events = &EventsService{client: c}
+	c.Contacts = &ContactsService{client: c}
+	c.Groups = &GroupsService{client: c}
+	c.Campaigns = &CampaignsService{client: c}
+	c.Conversations = &ConversationsService{client: c}
+	c.Suppressions = &SuppressionsService{client: c}
+	c.Lookup = &LookupService{client: c}
+	c.Numbers = &NumbersService{client: c}
+	c.Media = &MediaService{client: c}
+	c.Usage = &UsageService{client: c}
+	c.Settings = &SettingsService{client: c}
+	c.Exports = &ExportsService{client: c}
+	c.Integrations = &IntegrationsService{client: c}
+	c.Archives = &ArchivesService{client: c}
 
 	return c
 }
 
+// newRateLimiter returns the client-side rate limiter for a client with the
+// given number of API keys: one request per second with bursts of 10, per
+// key.
+func newRateLimiter(keys int) *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(keys), 10*keys)
+}
+
 // request performs an HTTP request with retries and rate limiting.
 func (c *Client) request(ctx context.Context, method, path string, body interface{}, result interface{}) error {
 	// Wait for rate limiter
@@ -124,52 +388,159 @@ func (c *Client) request(ctx context.Context, method, path string, body interfac
 		return &NetworkError{Message: "rate limiter error", Err: err}
 	}
 
-	var lastErr error
-	for attempt := 0; attempt <= c.MaxRetries; attempt++ {
-		if attempt > 0 {
-			// Exponential backoff
-			backoff := time.Duration(1<<uint(attempt-1)) * time.Second
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case <-time.After(backoff):
-			}
-		}
+	// Resolve the idempotency key once so every retry sends the same one
+	ctx = c.withAutoIdempotencyKey(ctx, method)
+	ctx = c.beginMetrics(ctx)
+	start := time.Now()
 
+	for attempt := 0; ; attempt++ {
 		err := c.doRequest(ctx, method, path, body, result)
 		if err == nil {
+			c.observeCall(ctx, method, path, start, nil)
 			return nil
 		}
-
-		// Don't retry on certain errors
-		if _, ok := err.(*AuthenticationError); ok {
+		if attempt >= c.MaxRetries || !isRetryable(err) {
+			c.notifyRetry(method, path, attempt, err, false, 0)
+			c.observeCall(ctx, method, path, start, err)
 			return err
 		}
-		if _, ok := err.(*ValidationError); ok {
-			return err
+
+		delay := c.retryDelay(attempt, err)
+		c.notifyRetry(method, path, attempt, err, true, delay)
+		select {
+		case <-ctx.Done():
+			c.observeCall(ctx, method, path, start, ctx.Err())
+			return ctx.Err()
+		case <-time.After(delay):
 		}
-		if _, ok := err.(*NotFoundError); ok {
-			return err
+		c.observeRetry(method, path)
+	}
+}
+
+// stream performs a GET request and returns the response with its body
+// unread, for downloads too large to buffer. Failures before the body is
+// returned are retried like request; the caller must close the body.
+func (c *Client) stream(ctx context.Context, path string, header http.Header) (*http.Response, error) {
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return nil, &NetworkError{Message: "rate limiter error", Err: err}
+	}
+	ctx = c.beginMetrics(ctx)
+	start := time.Now()
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.doStream(ctx, path, header)
+		if err == nil {
+			c.observeCall(ctx, "GET", path, start, nil)
+			return resp, nil
 		}
-		if _, ok := err.(*InsufficientCreditsError); ok {
-			return err
+		if attempt >= c.MaxRetries || !isRetryable(err) {
+			c.notifyRetry("GET", path, attempt, err, false, 0)
+			c.observeCall(ctx, "GET", path, start, err)
+			return nil, err
 		}
 
-		lastErr = err
-
-		// Check for rate limit error with Retry-After
-		if rateLimitErr, ok := err.(*RateLimitError); ok {
-			if rateLimitErr.RetryAfter > 0 {
-				select {
-				case <-ctx.Done():
-					return ctx.Err()
-				case <-time.After(time.Duration(rateLimitErr.RetryAfter) * time.Second):
-				}
-			}
+		delay := c.retryDelay(attempt, err)
+		c.notifyRetry("GET", path, attempt, err, true, delay)
+		select {
+		case <-ctx.Done():
+			c.observeCall(ctx, "GET", path, start, ctx.Err())
+			return nil, ctx.Err()
+		case <-time.After(delay):
 		}
+		c.observeRetry("GET", path)
+	}
+}
+
+// doStream performs a single streaming GET request.
+func (c *Client) doStream(ctx context.Context, path string, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.BaseURL+path, nil)
+	if err != nil {
+		return nil, &NetworkError{Message: "failed to create request", Err: err}
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	key := c.applyHeaders(ctx, req)
+	if err := c.interceptRequest(req); err != nil {
+		return nil, err
+	}
+	if err := c.signRequest(req); err != nil {
+		return nil, err
+	}
+
+	info := callInfoFromContext(ctx)
+	if info != nil {
+		info.beginAttempt()
+	}
+
+	start := time.Now()
+	resp, err := c.HTTPClient.Do(req)
+	if info != nil {
+		info.endAttempt(resp)
+	}
+	c.logAttempt(ctx, req, resp, time.Since(start), err, nil, nil)
+	c.auditAttempt(ctx, req, resp, err, nil)
+	if err != nil {
+		return nil, &NetworkError{Message: "request failed", Err: err}
+	}
+	if err := c.interceptResponse(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	c.recordRateLimit(key, resp.Header)
+
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, &NetworkError{Message: "failed to read response body", Err: err, responded: true}
+		}
+		return nil, c.handleErrorResponse(resp, body)
+	}
+	return resp, nil
+}
+
+// isRetryable reports whether a failed request may succeed if retried.
+// Network errors are only retried if no response was received, since the
+// server may already have acted on the call.
+func isRetryable(err error) bool {
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return true
+	}
+	var networkErr *NetworkError
+	if errors.As(err, &networkErr) {
+		return !networkErr.responded
+	}
+	var sendlyErr *SendlyError
+	if errors.As(err, &sendlyErr) {
+		return sendlyErr.StatusCode >= 500
+	}
+	return false
+}
+
+// retryDelay returns how long to wait before retry number attempt+1. A
+// Retry-After value from the server wins; otherwise the delay is the base
+// backoff doubled per attempt, capped, with equal jitter.
+func (c *Client) retryDelay(attempt int, err error) time.Duration {
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) && rateLimitErr.RetryAfter > 0 {
+		return time.Duration(rateLimitErr.RetryAfter) * time.Second
 	}
 
-	return lastErr
+	backoff := c.RetryBackoff
+	for i := 0; i < attempt && (c.MaxRetryBackoff <= 0 || backoff < c.MaxRetryBackoff); i++ {
+		backoff *= 2
+	}
+	if c.MaxRetryBackoff > 0 && backoff > c.MaxRetryBackoff {
+		backoff = c.MaxRetryBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(backoff-half)+1))
 }
 
 // doRequest performs a single HTTP request.
@@ -177,8 +548,10 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 	fullURL := c.BaseURL + path
 
 	var bodyReader io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = c.Codec.Marshal(body)
 		if err != nil {
 			return &ValidationError{APIError: APIError{Message: "failed to marshal request body"}, Err: err}
 		}
@@ -190,20 +563,44 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		return &NetworkError{Message: "failed to create request", Err: err}
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.APIKey)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", "sendly-go/"+Version)
+	key := c.applyHeaders(ctx, req)
+	if err := c.interceptRequest(req); err != nil {
+		return err
+	}
+	if err := c.signRequest(req); err != nil {
+		return err
+	}
+
+	info := callInfoFromContext(ctx)
+	if info != nil {
+		info.beginAttempt()
+	}
 
+	start := time.Now()
 	resp, err := c.HTTPClient.Do(req)
+	if info != nil {
+		info.endAttempt(resp)
+	}
 	if err != nil {
+		c.logAttempt(ctx, req, nil, time.Since(start), err, jsonBody, nil)
+		c.auditAttempt(ctx, req, nil, err, jsonBody)
 		return &NetworkError{Message: "request failed", Err: err}
 	}
 	defer resp.Body.Close()
+	c.auditAttempt(ctx, req, resp, nil, jsonBody)
+	if err := c.interceptResponse(resp); err != nil {
+		return err
+	}
+
+	c.consistency.set(resp.Header.Get(consistencyTokenHeader))
+	c.recordRateLimit(key, resp.Header)
 
 	respBody, err := io.ReadAll(resp.Body)
+	c.logAttempt(ctx, req, resp, time.Since(start), err, jsonBody, respBody)
 	if err != nil {
-		return &NetworkError{Message: "failed to read response body", Err: err}
+		return &NetworkError{Message: "failed to read response body", Err: err, responded: true}
 	}
 
 	if resp.StatusCode >= 400 {
@@ -211,23 +608,58 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 	}
 
 	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return &NetworkError{Message: "failed to unmarshal response", Err: err}
+		if err := c.Codec.Unmarshal(respBody, result); err != nil {
+			return &NetworkError{Message: "failed to unmarshal response", Err: err, responded: true}
 		}
 	}
 
 	return nil
 }
 
+// applyHeaders sets the headers common to every API request. It returns the
+// pooled API key used, or nil when the client has a single key.
+func (c *Client) applyHeaders(ctx context.Context, req *http.Request) *pooledKey {
+	key := c.applyAPIKey(req)
+	req.Header.Set("User-Agent", c.userAgent())
+	if c.APIVersion != "" {
+		req.Header.Set(apiVersionHeader, c.APIVersion)
+	}
+	if c.LoadTest {
+		req.Header.Set("X-Sendly-Load-Test", "true")
+	}
+	c.applyConsistencyToken(ctx, req)
+	applyDeadlineHints(ctx, req)
+	if idemKey := idempotencyKeyFromContext(ctx); idemKey != "" && mutatingMethod(req.Method) {
+		req.Header.Set(idempotencyKeyHeader, idemKey)
+	}
+	return key
+}
+
+// userAgent returns the User-Agent header value.
+func (c *Client) userAgent() string {
+	if c.UserAgent != "" {
+		return c.UserAgent + " sendly-go/" + Version
+	}
+	return "sendly-go/" + Version
+}
+
 // handleErrorResponse converts HTTP error responses to typed errors.
 func (c *Client) handleErrorResponse(resp *http.Response, body []byte) error {
 	var apiErr APIError
-	if err := json.Unmarshal(body, &apiErr); err != nil {
+	if err := c.Codec.Unmarshal(body, &apiErr); err != nil {
 		apiErr = APIError{
 			Code:    "UNKNOWN_ERROR",
 			Message: string(body),
 		}
 	}
+	apiErr.statusCode = resp.StatusCode
+	if apiErr.RequestID == "" {
+		apiErr.RequestID = resp.Header.Get("X-Request-Id")
+	}
+
+	if apiErr.Code == errCodeMessageClassUnsupported {
+		return &UnsupportedMessageClassError{APIError: apiErr}
+	}
 
 	switch resp.StatusCode {
 	case http.StatusUnauthorized:
@@ -3,8 +3,10 @@ package sendly
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 )
@@ -127,6 +129,42 @@ func TestNewClient(t *testing.T) {
 	}
 }
 
+func TestNew(t *testing.T) {
+	if _, err := New(""); !IsValidationError(err) {
+		t.Errorf("expected validation error for empty API key, got %v", err)
+	}
+	if _, err := New("test-api-key", WithBaseURL("not a url")); !IsValidationError(err) {
+		t.Errorf("expected validation error for relative base URL, got %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ua := r.Header.Get("User-Agent"); ua != "acme/1.0 sendly-go/"+Version {
+			t.Errorf("expected User-Agent to be 'acme/1.0 sendly-go/%s', got '%s'", Version, ua)
+		}
+		if v := r.Header.Get("Sendly-Version"); v != "2025-01-15" {
+			t.Errorf("expected Sendly-Version header to be '2025-01-15', got '%s'", v)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := New("test-api-key",
+		WithBaseURL(server.URL),
+		WithUserAgent("acme/1.0"),
+		WithAPIVersion("2025-01-15"),
+		WithHTTPClient(nil),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.HTTPClient == nil {
+		t.Fatal("expected nil HTTP client to be ignored")
+	}
+	if err := client.request(context.Background(), "GET", "/test", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestClientRequest_Headers(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Verify headers
@@ -220,6 +258,49 @@ func TestClientRequest_NoRetryOnAuthError(t *testing.T) {
 	}
 }
 
+func TestClientRequest_NoRetryAfterResponse(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id":`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithMaxRetries(3))
+	var result map[string]string
+	err := client.request(context.Background(), "POST", "/messages", map[string]string{"to": "+1"}, &result)
+	if !IsNetworkError(err) {
+		t.Fatalf("expected NetworkError, got %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt (no retry once the server responded), got %d", attempts)
+	}
+}
+
+func TestClientRequest_RetriesTransportError(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithRetry(4, time.Millisecond))
+	var result map[string]string
+	if err := client.request(context.Background(), "GET", "/test", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
+
 func TestClientRequest_NoRetryOnValidationError(t *testing.T) {
 	attempts := 0
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -353,3 +434,316 @@ func TestBuildQueryString(t *testing.T) {
 		})
 	}
 }
+
+func TestClientRequest_LoadTestHeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		expected string
+	}{
+		{name: "disabled", enabled: false, expected: ""},
+		{name: "enabled", enabled: true, expected: "true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if h := r.Header.Get("X-Sendly-Load-Test"); h != tt.expected {
+					t.Errorf("expected X-Sendly-Load-Test header to be '%s', got '%s'", tt.expected, h)
+				}
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+			}))
+			defer server.Close()
+
+			client := NewClient("test-api-key", WithBaseURL(server.URL), WithLoadTestMode(tt.enabled))
+
+			var result map[string]string
+			if err := client.request(context.Background(), "GET", "/test", nil, &result); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestClientRequest_DeadlineHints(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		budget, err := strconv.Atoi(r.Header.Get("X-Sendly-Request-Timeout"))
+		if err != nil || budget <= 0 || budget > 5000 {
+			t.Errorf("expected X-Sendly-Request-Timeout within (0, 5000], got '%s'", r.Header.Get("X-Sendly-Request-Timeout"))
+		}
+		if p := r.Header.Get("X-Sendly-Priority"); p != "high" {
+			t.Errorf("expected X-Sendly-Priority header to be 'high', got '%s'", p)
+		}
+		w.Header().Set("X-Sendly-Processing-Time", "12.5")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var info CallInfo
+	ctx = WithCallInfo(WithPriority(ctx, PriorityHigh), &info)
+	if err := client.request(ctx, "GET", "/test", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.ServerTime != 12500*time.Microsecond {
+		t.Errorf("expected ServerTime to be 12.5ms, got %v", info.ServerTime)
+	}
+}
+
+func TestClientRequest_CallInfo(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("X-Request-Id", "req_"+strconv.Itoa(attempts))
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		if attempts < 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(APIError{Code: "SERVER_ERROR", Message: "Internal server error"})
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithMaxRetries(3))
+
+	var info CallInfo
+	var result map[string]string
+	if err := client.request(WithCallInfo(context.Background(), &info), "GET", "/test", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if info.Attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", info.Attempts)
+	}
+	if info.StatusCode != http.StatusOK {
+		t.Errorf("expected StatusCode to be 200, got %d", info.StatusCode)
+	}
+	if info.RequestID != "req_2" {
+		t.Errorf("expected RequestID to be 'req_2', got '%s'", info.RequestID)
+	}
+	if info.RateLimitRemaining != 42 {
+		t.Errorf("expected RateLimitRemaining to be 42, got %d", info.RateLimitRemaining)
+	}
+	if info.Latency < 500*time.Millisecond {
+		t.Errorf("expected Latency to include backoff, got %v", info.Latency)
+	}
+}
+
+func TestClientRequest_RateLimit(t *testing.T) {
+	reset := time.Now().Add(time.Minute).Unix()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "7")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+		json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	if client.LastRateLimit() != nil {
+		t.Error("expected no rate limit before the first request")
+	}
+
+	var info CallInfo
+	if err := client.request(WithCallInfo(context.Background(), &info), "GET", "/test", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for name, rl := range map[string]*RateLimit{"CallInfo": info.RateLimit, "LastRateLimit": client.LastRateLimit()} {
+		if rl == nil {
+			t.Fatalf("expected %s rate limit to be set", name)
+		}
+		if rl.Limit != 100 || rl.Remaining != 7 {
+			t.Errorf("expected %s limit 100/remaining 7, got %d/%d", name, rl.Limit, rl.Remaining)
+		}
+		if rl.Reset.Unix() != reset {
+			t.Errorf("expected %s reset %d, got %d", name, reset, rl.Reset.Unix())
+		}
+	}
+}
+
+func TestClientRequest_ReadYourWrites(t *testing.T) {
+	var gotTokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTokens = append(gotTokens, r.Header.Get("X-Sendly-Consistency-Token"))
+		if r.Method == "POST" {
+			w.Header().Set("X-Sendly-Consistency-Token", "ct_1")
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithReadYourWrites(true))
+	ctx := context.Background()
+
+	var info CallInfo
+	var result map[string]string
+	if err := client.request(WithCallInfo(ctx, &info), "POST", "/test", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.ConsistencyToken != "ct_1" {
+		t.Errorf("expected ConsistencyToken to be 'ct_1', got '%s'", info.ConsistencyToken)
+	}
+	if err := client.request(ctx, "GET", "/test", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.request(WithConsistencyToken(ctx, "ct_explicit"), "GET", "/test", nil, &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"", "ct_1", "ct_explicit"}
+	for i, want := range expected {
+		if gotTokens[i] != want {
+			t.Errorf("request %d: expected token '%s', got '%s'", i, want, gotTokens[i])
+		}
+	}
+}
+
+func TestClientRequest_RetryPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		wantAttempts int
+	}{
+		{name: "retries 503", status: http.StatusServiceUnavailable, wantAttempts: 4},
+		{name: "retries 429", status: http.StatusTooManyRequests, wantAttempts: 4},
+		{name: "does not retry 403", status: http.StatusForbidden, wantAttempts: 1},
+		{name: "does not retry 409", status: http.StatusConflict, wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				w.WriteHeader(tt.status)
+				json.NewEncoder(w).Encode(APIError{Code: "ERROR", Message: "error"})
+			}))
+			defer server.Close()
+
+			client := NewClient("test-api-key", WithBaseURL(server.URL), WithRetry(4, time.Millisecond))
+
+			var result map[string]string
+			if err := client.request(context.Background(), "GET", "/test", nil, &result); err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.wantAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestClientRequest_IdempotencyKey(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"ok": "true"})
+	}))
+	defer server.Close()
+
+	t.Run("explicit key reused across retries", func(t *testing.T) {
+		keys = nil
+		client := NewClient("test-api-key", WithBaseURL(server.URL), WithRetry(2, time.Millisecond))
+		ctx := WithIdempotencyKey(context.Background(), "key-123")
+		if err := client.request(ctx, "POST", "/test", map[string]string{}, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(keys) != 2 || keys[0] != "key-123" || keys[1] != "key-123" {
+			t.Errorf("expected key-123 on both attempts, got %v", keys)
+		}
+	})
+
+	t.Run("auto key reused across retries", func(t *testing.T) {
+		keys = nil
+		client := NewClient("test-api-key", WithBaseURL(server.URL), WithRetry(2, time.Millisecond), WithAutoIdempotency(true))
+		if err := client.request(context.Background(), "POST", "/test", map[string]string{}, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(keys) != 2 || len(keys[0]) != 36 || keys[0] != keys[1] {
+			t.Errorf("expected the same generated key on both attempts, got %v", keys)
+		}
+	})
+
+	t.Run("explicit key not sent on GET", func(t *testing.T) {
+		keys = nil
+		client := NewClient("test-api-key", WithBaseURL(server.URL), WithRetry(2, time.Millisecond))
+		ctx := WithIdempotencyKey(context.Background(), "key-123")
+		if err := client.request(ctx, "GET", "/test", nil, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if keys[0] != "" {
+			t.Errorf("expected no key on GET, got '%s'", keys[0])
+		}
+	})
+
+	t.Run("auto key not sent on GET", func(t *testing.T) {
+		keys = nil
+		client := NewClient("test-api-key", WithBaseURL(server.URL), WithRetry(2, time.Millisecond), WithAutoIdempotency(true))
+		if err := client.request(context.Background(), "GET", "/test", nil, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if keys[0] != "" {
+			t.Errorf("expected no key on GET, got '%s'", keys[0])
+		}
+	})
+}
+
+func TestClientRetryDelay(t *testing.T) {
+	client := NewClient("test-api-key", WithRetry(10, 100*time.Millisecond))
+	client.MaxRetryBackoff = time.Second
+
+	for attempt := 0; attempt < 8; attempt++ {
+		base := 100 * time.Millisecond << uint(attempt)
+		if base > time.Second {
+			base = time.Second
+		}
+		delay := client.retryDelay(attempt, &SendlyError{StatusCode: 500})
+		if delay < base/2 || delay > base {
+			t.Errorf("attempt %d: expected delay in [%v, %v], got %v", attempt, base/2, base, delay)
+		}
+	}
+
+	delay := client.retryDelay(0, &RateLimitError{RetryAfter: 7})
+	if delay != 7*time.Second {
+		t.Errorf("expected Retry-After delay of 7s, got %v", delay)
+	}
+	delay = client.retryDelay(0, fmt.Errorf("send: %w", &RateLimitError{RetryAfter: 7}))
+	if delay != 7*time.Second {
+		t.Errorf("expected Retry-After delay of 7s for a wrapped error, got %v", delay)
+	}
+}
+
+func TestTemplates_RetriedOnServerError(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		json.NewEncoder(w).Encode(TemplateListResponse{})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithRetry(3, time.Millisecond))
+
+	if _, err := client.Templates.List(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
@@ -0,0 +1,176 @@
+package sendly
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// WebhookPayloadFormat is how a webhook's events are encoded.
+type WebhookPayloadFormat string
+
+const (
+	// WebhookFormatSendly sends events as Sendly JSON envelopes (the default).
+	WebhookFormatSendly WebhookPayloadFormat = "sendly"
+	// WebhookFormatCloudEventsStructured sends CloudEvents 1.0 envelopes
+	// as the body, with Content-Type application/cloudevents+json.
+	WebhookFormatCloudEventsStructured WebhookPayloadFormat = "cloudevents_structured"
+	// WebhookFormatCloudEventsBinary sends the event data as the body and
+	// the CloudEvents attributes as ce-* headers.
+	WebhookFormatCloudEventsBinary WebhookPayloadFormat = "cloudevents_binary"
+)
+
+func validatePayloadFormat(f WebhookPayloadFormat) error {
+	switch f {
+	case "", WebhookFormatSendly, WebhookFormatCloudEventsStructured, WebhookFormatCloudEventsBinary:
+		return nil
+	}
+	return invalidParamError("payload_format", "payload format must be sendly, cloudevents_structured or cloudevents_binary")
+}
+
+const (
+	// CloudEventsContentType is the Content-Type of structured-mode
+	// CloudEvents.
+	CloudEventsContentType = "application/cloudevents+json"
+	// CloudEventTypePrefix prefixes Sendly event types in the CloudEvents
+	// type attribute, e.g. "com.sendly.message.delivered".
+	CloudEventTypePrefix = "com.sendly."
+)
+
+// CloudEvent is a Sendly event in CloudEvents 1.0 form.
+type CloudEvent struct {
+	SpecVersion string `json:"specversion"`
+	ID          string `json:"id"`
+	// Source identifies the account and webhook, e.g.
+	// "/accounts/acc_xxx/webhooks/whk_xxx".
+	Source string `json:"source"`
+	// Type is the Sendly event type prefixed with CloudEventTypePrefix.
+	Type string `json:"type"`
+	// Subject is the ID of the resource the event is about, such as the
+	// message ID.
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+	// SendlyAPIVersion is the sendlyapiversion extension attribute, the API
+	// version the data is rendered in.
+	SendlyAPIVersion string `json:"sendlyapiversion,omitempty"`
+}
+
+// EventType returns the Sendly event type, without CloudEventTypePrefix.
+func (ce *CloudEvent) EventType() WebhookEventType {
+	return WebhookEventType(strings.TrimPrefix(ce.Type, CloudEventTypePrefix))
+}
+
+// Event converts ce into an Event with a typed payload.
+func (ce *CloudEvent) Event() (*Event, error) {
+	return ce.event(EventDecodeDefault)
+}
+
+func (ce *CloudEvent) event(mode EventDecodeMode) (*Event, error) {
+	envelope, err := json.Marshal(Event{
+		ID:         ce.ID,
+		Type:       ce.EventType(),
+		Data:       ce.Data,
+		CreatedAt:  ce.Time,
+		APIVersion: ce.SendlyAPIVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse webhook payload: %w", err)
+	}
+	return ParseEventWithMode(envelope, mode)
+}
+
+// IsCloudEvent reports whether a webhook request carries a CloudEvent in
+// either structured or binary mode.
+func IsCloudEvent(header http.Header) bool {
+	if header.Get("Ce-Specversion") != "" {
+		return true
+	}
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	return mediaType == CloudEventsContentType
+}
+
+// ParseCloudEvent decodes a structured or binary mode CloudEvent from a
+// webhook request's headers and body. It does not verify the signature;
+// use ConstructCloudEvent for untrusted input.
+func ParseCloudEvent(header http.Header, body []byte) (*CloudEvent, error) {
+	var ce CloudEvent
+	if header.Get("Ce-Specversion") != "" {
+		ce = CloudEvent{
+			SpecVersion:      header.Get("Ce-Specversion"),
+			ID:               header.Get("Ce-Id"),
+			Source:           header.Get("Ce-Source"),
+			Type:             header.Get("Ce-Type"),
+			Subject:          header.Get("Ce-Subject"),
+			Time:             header.Get("Ce-Time"),
+			DataContentType:  header.Get("Content-Type"),
+			SendlyAPIVersion: header.Get("Ce-Sendlyapiversion"),
+		}
+		if len(body) > 0 {
+			ce.Data = body
+		}
+	} else if err := json.Unmarshal(body, &ce); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook payload: %w", err)
+	}
+
+	if ce.SpecVersion != "1.0" {
+		return nil, fmt.Errorf("unsupported CloudEvents specversion %q", ce.SpecVersion)
+	}
+	if ce.ID == "" || ce.Source == "" || ce.Type == "" {
+		return nil, errors.New("invalid event structure")
+	}
+	return &ce, nil
+}
+
+// ConstructCloudEvent verifies the signature of a CloudEvents webhook
+// request and decodes it into an Event with a typed payload.
+//
+// In structured mode the X-Sendly-Signature header signs the body, as for
+// Sendly envelopes. In binary mode it signs the id, source, type, subject,
+// time and sendlyapiversion attributes and the body joined with newlines,
+// which cannot occur in header values, with absent attributes as empty
+// strings, so the attributes carried in headers cannot be altered either.
+//
+// Example:
+//
+//	event, err := sendly.ConstructCloudEvent(r.Header, body, secret)
+//	if err != nil {
+//	    http.Error(w, "invalid webhook", http.StatusBadRequest)
+//	    return
+//	}
+func ConstructCloudEvent(header http.Header, body []byte, secret string) (*Event, error) {
+	return constructCloudEvent(header, body, EventSecrets{Default: secret}, EventDecodeDefault)
+}
+
+func constructCloudEvent(header http.Header, body []byte, secrets EventSecrets, mode EventDecodeMode) (*Event, error) {
+	ce, err := ParseCloudEvent(header, body)
+	if err != nil {
+		return nil, err
+	}
+	secret := secrets.For(ce.EventType())
+	if secret == "" || !(Webhooks{}).VerifySignature(cloudEventSignedPayload(header, body), header.Get(SignatureHeader), secret) {
+		return nil, ErrInvalidSignature
+	}
+	return ce.event(mode)
+}
+
+// cloudEventSignedPayload returns the string a CloudEvents request's
+// signature covers.
+func cloudEventSignedPayload(header http.Header, body []byte) string {
+	if header.Get("Ce-Specversion") == "" {
+		return string(body)
+	}
+	return strings.Join([]string{
+		header.Get("Ce-Id"),
+		header.Get("Ce-Source"),
+		header.Get("Ce-Type"),
+		header.Get("Ce-Subject"),
+		header.Get("Ce-Time"),
+		header.Get("Ce-Sendlyapiversion"),
+		string(body),
+	}, "\n")
+}
@@ -0,0 +1,160 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestConstructCloudEvent(t *testing.T) {
+	const secret = "whsec_test"
+	data := `{"message_id":"msg_1","status":"delivered"}`
+
+	structured := `{"specversion":"1.0","id":"evt_1","source":"/accounts/acc_1/webhooks/whk_1","type":"com.sendly.message.delivered","time":"2024-01-01T00:00:00Z","datacontenttype":"application/json","sendlyapiversion":"2024-06-01","data":` + data + `}`
+	structuredHeader := http.Header{}
+	structuredHeader.Set("Content-Type", CloudEventsContentType+"; charset=utf-8")
+	structuredHeader.Set(SignatureHeader, Webhooks{}.GenerateSignature(structured, secret))
+
+	binaryHeader := http.Header{}
+	binaryHeader.Set("Content-Type", "application/json")
+	binaryHeader.Set("Ce-Specversion", "1.0")
+	binaryHeader.Set("Ce-Id", "evt_1")
+	binaryHeader.Set("Ce-Source", "/accounts/acc_1/webhooks/whk_1")
+	binaryHeader.Set("Ce-Type", "com.sendly.message.delivered")
+	binaryHeader.Set("Ce-Subject", "msg_1")
+	binaryHeader.Set("Ce-Time", "2024-01-01T00:00:00Z")
+	binaryHeader.Set("Ce-Sendlyapiversion", "2024-06-01")
+	binaryHeader.Set(SignatureHeader, Webhooks{}.GenerateSignature("evt_1\n/accounts/acc_1/webhooks/whk_1\ncom.sendly.message.delivered\nmsg_1\n2024-01-01T00:00:00Z\n2024-06-01\n"+data, secret))
+
+	tests := []struct {
+		name   string
+		header http.Header
+		body   string
+	}{
+		{"structured", structuredHeader, structured},
+		{"binary", binaryHeader, data},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !IsCloudEvent(tt.header) {
+				t.Fatal("expected request to be detected as a CloudEvent")
+			}
+			event, err := ConstructCloudEvent(tt.header, []byte(tt.body), secret)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if event.ID != "evt_1" || event.Type != WebhookEventMessageDelivered || event.APIVersion != "2024-06-01" {
+				t.Errorf("unexpected event: %+v", event)
+			}
+			if msg, ok := event.Message(); !ok || msg.MessageID != "msg_1" {
+				t.Errorf("unexpected payload: %+v", event.Payload)
+			}
+			if _, err := ConstructCloudEvent(tt.header, []byte(tt.body), "whsec_other"); err != ErrInvalidSignature {
+				t.Errorf("expected ErrInvalidSignature, got %v", err)
+			}
+		})
+	}
+
+	for _, attr := range []string{"Ce-Id", "Ce-Source", "Ce-Type", "Ce-Subject", "Ce-Time", "Ce-Sendlyapiversion"} {
+		tampered := binaryHeader.Clone()
+		tampered.Set(attr, tampered.Get(attr)+"x")
+		if _, err := ConstructCloudEvent(tampered, []byte(data), secret); err != ErrInvalidSignature {
+			t.Errorf("expected ErrInvalidSignature for altered %s, got %v", attr, err)
+		}
+	}
+
+	// Moving text across an attribute boundary changes the signed string.
+	shifted := binaryHeader.Clone()
+	shifted.Del("Ce-Subject")
+	shifted.Set(SignatureHeader, Webhooks{}.GenerateSignature("evt_1\n/accounts/acc_1/webhooks/whk_1\ncom.sendly.message\ndelivered\n2024-01-01T00:00:00Z\n2024-06-01\n"+data, secret))
+	shifted.Set("Ce-Type", "com.sendly.message.delivered")
+	if _, err := ConstructCloudEvent(shifted, []byte(data), secret); err != ErrInvalidSignature {
+		t.Errorf("expected ErrInvalidSignature for a shifted attribute boundary, got %v", err)
+	}
+	if cloudEventSignedPayload(shifted, nil) == cloudEventSignedPayload(http.Header{
+		"Ce-Specversion": {"1.0"}, "Ce-Id": {"evt_1"}, "Ce-Source": {"/accounts/acc_1/webhooks/whk_1"},
+		"Ce-Type": {"com.sendly.message"}, "Ce-Subject": {"delivered"},
+		"Ce-Time": {"2024-01-01T00:00:00Z"}, "Ce-Sendlyapiversion": {"2024-06-01"},
+	}, nil) {
+		t.Error("expected distinct attribute splits to sign distinct strings")
+	}
+	if IsCloudEvent(http.Header{"Content-Type": {"application/json"}}) {
+		t.Error("expected Sendly envelope not to be detected as a CloudEvent")
+	}
+}
+
+func TestParseCloudEvent_Invalid(t *testing.T) {
+	header := http.Header{"Content-Type": {CloudEventsContentType}}
+	tests := []string{
+		`not json`,
+		`{"specversion":"0.3","id":"evt_1","source":"/s","type":"com.sendly.message.sent"}`,
+		`{"specversion":"1.0","source":"/s","type":"com.sendly.message.sent"}`,
+	}
+	for i, body := range tests {
+		if _, err := ParseCloudEvent(header, []byte(body)); err == nil {
+			t.Errorf("case %d: expected error", i)
+		}
+	}
+}
+
+func TestWebhookHandler_CloudEvents(t *testing.T) {
+	const secret = "whsec_test"
+	body := `{"specversion":"1.0","id":"evt_1","source":"/accounts/acc_1/webhooks/whk_1","type":"com.sendly.verify.completed","time":"2024-01-01T00:00:00Z","data":{"id":"ver_1","status":"verified"}}`
+
+	var got *Event
+	handler := Webhooks{}.Handler(secret, func(e *Event) error {
+		got = e
+		return nil
+	})
+	req := httptest.NewRequest("POST", "/webhooks", strings.NewReader(body))
+	req.Header.Set("Content-Type", CloudEventsContentType)
+	req.Header.Set(SignatureHeader, Webhooks{}.GenerateSignature(body, secret))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if got == nil || got.Type != WebhookEventVerifyCompleted {
+		t.Errorf("unexpected event: %+v", got)
+	}
+}
+
+func TestWebhooksService_PayloadFormat(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["payload_format"] != "cloudevents_binary" {
+			t.Errorf("expected payload_format to be 'cloudevents_binary', got '%v'", body["payload_format"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"whk_1","url":"https://example.com/hook","events":["message.delivered"],"payload_format":"cloudevents_binary","secret":"whsec_1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+	created, err := client.WebhooksService.Create(ctx, CreateWebhookRequest{
+		URL:           "https://example.com/hook",
+		Events:        []string{"message.delivered"},
+		PayloadFormat: WebhookFormatCloudEventsBinary,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.PayloadFormat != WebhookFormatCloudEventsBinary {
+		t.Errorf("expected PayloadFormat to be 'cloudevents_binary', got '%s'", created.PayloadFormat)
+	}
+
+	_, err = client.WebhooksService.Create(ctx, CreateWebhookRequest{
+		URL:           "https://example.com/hook",
+		Events:        []string{"message.delivered"},
+		PayloadFormat: "cloudevents",
+	})
+	if !IsValidationError(err) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
@@ -0,0 +1,22 @@
+package sendly
+
+import "encoding/json"
+
+// Codec encodes request bodies and decodes response bodies. Implementations
+// must be safe for concurrent use and follow encoding/json semantics for
+// struct tags, omitempty, and interface{} decoding (numbers as float64).
+// Drop-in replacements such as jsoniter's ConfigCompatibleWithStandardLibrary
+// or sonic's ConfigStd satisfy this interface.
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// StdCodec is the default Codec, backed by encoding/json.
+type StdCodec struct{}
+
+// Marshal encodes v using encoding/json.
+func (StdCodec) Marshal(v interface{}) ([]byte, error) { return json.Marshal(v) }
+
+// Unmarshal decodes data into v using encoding/json.
+func (StdCodec) Unmarshal(data []byte, v interface{}) error { return json.Unmarshal(data, v) }
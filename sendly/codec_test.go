@@ -0,0 +1,109 @@
+package sendly
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+// streamCodec is an alternative Codec built on json.Encoder/Decoder, used to
+// check that the SDK makes no assumptions beyond the Codec contract.
+type streamCodec struct {
+	marshals   int32
+	unmarshals int32
+}
+
+func (c *streamCodec) Marshal(v interface{}) ([]byte, error) {
+	atomic.AddInt32(&c.marshals, 1)
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+func (c *streamCodec) Unmarshal(data []byte, v interface{}) error {
+	atomic.AddInt32(&c.unmarshals, 1)
+	return json.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// TestCodecConformance checks that each codec round-trips SDK types exactly
+// like encoding/json does. Add new codecs to the codecs table.
+func TestCodecConformance(t *testing.T) {
+	codecs := map[string]Codec{
+		"std":    StdCodec{},
+		"stream": &streamCodec{},
+	}
+
+	errMsg := "carrier rejected"
+	shorten := true
+	values := []interface{}{
+		&Message{ID: "msg_1", To: "+15551234567", Text: "Hi <b>&</b> ünïcode", Status: MessageStatusFailed, Error: &errMsg},
+		&SendMessageRequest{To: "+15551234567", Text: "Hi", Links: &LinkOptions{Shorten: &shorten}},
+		&Webhook{ID: "whk_1", Events: []string{"message.sent"}, Metadata: map[string]interface{}{"n": float64(1), "nested": map[string]interface{}{"a": "b"}}},
+		&APIError{Code: "E", Message: "m", Details: map[string]interface{}{"list": []interface{}{"x", float64(2)}}},
+	}
+
+	for name, codec := range codecs {
+		t.Run(name, func(t *testing.T) {
+			for _, v := range values {
+				data, err := codec.Marshal(v)
+				if err != nil {
+					t.Fatalf("Marshal(%T): %v", v, err)
+				}
+				want, _ := json.Marshal(v)
+				if !bytes.Equal(normalizeJSON(t, data), normalizeJSON(t, want)) {
+					t.Errorf("Marshal(%T) = %s, want %s", v, data, want)
+				}
+
+				got := reflect.New(reflect.TypeOf(v).Elem()).Interface()
+				if err := codec.Unmarshal(data, got); err != nil {
+					t.Fatalf("Unmarshal(%T): %v", v, err)
+				}
+				if !reflect.DeepEqual(got, v) {
+					t.Errorf("round trip of %T = %+v, want %+v", v, got, v)
+				}
+			}
+		})
+	}
+}
+
+// normalizeJSON re-encodes data so semantically equal documents compare equal.
+func normalizeJSON(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatalf("invalid JSON %s: %v", data, err)
+	}
+	out, _ := json.Marshal(v)
+	return out
+}
+
+func TestClient_UsesCodec(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Message{ID: "msg_123", Status: MessageStatusQueued})
+	}))
+	defer server.Close()
+
+	codec := &streamCodec{}
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithCodec(codec))
+
+	msg, err := client.Messages.Send(context.Background(), &SendMessageRequest{To: "+1234567890", Text: "Hi"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.ID != "msg_123" {
+		t.Errorf("expected ID to be 'msg_123', got '%s'", msg.ID)
+	}
+	if codec.marshals != 1 || codec.unmarshals != 1 {
+		t.Errorf("expected 1 marshal and 1 unmarshal, got %d and %d", codec.marshals, codec.unmarshals)
+	}
+}
@@ -0,0 +1,65 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// consistencyTokenHeader carries read-your-writes consistency tokens in both
+// directions: servers return it on mutating responses, and reads that send it
+// are guaranteed to observe those writes.
+const consistencyTokenHeader = "X-Sendly-Consistency-Token"
+
+type consistencyTokenKey struct{}
+
+// WithConsistencyToken returns a context that makes reads observe at least the
+// writes covered by token. Tokens are returned in CallInfo.ConsistencyToken.
+//
+// Example:
+//
+//	var info sendly.CallInfo
+//	client.WebhooksService.Create(sendly.WithCallInfo(ctx, &info), req)
+//	webhooks, err := client.WebhooksService.List(sendly.WithConsistencyToken(ctx, info.ConsistencyToken))
+func WithConsistencyToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, consistencyTokenKey{}, token)
+}
+
+// consistencyTokenFromContext returns the consistency token attached to ctx, if any.
+func consistencyTokenFromContext(ctx context.Context) string {
+	token, _ := ctx.Value(consistencyTokenKey{}).(string)
+	return token
+}
+
+// consistencyTracker remembers the most recent consistency token seen by a client.
+type consistencyTracker struct {
+	mu    sync.Mutex
+	token string
+}
+
+func (t *consistencyTracker) get() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.token
+}
+
+func (t *consistencyTracker) set(token string) {
+	if token == "" {
+		return
+	}
+	t.mu.Lock()
+	t.token = token
+	t.mu.Unlock()
+}
+
+// applyConsistencyToken sets the consistency token header on req, preferring
+// an explicit token from ctx over the client's most recent one.
+func (c *Client) applyConsistencyToken(ctx context.Context, req *http.Request) {
+	token := consistencyTokenFromContext(ctx)
+	if token == "" && c.ReadYourWrites {
+		token = c.consistency.get()
+	}
+	if token != "" {
+		req.Header.Set(consistencyTokenHeader, token)
+	}
+}
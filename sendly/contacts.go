@@ -0,0 +1,300 @@
+package sendly
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ContactsService provides contact management operations.
+type ContactsService struct {
+	client *Client
+}
+
+// MaxContactImportSize is the maximum number of contacts in one Import call.
+const MaxContactImportSize = 10000
+
+// ContactOptInStatus represents a contact's messaging consent.
+type ContactOptInStatus string
+
+const (
+	ContactOptInUnknown      ContactOptInStatus = "unknown"
+	ContactOptInPending      ContactOptInStatus = "pending"
+	ContactOptInSubscribed   ContactOptInStatus = "subscribed"
+	ContactOptInUnsubscribed ContactOptInStatus = "unsubscribed"
+)
+
+// Contact represents a phone-book entry.
+type Contact struct {
+	ID          string                 `json:"id"`
+	Phone       string                 `json:"phone"`
+	Email       string                 `json:"email,omitempty"`
+	FirstName   string                 `json:"first_name,omitempty"`
+	LastName    string                 `json:"last_name,omitempty"`
+	Attributes  map[string]interface{} `json:"attributes,omitempty"`
+	OptInStatus ContactOptInStatus     `json:"opt_in_status"`
+	OptedInAt   string                 `json:"opted_in_at,omitempty"`
+	OptedOutAt  string                 `json:"opted_out_at,omitempty"`
+	CreatedAt   string                 `json:"created_at"`
+	UpdatedAt   string                 `json:"updated_at"`
+}
+
+// CreateContactRequest represents the parameters for creating a contact.
+type CreateContactRequest struct {
+	// Phone is the contact's phone number in E.164 format (required).
+	Phone     string `json:"phone"`
+	Email     string `json:"email,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	// Attributes are custom key/value pairs, usable as template variables.
+	Attributes  map[string]interface{} `json:"attributes,omitempty"`
+	OptInStatus ContactOptInStatus     `json:"opt_in_status,omitempty"`
+}
+
+// UpdateContactRequest represents the parameters for updating a contact.
+// Nil fields are left unchanged. Attributes are merged; set a key to nil to
+// remove it.
+type UpdateContactRequest struct {
+	Phone       *string                `json:"phone,omitempty"`
+	Email       *string                `json:"email,omitempty"`
+	FirstName   *string                `json:"first_name,omitempty"`
+	LastName    *string                `json:"last_name,omitempty"`
+	Attributes  map[string]interface{} `json:"attributes,omitempty"`
+	OptInStatus *ContactOptInStatus    `json:"opt_in_status,omitempty"`
+}
+
+// ContactListOptions are options for listing contacts.
+type ContactListOptions struct {
+	// Limit is the maximum number of contacts per page (default: 50, max: 200).
+	Limit int
+	// Cursor continues from a previous page's NextCursor.
+	Cursor string
+	// Search matches against phone, email and name.
+	Search string
+	// OptInStatus filters by consent status.
+	OptInStatus ContactOptInStatus
+	// Attributes filters by exact custom attribute values.
+	Attributes map[string]string
+}
+
+// ContactListResponse is a page of contacts.
+type ContactListResponse struct {
+	Data       []Contact `json:"data"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+	HasMore    bool      `json:"has_more"`
+}
+
+// ImportContactsRequest represents a bulk contact import.
+type ImportContactsRequest struct {
+	Contacts []CreateContactRequest `json:"contacts"`
+	// UpdateExisting updates contacts whose phone number already exists
+	// instead of skipping them.
+	UpdateExisting bool `json:"update_existing,omitempty"`
+}
+
+// ContactImportError describes a row that could not be imported.
+type ContactImportError struct {
+	Index   int    `json:"index"`
+	Phone   string `json:"phone"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ImportContactsResponse summarises a bulk contact import.
+type ImportContactsResponse struct {
+	Created int                  `json:"created"`
+	Updated int                  `json:"updated"`
+	Skipped int                  `json:"skipped"`
+	Errors  []ContactImportError `json:"errors,omitempty"`
+}
+
+// Create creates a contact.
+func (s *ContactsService) Create(ctx context.Context, req *CreateContactRequest) (*Contact, error) {
+	if req == nil || req.Phone == "" {
+		return nil, invalidParamError("phone", "phone is required")
+	}
+
+	var resp Contact
+	if err := s.client.request(ctx, "POST", "/contacts", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get retrieves a contact by ID.
+func (s *ContactsService) Get(ctx context.Context, id string) (*Contact, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "contact ID is required")
+	}
+
+	var resp Contact
+	if err := s.client.request(ctx, "GET", "/contacts/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Update updates a contact.
+func (s *ContactsService) Update(ctx context.Context, id string, req *UpdateContactRequest) (*Contact, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "contact ID is required")
+	}
+	if req == nil {
+		return nil, &ValidationError{APIError: APIError{Message: "request is required"}}
+	}
+
+	var resp Contact
+	if err := s.client.request(ctx, "PATCH", "/contacts/"+url.PathEscape(id), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Delete deletes a contact.
+func (s *ContactsService) Delete(ctx context.Context, id string) error {
+	if id == "" {
+		return invalidParamError("id", "contact ID is required")
+	}
+	return s.client.request(ctx, "DELETE", "/contacts/"+url.PathEscape(id), nil, nil)
+}
+
+// List retrieves a page of contacts.
+func (s *ContactsService) List(ctx context.Context, opts *ContactListOptions) (*ContactListResponse, error) {
+	params := make(map[string]string)
+	if opts != nil {
+		if opts.Limit > 0 {
+			params["limit"] = strconv.Itoa(opts.Limit)
+		}
+		params["cursor"] = opts.Cursor
+		params["search"] = opts.Search
+		params["opt_in_status"] = string(opts.OptInStatus)
+		for k, v := range opts.Attributes {
+			params["attributes["+k+"]"] = v
+		}
+	}
+
+	var resp ContactListResponse
+	if err := s.client.request(ctx, "GET", "/contacts"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAll returns every contact matching opts as an Iter, fetching pages as
+// needed.
+func (s *ContactsService) ListAll(ctx context.Context, opts *ContactListOptions) Iter[Contact] {
+	var o ContactListOptions
+	if opts != nil {
+		o = *opts
+	}
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[Contact], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[Contact]{Items: resp.Data}
+		if resp.HasMore {
+			page.NextCursor = resp.NextCursor
+		}
+		return page, nil
+	}).Iter()
+}
+
+// Import creates up to MaxContactImportSize contacts in one call. Rows that
+// fail are reported in the response rather than failing the whole import.
+func (s *ContactsService) Import(ctx context.Context, req *ImportContactsRequest) (*ImportContactsResponse, error) {
+	if req == nil || len(req.Contacts) == 0 {
+		return nil, invalidParamError("contacts", "at least one contact is required")
+	}
+	if len(req.Contacts) > MaxContactImportSize {
+		return nil, invalidParamError("contacts", fmt.Sprintf("cannot import more than %d contacts at once", MaxContactImportSize))
+	}
+
+	var resp ImportContactsResponse
+	if err := s.client.request(ctx, "POST", "/contacts/import", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ContactActivityType represents the kind of a contact timeline entry.
+type ContactActivityType string
+
+const (
+	ContactActivityMessageOutbound    ContactActivityType = "message.outbound"
+	ContactActivityMessageInbound     ContactActivityType = "message.inbound"
+	ContactActivityVerification       ContactActivityType = "verification"
+	ContactActivityOptIn              ContactActivityType = "opt_in"
+	ContactActivityOptOut             ContactActivityType = "opt_out"
+	ContactActivityCampaignMembership ContactActivityType = "campaign_membership"
+)
+
+// ContactActivity is a single entry in a contact's activity timeline.
+type ContactActivity struct {
+	ID         string                 `json:"id"`
+	Type       ContactActivityType    `json:"type"`
+	OccurredAt string                 `json:"occurred_at"`
+	Summary    string                 `json:"summary"`
+	ResourceID string                 `json:"resource_id,omitempty"`
+	Status     string                 `json:"status,omitempty"`
+	Data       map[string]interface{} `json:"data,omitempty"`
+}
+
+// ContactActivityOptions are options for listing a contact's activity.
+type ContactActivityOptions struct {
+	// Limit is the maximum number of entries to return (default: 50, max: 200).
+	Limit int
+	// Cursor continues from a previous page's NextCursor.
+	Cursor string
+	// Types restricts the timeline to the given activity types.
+	Types []ContactActivityType
+	// Since returns entries at or after this ISO 8601 time.
+	Since string
+	// Until returns entries before this ISO 8601 time.
+	Until string
+}
+
+// ContactActivityPage is a page of a contact's activity timeline, newest first.
+type ContactActivityPage struct {
+	Data       []ContactActivity `json:"data"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+	HasMore    bool              `json:"has_more"`
+}
+
+// Activity returns a merged timeline of messages, verifications, opt-in/out
+// events, and campaign membership for one contact.
+func (s *ContactsService) Activity(ctx context.Context, contactID string, opts *ContactActivityOptions) (*ContactActivityPage, error) {
+	if contactID == "" {
+		return nil, invalidParamError("contact_id", "contact ID is required")
+	}
+
+	params := make(map[string]string)
+	if opts != nil {
+		if opts.Limit > 0 {
+			params["limit"] = strconv.Itoa(opts.Limit)
+		}
+		params["cursor"] = opts.Cursor
+		params["since"] = opts.Since
+		params["until"] = opts.Until
+		if len(opts.Types) > 0 {
+			types := make([]string, len(opts.Types))
+			for i, t := range opts.Types {
+				types[i] = string(t)
+			}
+			params["types"] = strings.Join(types, ",")
+		}
+	}
+
+	path := "/contacts/" + url.PathEscape(contactID) + "/activity" + buildQueryString(params)
+
+	var resp ContactActivityPage
+	if err := s.client.request(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,92 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestContactsService_List(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("opt_in_status") != "subscribed" {
+			t.Errorf("expected opt_in_status to be 'subscribed', got '%s'", q.Get("opt_in_status"))
+		}
+		if q.Get("attributes[plan]") != "pro" {
+			t.Errorf("expected attributes[plan] to be 'pro', got '%s'", q.Get("attributes[plan]"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"id":"con_1","phone":"+15551234567","attributes":{"plan":"pro"},"opt_in_status":"subscribed"}],"has_more":false}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	resp, err := client.Contacts.List(context.Background(), &ContactListOptions{
+		OptInStatus: ContactOptInSubscribed,
+		Attributes:  map[string]string{"plan": "pro"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].Attributes["plan"] != "pro" {
+		t.Errorf("unexpected contacts: %+v", resp.Data)
+	}
+}
+
+func TestContactsService_Validation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	if _, err := client.Contacts.Create(ctx, &CreateContactRequest{}); !IsValidationError(err) {
+		t.Errorf("expected validation error for missing phone, got %v", err)
+	}
+	if _, err := client.Contacts.Import(ctx, &ImportContactsRequest{}); !IsValidationError(err) {
+		t.Errorf("expected validation error for empty import, got %v", err)
+	}
+	big := &ImportContactsRequest{Contacts: make([]CreateContactRequest, MaxContactImportSize+1)}
+	if _, err := client.Contacts.Import(ctx, big); !IsValidationError(err) {
+		t.Errorf("expected validation error for oversized import, got %v", err)
+	}
+}
+
+func TestContactsService_Activity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/contacts/con_1/activity" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("types") != "message.inbound,opt_out" || q.Get("limit") != "20" || q.Get("since") != "2025-01-01T00:00:00Z" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		if q.Has("cursor") || q.Has("until") {
+			t.Errorf("expected unset options to be omitted, got %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[
+			{"id":"act_2","type":"opt_out","occurred_at":"2025-01-03T00:00:00Z","summary":"Replied STOP"},
+			{"id":"act_1","type":"message.inbound","occurred_at":"2025-01-02T00:00:00Z","summary":"Inbound message","resource_id":"msg_1","data":{"text":"hi"}}
+		],"next_cursor":"cur_2","has_more":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	page, err := client.Contacts.Activity(context.Background(), "con_1", &ContactActivityOptions{
+		Limit: 20,
+		Types: []ContactActivityType{ContactActivityMessageInbound, ContactActivityOptOut},
+		Since: "2025-01-01T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Data) != 2 || !page.HasMore || page.NextCursor != "cur_2" {
+		t.Fatalf("unexpected page: %+v", page)
+	}
+	if page.Data[0].Type != ContactActivityOptOut || page.Data[1].ResourceID != "msg_1" || page.Data[1].Data["text"] != "hi" {
+		t.Errorf("unexpected activity: %+v", page.Data)
+	}
+
+	if _, err := client.Contacts.Activity(context.Background(), "", nil); !IsValidationError(err) {
+		t.Errorf("expected validation error for missing contact ID, got %v", err)
+	}
+}
@@ -0,0 +1,302 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// ConversationsService provides access to inbound messages and two-way
+// conversation threads.
+type ConversationsService struct {
+	client *Client
+}
+
+// MessageDirection indicates whether a message was sent or received.
+type MessageDirection string
+
+const (
+	MessageDirectionInbound  MessageDirection = "inbound"
+	MessageDirectionOutbound MessageDirection = "outbound"
+)
+
+// InboundMessage is an SMS received on one of your numbers.
+type InboundMessage struct {
+	ID string `json:"id"`
+	// ConversationID is the thread this message belongs to.
+	ConversationID string `json:"conversation_id"`
+	// From is the sender's phone number in E.164 format.
+	From string `json:"from"`
+	// To is your number that received the message.
+	To         string `json:"to"`
+	Text       string `json:"text"`
+	Segments   int    `json:"segments"`
+	Read       bool   `json:"read"`
+	ReceivedAt string `json:"received_at"`
+	// Keyword is set when the message matched an opt-in or opt-out keyword.
+	Keyword string `json:"keyword,omitempty"`
+	// Media lists MMS attachments. Fetch their content with
+	// MediaService.Download.
+	Media []Media `json:"media,omitempty"`
+}
+
+// InboundListOptions are options for listing inbound messages.
+type InboundListOptions struct {
+	// Limit is the maximum number of messages per page (default: 50, max: 200).
+	Limit int
+	// Cursor continues from a previous page's NextCursor.
+	Cursor string
+	// From filters by sender phone number.
+	From string
+	// To filters by receiving number.
+	To string
+	// Unread restricts results to unread messages when true.
+	Unread bool
+	// Since and Until filter by received time (ISO 8601).
+	Since string
+	Until string
+}
+
+// InboundListResponse is a page of inbound messages.
+type InboundListResponse struct {
+	Data       []InboundMessage `json:"data"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+	HasMore    bool             `json:"has_more"`
+}
+
+// Conversation is a thread of messages exchanged with one phone number.
+type Conversation struct {
+	ID string `json:"id"`
+	// Phone is the other party's phone number in E.164 format.
+	Phone string `json:"phone"`
+	// Number is your number used in the conversation.
+	Number          string           `json:"number"`
+	ContactID       string           `json:"contact_id,omitempty"`
+	MessageCount    int              `json:"message_count"`
+	UnreadCount     int              `json:"unread_count"`
+	LastMessageText string           `json:"last_message_text"`
+	LastDirection   MessageDirection `json:"last_direction"`
+	LastMessageAt   string           `json:"last_message_at"`
+	CreatedAt       string           `json:"created_at"`
+}
+
+// ConversationMessage is a message within a conversation, in either
+// direction.
+type ConversationMessage struct {
+	ID        string           `json:"id"`
+	Direction MessageDirection `json:"direction"`
+	From      string           `json:"from"`
+	To        string           `json:"to"`
+	Text      string           `json:"text"`
+	// Status is the delivery status of outbound messages.
+	Status    MessageStatus `json:"status,omitempty"`
+	Read      bool          `json:"read"`
+	CreatedAt string        `json:"created_at"`
+}
+
+// ConversationListOptions are options for listing conversations or the
+// messages in one.
+type ConversationListOptions struct {
+	// Limit is the maximum number of results per page (default: 50, max: 200).
+	Limit int
+	// Cursor continues from a previous page's NextCursor.
+	Cursor string
+	// Unread restricts conversations to those with unread messages when true.
+	// It is ignored when listing a conversation's messages.
+	Unread bool
+}
+
+// ConversationListResponse is a page of conversations.
+type ConversationListResponse struct {
+	Data       []Conversation `json:"data"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+	HasMore    bool           `json:"has_more"`
+}
+
+// ConversationMessagesResponse is a page of messages in a conversation.
+type ConversationMessagesResponse struct {
+	Data       []ConversationMessage `json:"data"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+	HasMore    bool                  `json:"has_more"`
+}
+
+// MarkReadResult reports how many messages were marked read.
+type MarkReadResult struct {
+	Updated int `json:"updated"`
+}
+
+// ListInbound retrieves a page of inbound messages, newest first.
+func (s *ConversationsService) ListInbound(ctx context.Context, opts *InboundListOptions) (*InboundListResponse, error) {
+	params := make(map[string]string)
+	if opts != nil {
+		if opts.Limit > 0 {
+			params["limit"] = strconv.Itoa(opts.Limit)
+		}
+		params["cursor"] = opts.Cursor
+		params["from"] = opts.From
+		params["to"] = opts.To
+		if opts.Unread {
+			params["unread"] = "true"
+		}
+		params["since"] = opts.Since
+		params["until"] = opts.Until
+	}
+
+	var resp InboundListResponse
+	if err := s.client.request(ctx, "GET", "/messages/inbound"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAllInbound returns every inbound message matching opts as an Iter,
+// fetching pages as needed.
+func (s *ConversationsService) ListAllInbound(ctx context.Context, opts *InboundListOptions) Iter[InboundMessage] {
+	var o InboundListOptions
+	if opts != nil {
+		o = *opts
+	}
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[InboundMessage], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.ListInbound(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[InboundMessage]{Items: resp.Data}
+		if resp.HasMore {
+			page.NextCursor = resp.NextCursor
+		}
+		return page, nil
+	}).Iter()
+}
+
+// GetInbound retrieves an inbound message by ID.
+func (s *ConversationsService) GetInbound(ctx context.Context, id string) (*InboundMessage, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "message ID is required")
+	}
+
+	var resp InboundMessage
+	if err := s.client.request(ctx, "GET", "/messages/inbound/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (o *ConversationListOptions) params() map[string]string {
+	params := make(map[string]string)
+	if o != nil {
+		if o.Limit > 0 {
+			params["limit"] = strconv.Itoa(o.Limit)
+		}
+		params["cursor"] = o.Cursor
+		if o.Unread {
+			params["unread"] = "true"
+		}
+	}
+	return params
+}
+
+// List retrieves a page of conversations, most recently active first.
+func (s *ConversationsService) List(ctx context.Context, opts *ConversationListOptions) (*ConversationListResponse, error) {
+	var resp ConversationListResponse
+	if err := s.client.request(ctx, "GET", "/conversations"+buildQueryString(opts.params()), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAll returns every conversation matching opts as an Iter, fetching
+// pages as needed.
+func (s *ConversationsService) ListAll(ctx context.Context, opts *ConversationListOptions) Iter[Conversation] {
+	var o ConversationListOptions
+	if opts != nil {
+		o = *opts
+	}
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[Conversation], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[Conversation]{Items: resp.Data}
+		if resp.HasMore {
+			page.NextCursor = resp.NextCursor
+		}
+		return page, nil
+	}).Iter()
+}
+
+// Get retrieves a conversation by ID.
+func (s *ConversationsService) Get(ctx context.Context, id string) (*Conversation, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "conversation ID is required")
+	}
+
+	var resp Conversation
+	if err := s.client.request(ctx, "GET", "/conversations/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetByPhone retrieves the conversation with a phone number.
+func (s *ConversationsService) GetByPhone(ctx context.Context, phone string) (*Conversation, error) {
+	if phone == "" {
+		return nil, invalidParamError("phone", "phone number is required")
+	}
+
+	var resp Conversation
+	path := "/conversations/lookup" + buildQueryString(map[string]string{"phone": phone})
+	if err := s.client.request(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Messages retrieves a page of a conversation's messages, newest first.
+func (s *ConversationsService) Messages(ctx context.Context, id string, opts *ConversationListOptions) (*ConversationMessagesResponse, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "conversation ID is required")
+	}
+
+	params := opts.params()
+	delete(params, "unread")
+	path := "/conversations/" + url.PathEscape(id) + "/messages" + buildQueryString(params)
+	var resp ConversationMessagesResponse
+	if err := s.client.request(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// MarkRead marks every unread message in a conversation as read.
+func (s *ConversationsService) MarkRead(ctx context.Context, id string) (*MarkReadResult, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "conversation ID is required")
+	}
+
+	var resp MarkReadResult
+	if err := s.client.request(ctx, "POST", "/conversations/"+url.PathEscape(id)+"/read", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// MarkInboundRead marks individual inbound messages as read.
+func (s *ConversationsService) MarkInboundRead(ctx context.Context, ids []string) (*MarkReadResult, error) {
+	if len(ids) == 0 {
+		return nil, invalidParamError("ids", "at least one message ID is required")
+	}
+
+	body := map[string]interface{}{"ids": ids}
+	var resp MarkReadResult
+	if err := s.client.request(ctx, "POST", "/messages/inbound/read", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
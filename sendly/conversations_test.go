@@ -0,0 +1,59 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestConversationsService_ListAll(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/conversations" {
+			t.Errorf("expected path to be '/conversations', got '%s'", r.URL.Path)
+		}
+		if r.URL.Query().Get("unread") != "true" {
+			t.Errorf("expected unread to be 'true', got '%s'", r.URL.Query().Get("unread"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			w.Write([]byte(`{"data":[{"id":"conv_1","phone":"+15551234567","unread_count":2}],"next_cursor":"c2","has_more":true}`))
+			return
+		}
+		w.Write([]byte(`{"data":[{"id":"conv_2","phone":"+15557654321","unread_count":1}],"has_more":false}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	conversations, err := client.Conversations.ListAll(context.Background(), &ConversationListOptions{Unread: true}).Collect()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(conversations) != 2 || conversations[1].ID != "conv_2" {
+		t.Errorf("unexpected conversations: %+v", conversations)
+	}
+}
+
+func TestConversationsService_MarkRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/conversations/conv_1/read" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"updated":3}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	result, err := client.Conversations.MarkRead(context.Background(), "conv_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Updated != 3 {
+		t.Errorf("expected Updated to be 3, got %d", result.Updated)
+	}
+
+	if _, err := client.Conversations.MarkInboundRead(context.Background(), nil); !IsValidationError(err) {
+		t.Errorf("expected validation error for empty IDs, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// requestTimeoutHeader tells the API how many milliseconds remain before
+	// the caller gives up, so it can shed work that cannot finish in time.
+	requestTimeoutHeader = "X-Sendly-Request-Timeout"
+	// priorityHeader carries the caller's RequestPriority.
+	priorityHeader = "X-Sendly-Priority"
+	// processingTimeHeader reports server-side processing time in milliseconds.
+	processingTimeHeader = "X-Sendly-Processing-Time"
+)
+
+// RequestPriority hints how the API should schedule a request under load.
+type RequestPriority string
+
+const (
+	// PriorityLow marks work that may be delayed or shed first, such as
+	// reports and bulk exports.
+	PriorityLow RequestPriority = "low"
+	// PriorityNormal is the default.
+	PriorityNormal RequestPriority = "normal"
+	// PriorityHigh marks latency-sensitive work such as verification codes.
+	PriorityHigh RequestPriority = "high"
+)
+
+type priorityKey struct{}
+
+// WithPriority returns a context that sends priority as a scheduling hint
+// with every request made using it.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+//	defer cancel()
+//	client.Verify.Send(sendly.WithPriority(ctx, sendly.PriorityHigh), req)
+func WithPriority(ctx context.Context, priority RequestPriority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// priorityFromContext returns the priority attached to ctx, if any.
+func priorityFromContext(ctx context.Context) RequestPriority {
+	priority, _ := ctx.Value(priorityKey{}).(RequestPriority)
+	return priority
+}
+
+// applyDeadlineHints forwards the time remaining on ctx's deadline and any
+// priority hint to the API. The budget is computed per attempt, so retries
+// report what is actually left.
+func applyDeadlineHints(ctx context.Context, req *http.Request) {
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline).Milliseconds(); remaining > 0 {
+			req.Header.Set(requestTimeoutHeader, strconv.FormatInt(remaining, 10))
+		}
+	}
+	if priority := priorityFromContext(ctx); priority != "" {
+		req.Header.Set(priorityHeader, string(priority))
+	}
+}
+
+// parseProcessingTime returns the server-side processing time reported in h,
+// or 0 if absent or malformed.
+func parseProcessingTime(h http.Header) time.Duration {
+	ms, err := strconv.ParseFloat(h.Get(processingTimeHeader), 64)
+	if err != nil || ms < 0 {
+		return 0
+	}
+	return time.Duration(ms * float64(time.Millisecond))
+}
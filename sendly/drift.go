@@ -0,0 +1,204 @@
+package sendly
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ConfigSnapshot is a declarative description of the account configuration
+// that should exist, typically kept in version control.
+type ConfigSnapshot struct {
+	// Webhooks are the expected webhook endpoints, matched by URL.
+	Webhooks []WebhookSpec `json:"webhooks,omitempty"`
+	// Templates are the expected templates, matched by name.
+	Templates []TemplateSpec `json:"templates,omitempty"`
+}
+
+// WebhookSpec is the desired state of a webhook endpoint.
+type WebhookSpec struct {
+	URL         string      `json:"url"`
+	Events      []string    `json:"events"`
+	Description string      `json:"description,omitempty"`
+	Mode        WebhookMode `json:"mode,omitempty"`
+	// Disabled marks a webhook that should exist but be inactive.
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// TemplateSpec is the desired state of a template.
+type TemplateSpec struct {
+	Name string `json:"name"`
+	Text string `json:"text"`
+}
+
+// DriftType describes how live configuration differs from a snapshot.
+type DriftType string
+
+const (
+	// DriftMissing means a resource in the snapshot does not exist.
+	DriftMissing DriftType = "missing"
+	// DriftUnexpected means a live resource is not in the snapshot.
+	DriftUnexpected DriftType = "unexpected"
+	// DriftChanged means a resource exists but a field differs.
+	DriftChanged DriftType = "changed"
+)
+
+// Drift is a single difference between live configuration and a snapshot.
+type Drift struct {
+	// Resource is the resource kind (webhook or template).
+	Resource string
+	// Key identifies the resource (webhook URL or template name).
+	Key string
+	// ID is the live resource ID, if it exists.
+	ID string
+	// Type is the kind of difference.
+	Type DriftType
+	// Field is the differing field for DriftChanged.
+	Field string
+	// Expected is the snapshot value for DriftChanged.
+	Expected string
+	// Actual is the live value for DriftChanged.
+	Actual string
+}
+
+func (d Drift) String() string {
+	switch d.Type {
+	case DriftChanged:
+		return fmt.Sprintf("%s %s: %s is %q, expected %q", d.Resource, d.Key, d.Field, d.Actual, d.Expected)
+	default:
+		return fmt.Sprintf("%s %s: %s", d.Resource, d.Key, d.Type)
+	}
+}
+
+// DriftReport lists all detected differences.
+type DriftReport struct {
+	Drifts []Drift
+}
+
+// HasDrift reports whether any differences were found.
+func (r *DriftReport) HasDrift() bool {
+	return len(r.Drifts) > 0
+}
+
+// DriftDetector compares live account configuration against a snapshot.
+// Pair it with webhook.*, template.* and number.* events to re-check
+// whenever configuration is modified outside of your deployment pipeline.
+type DriftDetector struct {
+	client *Client
+}
+
+// NewDriftDetector creates a drift detector using the given client.
+func NewDriftDetector(client *Client) *DriftDetector {
+	return &DriftDetector{client: client}
+}
+
+// Detect fetches live configuration and reports differences from snapshot.
+func (d *DriftDetector) Detect(ctx context.Context, snapshot *ConfigSnapshot) (*DriftReport, error) {
+	if snapshot == nil {
+		return nil, &ValidationError{APIError: APIError{Message: "snapshot is required"}}
+	}
+
+	webhooks, err := d.client.WebhooksService.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	templates, err := d.client.Templates.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &DriftReport{}
+	report.Drifts = append(report.Drifts, diffWebhooks(snapshot.Webhooks, webhooks)...)
+	report.Drifts = append(report.Drifts, diffTemplates(snapshot.Templates, templates.Templates)...)
+	return report, nil
+}
+
+// diffWebhooks compares expected webhook specs to live webhooks by URL.
+func diffWebhooks(specs []WebhookSpec, live []Webhook) []Drift {
+	byURL := make(map[string]Webhook, len(live))
+	for _, w := range live {
+		byURL[w.URL] = w
+	}
+
+	var drifts []Drift
+	seen := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		seen[spec.URL] = true
+		w, ok := byURL[spec.URL]
+		if !ok {
+			drifts = append(drifts, Drift{Resource: "webhook", Key: spec.URL, Type: DriftMissing})
+			continue
+		}
+
+		changed := func(field, expected, actual string) {
+			if expected != actual {
+				drifts = append(drifts, Drift{
+					Resource: "webhook", Key: spec.URL, ID: w.ID, Type: DriftChanged,
+					Field: field, Expected: expected, Actual: actual,
+				})
+			}
+		}
+		changed("events", joinSorted(spec.Events), joinSorted(w.Events))
+		mode := spec.Mode
+		if mode == "" {
+			mode = WebhookModeAll
+		}
+		changed("mode", string(mode), string(w.Mode))
+		description := ""
+		if w.Description != nil {
+			description = *w.Description
+		}
+		changed("description", spec.Description, description)
+		changed("isActive", fmt.Sprint(!spec.Disabled), fmt.Sprint(w.IsActive))
+	}
+
+	for _, w := range live {
+		if !seen[w.URL] {
+			drifts = append(drifts, Drift{Resource: "webhook", Key: w.URL, ID: w.ID, Type: DriftUnexpected})
+		}
+	}
+	return drifts
+}
+
+// diffTemplates compares expected template specs to live templates by name.
+// Preset templates are ignored since they are not user-managed.
+func diffTemplates(specs []TemplateSpec, live []Template) []Drift {
+	byName := make(map[string]Template, len(live))
+	for _, t := range live {
+		if !t.IsPreset {
+			byName[t.Name] = t
+		}
+	}
+
+	var drifts []Drift
+	seen := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		seen[spec.Name] = true
+		t, ok := byName[spec.Name]
+		if !ok {
+			drifts = append(drifts, Drift{Resource: "template", Key: spec.Name, Type: DriftMissing})
+			continue
+		}
+		if t.Text != spec.Text {
+			drifts = append(drifts, Drift{
+				Resource: "template", Key: spec.Name, ID: t.ID, Type: DriftChanged,
+				Field: "text", Expected: spec.Text, Actual: t.Text,
+			})
+		}
+	}
+
+	for _, t := range live {
+		if !t.IsPreset && !seen[t.Name] {
+			drifts = append(drifts, Drift{Resource: "template", Key: t.Name, ID: t.ID, Type: DriftUnexpected})
+		}
+	}
+	return drifts
+}
+
+// joinSorted returns a canonical string form of an unordered string set.
+func joinSorted(values []string) string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
@@ -0,0 +1,60 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDriftDetector_Detect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/webhooks":
+			json.NewEncoder(w).Encode([]map[string]interface{}{
+				{"id": "whk_1", "url": "https://a.example.com", "events": []string{"message.failed", "message.delivered"}, "mode": "all", "is_active": true},
+				{"id": "whk_2", "url": "https://b.example.com", "events": []string{"message.sent"}, "mode": "live", "is_active": true},
+			})
+		case "/templates":
+			json.NewEncoder(w).Encode(TemplateListResponse{Templates: []Template{
+				{ID: "tpl_1", Name: "otp", Text: "Your code is {{code}}"},
+				{ID: "tpl_2", Name: "preset", Text: "Preset", IsPreset: true},
+			}})
+		default:
+			t.Errorf("unexpected path '%s'", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	detector := NewDriftDetector(client)
+
+	report, err := detector.Detect(context.Background(), &ConfigSnapshot{
+		Webhooks: []WebhookSpec{
+			{URL: "https://a.example.com", Events: []string{"message.delivered", "message.failed"}},
+			{URL: "https://c.example.com", Events: []string{"message.sent"}},
+		},
+		Templates: []TemplateSpec{
+			{Name: "otp", Text: "Code: {{code}}"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]DriftType{
+		"webhook https://c.example.com": DriftMissing,
+		"webhook https://b.example.com": DriftUnexpected,
+		"template otp":                  DriftChanged,
+	}
+	if len(report.Drifts) != len(want) {
+		t.Fatalf("expected %d drifts, got %d: %v", len(want), len(report.Drifts), report.Drifts)
+	}
+	for _, d := range report.Drifts {
+		key := d.Resource + " " + d.Key
+		if want[key] != d.Type {
+			t.Errorf("unexpected drift %s", d)
+		}
+	}
+}
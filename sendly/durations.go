@@ -0,0 +1,364 @@
+package sendly
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// durationSecs converts d to whole seconds for the API, rounding partial
+// seconds up so that a short positive duration is never sent as 0 (which
+// the API reads as "use the default").
+func durationSecs(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int((d + time.Second - 1) / time.Second)
+}
+
+// secsDuration converts whole seconds from the API to a time.Duration.
+func secsDuration(secs int) time.Duration {
+	return time.Duration(secs) * time.Second
+}
+
+// MarshalJSON encodes a send request, sending DuplicateWindow and
+// ValidityPeriod in seconds.
+func (r SendMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias SendMessageRequest
+	return json.Marshal(struct {
+		alias
+		DuplicateWindow int `json:"duplicateWindowSecs,omitempty"`
+		ValidityPeriod  int `json:"validityPeriodSecs,omitempty"`
+	}{
+		alias:           alias(r),
+		DuplicateWindow: durationSecs(r.DuplicateWindow),
+		ValidityPeriod:  durationSecs(r.ValidityPeriod),
+	})
+}
+
+// UnmarshalJSON decodes a send request, reading DuplicateWindow and
+// ValidityPeriod in seconds.
+func (r *SendMessageRequest) UnmarshalJSON(data []byte) error {
+	type alias SendMessageRequest
+	aux := struct {
+		*alias
+		DuplicateWindow int `json:"duplicateWindowSecs,omitempty"`
+		ValidityPeriod  int `json:"validityPeriodSecs,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.DuplicateWindow = secsDuration(aux.DuplicateWindow)
+	r.ValidityPeriod = secsDuration(aux.ValidityPeriod)
+	return nil
+}
+
+// MarshalJSON encodes a batch request, sending DuplicateWindow and
+// ValidityPeriod in seconds.
+func (r SendBatchRequest) MarshalJSON() ([]byte, error) {
+	type alias SendBatchRequest
+	return json.Marshal(struct {
+		alias
+		DuplicateWindow int `json:"duplicateWindowSecs,omitempty"`
+		ValidityPeriod  int `json:"validityPeriodSecs,omitempty"`
+	}{
+		alias:           alias(r),
+		DuplicateWindow: durationSecs(r.DuplicateWindow),
+		ValidityPeriod:  durationSecs(r.ValidityPeriod),
+	})
+}
+
+// UnmarshalJSON decodes a batch request, reading DuplicateWindow and
+// ValidityPeriod in seconds.
+func (r *SendBatchRequest) UnmarshalJSON(data []byte) error {
+	type alias SendBatchRequest
+	aux := struct {
+		*alias
+		DuplicateWindow int `json:"duplicateWindowSecs,omitempty"`
+		ValidityPeriod  int `json:"validityPeriodSecs,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.DuplicateWindow = secsDuration(aux.DuplicateWindow)
+	r.ValidityPeriod = secsDuration(aux.ValidityPeriod)
+	return nil
+}
+
+// MarshalJSON encodes a schedule request, sending ValidityPeriod in
+// seconds.
+func (r ScheduleMessageRequest) MarshalJSON() ([]byte, error) {
+	type alias ScheduleMessageRequest
+	return json.Marshal(struct {
+		alias
+		ValidityPeriod int `json:"validityPeriodSecs,omitempty"`
+	}{
+		alias:          alias(r),
+		ValidityPeriod: durationSecs(r.ValidityPeriod),
+	})
+}
+
+// UnmarshalJSON decodes a schedule request, reading ValidityPeriod in
+// seconds.
+func (r *ScheduleMessageRequest) UnmarshalJSON(data []byte) error {
+	type alias ScheduleMessageRequest
+	aux := struct {
+		*alias
+		ValidityPeriod int `json:"validityPeriodSecs,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.ValidityPeriod = secsDuration(aux.ValidityPeriod)
+	return nil
+}
+
+// MarshalJSON encodes a verification request, sending Timeout in seconds.
+func (r SendVerificationRequest) MarshalJSON() ([]byte, error) {
+	type alias SendVerificationRequest
+	return json.Marshal(struct {
+		alias
+		Timeout int `json:"timeout_secs,omitempty"`
+	}{
+		alias:   alias(r),
+		Timeout: durationSecs(r.Timeout),
+	})
+}
+
+// UnmarshalJSON decodes a verification request, reading Timeout in seconds.
+func (r *SendVerificationRequest) UnmarshalJSON(data []byte) error {
+	type alias SendVerificationRequest
+	aux := struct {
+		*alias
+		Timeout int `json:"timeout_secs,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Timeout = secsDuration(aux.Timeout)
+	return nil
+}
+
+// MarshalJSON encodes an opt-in request, sending ExpiresIn in seconds.
+func (r StartOptInRequest) MarshalJSON() ([]byte, error) {
+	type alias StartOptInRequest
+	return json.Marshal(struct {
+		alias
+		ExpiresIn int `json:"expires_in_secs,omitempty"`
+	}{
+		alias:     alias(r),
+		ExpiresIn: durationSecs(r.ExpiresIn),
+	})
+}
+
+// UnmarshalJSON decodes an opt-in request, reading ExpiresIn in seconds.
+func (r *StartOptInRequest) UnmarshalJSON(data []byte) error {
+	type alias StartOptInRequest
+	aux := struct {
+		*alias
+		ExpiresIn int `json:"expires_in_secs,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.ExpiresIn = secsDuration(aux.ExpiresIn)
+	return nil
+}
+
+// MarshalJSON encodes a handoff request, sending TTL in seconds.
+func (r CreateHandoffRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateHandoffRequest
+	return json.Marshal(struct {
+		alias
+		TTL int `json:"ttl_seconds,omitempty"`
+	}{
+		alias: alias(r),
+		TTL:   durationSecs(r.TTL),
+	})
+}
+
+// UnmarshalJSON decodes a handoff request, reading TTL in seconds.
+func (r *CreateHandoffRequest) UnmarshalJSON(data []byte) error {
+	type alias CreateHandoffRequest
+	aux := struct {
+		*alias
+		TTL int `json:"ttl_seconds,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.TTL = secsDuration(aux.TTL)
+	return nil
+}
+
+// MarshalJSON encodes a migration request, sending ShadowWindow in seconds.
+func (r StartWebhookMigrationRequest) MarshalJSON() ([]byte, error) {
+	type alias StartWebhookMigrationRequest
+	return json.Marshal(struct {
+		alias
+		ShadowWindow int `json:"shadow_window_secs,omitempty"`
+	}{
+		alias:        alias(r),
+		ShadowWindow: durationSecs(r.ShadowWindow),
+	})
+}
+
+// UnmarshalJSON decodes a migration request, reading ShadowWindow in seconds.
+func (r *StartWebhookMigrationRequest) UnmarshalJSON(data []byte) error {
+	type alias StartWebhookMigrationRequest
+	aux := struct {
+		*alias
+		ShadowWindow int `json:"shadow_window_secs,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.ShadowWindow = secsDuration(aux.ShadowWindow)
+	return nil
+}
+
+// MarshalJSON encodes a verification fallback, sending Timeout in seconds.
+func (f VerifyFallback) MarshalJSON() ([]byte, error) {
+	type alias VerifyFallback
+	return json.Marshal(struct {
+		alias
+		Timeout int `json:"timeout_secs,omitempty"`
+	}{
+		alias:   alias(f),
+		Timeout: durationSecs(f.Timeout),
+	})
+}
+
+// UnmarshalJSON decodes a verification fallback, reading Timeout in seconds.
+func (f *VerifyFallback) UnmarshalJSON(data []byte) error {
+	type alias VerifyFallback
+	aux := struct {
+		*alias
+		Timeout int `json:"timeout_secs,omitempty"`
+	}{alias: (*alias)(f)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	f.Timeout = secsDuration(aux.Timeout)
+	return nil
+}
+
+// MarshalJSON encodes a verification rate limit, sending Window in seconds.
+func (l VerifyRateLimit) MarshalJSON() ([]byte, error) {
+	type alias VerifyRateLimit
+	return json.Marshal(struct {
+		alias
+		Window int `json:"window_secs"`
+	}{
+		alias:  alias(l),
+		Window: durationSecs(l.Window),
+	})
+}
+
+// UnmarshalJSON decodes a verification rate limit, reading Window in
+// seconds.
+func (l *VerifyRateLimit) UnmarshalJSON(data []byte) error {
+	type alias VerifyRateLimit
+	aux := struct {
+		*alias
+		Window int `json:"window_secs"`
+	}{alias: (*alias)(l)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	l.Window = secsDuration(aux.Window)
+	return nil
+}
+
+// MarshalJSON encodes a webhook retry policy, sending intervals and the
+// attempt timeout in seconds.
+func (p WebhookRetryPolicy) MarshalJSON() ([]byte, error) {
+	type alias WebhookRetryPolicy
+	return json.Marshal(struct {
+		alias
+		InitialInterval int `json:"initial_interval_secs,omitempty"`
+		MaxInterval     int `json:"max_interval_secs,omitempty"`
+		AttemptTimeout  int `json:"attempt_timeout_secs,omitempty"`
+	}{
+		alias:           alias(p),
+		InitialInterval: durationSecs(p.InitialInterval),
+		MaxInterval:     durationSecs(p.MaxInterval),
+		AttemptTimeout:  durationSecs(p.AttemptTimeout),
+	})
+}
+
+// UnmarshalJSON decodes a webhook retry policy, reading intervals and the
+// attempt timeout in seconds.
+func (p *WebhookRetryPolicy) UnmarshalJSON(data []byte) error {
+	type alias WebhookRetryPolicy
+	aux := struct {
+		*alias
+		InitialInterval int `json:"initial_interval_secs,omitempty"`
+		MaxInterval     int `json:"max_interval_secs,omitempty"`
+		AttemptTimeout  int `json:"attempt_timeout_secs,omitempty"`
+	}{alias: (*alias)(p)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	p.InitialInterval = secsDuration(aux.InitialInterval)
+	p.MaxInterval = secsDuration(aux.MaxInterval)
+	p.AttemptTimeout = secsDuration(aux.AttemptTimeout)
+	return nil
+}
+
+// MarshalJSON encodes account settings, sending OTPTTL in seconds.
+func (s AccountSettings) MarshalJSON() ([]byte, error) {
+	type alias AccountSettings
+	return json.Marshal(struct {
+		alias
+		OTPTTL int `json:"otp_ttl_seconds"`
+	}{
+		alias:  alias(s),
+		OTPTTL: durationSecs(s.OTPTTL),
+	})
+}
+
+// UnmarshalJSON decodes account settings, reading OTPTTL in seconds.
+func (s *AccountSettings) UnmarshalJSON(data []byte) error {
+	type alias AccountSettings
+	aux := struct {
+		*alias
+		OTPTTL int `json:"otp_ttl_seconds"`
+	}{alias: (*alias)(s)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	s.OTPTTL = secsDuration(aux.OTPTTL)
+	return nil
+}
+
+// MarshalJSON encodes a settings update, sending OTPTTL in seconds.
+func (r UpdateSettingsRequest) MarshalJSON() ([]byte, error) {
+	type alias UpdateSettingsRequest
+	aux := struct {
+		alias
+		OTPTTL *int `json:"otp_ttl_seconds,omitempty"`
+	}{alias: alias(r)}
+	if r.OTPTTL != nil {
+		secs := durationSecs(*r.OTPTTL)
+		aux.OTPTTL = &secs
+	}
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON decodes a settings update, reading OTPTTL in seconds.
+func (r *UpdateSettingsRequest) UnmarshalJSON(data []byte) error {
+	type alias UpdateSettingsRequest
+	aux := struct {
+		*alias
+		OTPTTL *int `json:"otp_ttl_seconds,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.OTPTTL = nil
+	if aux.OTPTTL != nil {
+		ttl := secsDuration(*aux.OTPTTL)
+		r.OTPTTL = &ttl
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package sendly
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRequestDurations_JSON(t *testing.T) {
+	data, err := json.Marshal(SendVerificationRequest{To: "+15551234567", Timeout: 90*time.Second + 500*time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(data), `"timeout_secs":91`) {
+		t.Errorf("expected timeout_secs to be rounded up to 91, got %s", data)
+	}
+
+	var req CreateHandoffRequest
+	if err := json.Unmarshal([]byte(`{"session_id":"vs_1","ttl_seconds":300}`), &req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.SessionID != "vs_1" || req.TTL != 5*time.Minute {
+		t.Errorf("unexpected request: %+v", req)
+	}
+
+	data, _ = json.Marshal(SendMessageRequest{To: "+15551234567", Text: "Hi"})
+	if strings.Contains(string(data), "duplicateWindowSecs") {
+		t.Errorf("expected zero duplicate window to be omitted, got %s", data)
+	}
+}
@@ -1,6 +1,63 @@
 package sendly
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Error is the structured form of an API error. Every Sendly error type that
+// carries an APIError unwraps to an *Error, so callers can branch on the
+// error code instead of matching error text:
+//
+//	var apiErr *sendly.Error
+//	if errors.As(err, &apiErr) && apiErr.Code == "INVALID_PHONE_NUMBER" {
+//	    log.Printf("bad %s (request %s)", apiErr.Param, apiErr.RequestID)
+//	}
+type Error struct {
+	// StatusCode is the HTTP status code, 0 for errors raised before a
+	// request was sent.
+	StatusCode int
+	// Code is the machine-readable error code.
+	Code string
+	// Message is the human-readable error message.
+	Message string
+	// RequestID identifies the failed request when contacting support.
+	RequestID string
+	// Param is the request parameter the error relates to, if any.
+	Param string
+	// Details contains additional error details.
+	Details map[string]interface{}
+}
+
+func (e *Error) Error() string {
+	if e.Param != "" {
+		return fmt.Sprintf("sendly: %s (code: %s, param: %s, status: %d)", e.Message, e.Code, e.Param, e.StatusCode)
+	}
+	return fmt.Sprintf("sendly: %s (code: %s, status: %d)", e.Message, e.Code, e.StatusCode)
+}
+
+// toError converts an APIError to an *Error, using fallbackStatus when the
+// response status is unknown.
+func (e APIError) toError(fallbackStatus int) *Error {
+	status := e.statusCode
+	if status == 0 {
+		status = fallbackStatus
+	}
+	return &Error{
+		StatusCode: status,
+		Code:       e.Code,
+		Message:    e.Message,
+		RequestID:  e.RequestID,
+		Param:      e.Param,
+		Details:    e.Details,
+	}
+}
+
+// invalidParamError returns a client-side validation error for param.
+func invalidParamError(param, message string) error {
+	return &ValidationError{APIError: APIError{Code: "INVALID_PARAMETER", Message: message, Param: param}}
+}
 
 // SendlyError is the base error type for Sendly API errors.
 type SendlyError struct {
@@ -12,6 +69,10 @@ func (e *SendlyError) Error() string {
 	return fmt.Sprintf("sendly: %s (code: %s, status: %d)", e.Message, e.Code, e.StatusCode)
 }
 
+func (e *SendlyError) Unwrap() error {
+	return e.APIError.toError(e.StatusCode)
+}
+
 // AuthenticationError indicates invalid or missing API credentials.
 type AuthenticationError struct {
 	APIError
@@ -21,6 +82,10 @@ func (e *AuthenticationError) Error() string {
 	return fmt.Sprintf("sendly: authentication failed: %s", e.Message)
 }
 
+func (e *AuthenticationError) Unwrap() error {
+	return e.APIError.toError(http.StatusUnauthorized)
+}
+
 // RateLimitError indicates the rate limit has been exceeded.
 type RateLimitError struct {
 	APIError
@@ -35,6 +100,10 @@ func (e *RateLimitError) Error() string {
 	return fmt.Sprintf("sendly: rate limit exceeded: %s", e.Message)
 }
 
+func (e *RateLimitError) Unwrap() error {
+	return e.APIError.toError(http.StatusTooManyRequests)
+}
+
 // InsufficientCreditsError indicates the account has insufficient credits.
 type InsufficientCreditsError struct {
 	APIError
@@ -44,6 +113,10 @@ func (e *InsufficientCreditsError) Error() string {
 	return fmt.Sprintf("sendly: insufficient credits: %s", e.Message)
 }
 
+func (e *InsufficientCreditsError) Unwrap() error {
+	return e.APIError.toError(http.StatusPaymentRequired)
+}
+
 // ValidationError indicates invalid request parameters.
 type ValidationError struct {
 	APIError
@@ -57,8 +130,13 @@ func (e *ValidationError) Error() string {
 	return fmt.Sprintf("sendly: validation error: %s", e.Message)
 }
 
-func (e *ValidationError) Unwrap() error {
-	return e.Err
+// Unwrap returns the structured *Error and, when set, the underlying
+// cause, so errors.Is and errors.As match either.
+func (e *ValidationError) Unwrap() []error {
+	if e.Err == nil {
+		return []error{e.APIError.toError(0)}
+	}
+	return []error{e.Err, e.APIError.toError(0)}
 }
 
 // NotFoundError indicates the requested resource was not found.
@@ -70,10 +148,18 @@ func (e *NotFoundError) Error() string {
 	return fmt.Sprintf("sendly: not found: %s", e.Message)
 }
 
+func (e *NotFoundError) Unwrap() error {
+	return e.APIError.toError(http.StatusNotFound)
+}
+
 // NetworkError indicates a network-level error.
 type NetworkError struct {
 	Message string
 	Err     error
+
+	// responded is set when the server sent a response before the error,
+	// so the call may already have taken effect.
+	responded bool
 }
 
 func (e *NetworkError) Error() string {
@@ -89,36 +175,52 @@ func (e *NetworkError) Unwrap() error {
 
 // IsAuthenticationError checks if the error is an authentication error.
 func IsAuthenticationError(err error) bool {
-	_, ok := err.(*AuthenticationError)
-	return ok
+	var target *AuthenticationError
+	return errors.As(err, &target)
 }
 
 // IsRateLimitError checks if the error is a rate limit error.
 func IsRateLimitError(err error) bool {
-	_, ok := err.(*RateLimitError)
-	return ok
+	var target *RateLimitError
+	return errors.As(err, &target)
 }
 
 // IsInsufficientCreditsError checks if the error is an insufficient credits error.
 func IsInsufficientCreditsError(err error) bool {
-	_, ok := err.(*InsufficientCreditsError)
-	return ok
+	var target *InsufficientCreditsError
+	return errors.As(err, &target)
 }
 
 // IsValidationError checks if the error is a validation error.
 func IsValidationError(err error) bool {
-	_, ok := err.(*ValidationError)
-	return ok
+	var target *ValidationError
+	return errors.As(err, &target)
 }
 
 // IsNotFoundError checks if the error is a not found error.
 func IsNotFoundError(err error) bool {
-	_, ok := err.(*NotFoundError)
-	return ok
+	var target *NotFoundError
+	return errors.As(err, &target)
 }
 
 // IsNetworkError checks if the error is a network error.
 func IsNetworkError(err error) bool {
-	_, ok := err.(*NetworkError)
-	return ok
+	var target *NetworkError
+	return errors.As(err, &target)
+}
+
+// IsNotFound reports whether err is a 404 from the API.
+func IsNotFound(err error) bool {
+	return IsNotFoundError(err)
+}
+
+// IsRateLimited reports whether err is a 429 from the API.
+func IsRateLimited(err error) bool {
+	return IsRateLimitError(err)
+}
+
+// HasErrorCode reports whether err carries the given API error code.
+func HasErrorCode(err error, code string) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.Code == code
 }
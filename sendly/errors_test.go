@@ -1,7 +1,11 @@
 package sendly
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -135,9 +139,18 @@ func TestValidationError_Unwrap(t *testing.T) {
 		Err: underlyingErr,
 	}
 
-	unwrapped := err.Unwrap()
-	if unwrapped != underlyingErr {
-		t.Errorf("expected unwrapped error to be underlying error, got %v", unwrapped)
+	if !errors.Is(err, underlyingErr) {
+		t.Error("expected errors.Is to match the underlying error")
+	}
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Code != "VALIDATION_ERROR" || apiErr.Message != "Invalid input" {
+		t.Errorf("expected errors.As to find the structured error, got %+v", apiErr)
+	}
+	if !HasErrorCode(err, "VALIDATION_ERROR") {
+		t.Error("expected HasErrorCode to match a validation error with a cause")
+	}
+	if !HasErrorCode(&ValidationError{APIError: APIError{Code: "INVALID_PARAM"}}, "INVALID_PARAM") {
+		t.Error("expected HasErrorCode to match a validation error without a cause")
 	}
 }
 
@@ -521,3 +534,61 @@ func TestErrorTypesWithDetails(t *testing.T) {
 		t.Errorf("expected reason to be 'invalid format', got '%v'", err.Details["reason"])
 	}
 }
+
+func TestStructuredError_FromResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req_abc")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"code":"INVALID_PHONE_NUMBER","message":"not a valid number","param":"to"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	err := client.request(context.Background(), "POST", "/messages", map[string]string{}, nil)
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *Error, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("expected StatusCode to be 422, got %d", apiErr.StatusCode)
+	}
+	if apiErr.Code != "INVALID_PHONE_NUMBER" {
+		t.Errorf("expected Code to be 'INVALID_PHONE_NUMBER', got '%s'", apiErr.Code)
+	}
+	if apiErr.Param != "to" {
+		t.Errorf("expected Param to be 'to', got '%s'", apiErr.Param)
+	}
+	if apiErr.RequestID != "req_abc" {
+		t.Errorf("expected RequestID to be 'req_abc', got '%s'", apiErr.RequestID)
+	}
+	if !HasErrorCode(err, "INVALID_PHONE_NUMBER") {
+		t.Error("expected HasErrorCode to match")
+	}
+	if !IsValidationError(err) {
+		t.Error("expected IsValidationError to remain true")
+	}
+}
+
+func TestStructuredError_Helpers(t *testing.T) {
+	notFound := fmt.Errorf("lookup: %w", &NotFoundError{APIError: APIError{Code: "NOT_FOUND"}})
+	if !IsNotFound(notFound) {
+		t.Error("expected IsNotFound to match wrapped NotFoundError")
+	}
+	var apiErr *Error
+	if !errors.As(notFound, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected *Error with status 404, got %v", apiErr)
+	}
+
+	if !IsRateLimited(&RateLimitError{RetryAfter: 1}) {
+		t.Error("expected IsRateLimited to match RateLimitError")
+	}
+	if IsRateLimited(notFound) {
+		t.Error("expected IsRateLimited not to match NotFoundError")
+	}
+
+	_, err := NewClient("test-api-key").WebhooksService.Get(context.Background(), "bad")
+	if !errors.As(err, &apiErr) || apiErr.Param != "webhook_id" {
+		t.Errorf("expected webhook_id param error, got %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package sendly
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultEventTypeRefreshInterval is how often an EventTypeWatcher refreshes
+// the event type list by default.
+const DefaultEventTypeRefreshInterval = time.Hour
+
+// EventTypeWatchOptions configures WebhooksService.WatchEventTypes.
+type EventTypeWatchOptions struct {
+	// Interval is the time between refreshes (default:
+	// DefaultEventTypeRefreshInterval).
+	Interval time.Duration
+	// Handled lists the event types the application handles. If set,
+	// OnNew is also called for unhandled types found by the first fetch;
+	// otherwise the first fetch is the baseline.
+	Handled []WebhookEventType
+	// OnNew is called with event types that appear in a refresh, sorted.
+	OnNew func(added []string)
+	// OnError is called when a background refresh fails. The previous
+	// list is kept.
+	OnError func(err error)
+}
+
+// EventTypeWatcher keeps a cached copy of the available webhook event types
+// and refreshes it in the background. It is safe for concurrent use.
+type EventTypeWatcher struct {
+	service *WebhooksService
+	opts    EventTypeWatchOptions
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	mu          sync.RWMutex
+	types       []string
+	known       map[string]bool
+	refreshedAt time.Time
+}
+
+// WatchEventTypes fetches the available event types and keeps refreshing
+// them until ctx is done or Stop is called, so long-running services can
+// log or alert when Sendly ships event types they don't handle yet.
+//
+// Example:
+//
+//	w, err := client.WebhooksService.WatchEventTypes(ctx, &sendly.EventTypeWatchOptions{
+//	    Handled: []sendly.WebhookEventType{sendly.WebhookEventMessageDelivered, sendly.WebhookEventMessageFailed},
+//	    OnNew: func(added []string) {
+//	        slog.Warn("unhandled Sendly event types", "types", added)
+//	    },
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	defer w.Stop()
+func (s *WebhooksService) WatchEventTypes(ctx context.Context, opts *EventTypeWatchOptions) (*EventTypeWatcher, error) {
+	var o EventTypeWatchOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Interval <= 0 {
+		o.Interval = DefaultEventTypeRefreshInterval
+	}
+
+	w := &EventTypeWatcher{service: s, opts: o, done: make(chan struct{})}
+	if len(o.Handled) > 0 {
+		w.known = make(map[string]bool, len(o.Handled))
+		for _, t := range o.Handled {
+			w.known[string(t)] = true
+		}
+	}
+	if err := w.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	ctx, w.cancel = context.WithCancel(ctx)
+	go w.run(ctx)
+	return w, nil
+}
+
+// EventTypes returns the cached event types.
+func (w *EventTypeWatcher) EventTypes() []string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return append([]string(nil), w.types...)
+}
+
+// Has reports whether t is in the cached event types.
+func (w *EventTypeWatcher) Has(t WebhookEventType) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	for _, et := range w.types {
+		if et == string(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// RefreshedAt returns when the cached list was last fetched.
+func (w *EventTypeWatcher) RefreshedAt() time.Time {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.refreshedAt
+}
+
+// Refresh fetches the event types now, calling OnNew for any that are new.
+func (w *EventTypeWatcher) Refresh(ctx context.Context) error {
+	types, err := w.service.ListEventTypes(ctx)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	var added []string
+	if w.known != nil {
+		for _, t := range types {
+			if !w.known[t] {
+				added = append(added, t)
+			}
+		}
+	} else {
+		w.known = make(map[string]bool, len(types))
+	}
+	for _, t := range types {
+		w.known[t] = true
+	}
+	w.types = types
+	w.refreshedAt = time.Now()
+	w.mu.Unlock()
+
+	if len(added) > 0 && w.opts.OnNew != nil {
+		sort.Strings(added)
+		w.opts.OnNew(added)
+	}
+	return nil
+}
+
+// Stop ends background refreshes and waits for a refresh in progress to
+// finish. The cached list stays readable.
+func (w *EventTypeWatcher) Stop() {
+	w.cancel()
+	<-w.done
+}
+
+func (w *EventTypeWatcher) run(ctx context.Context) {
+	defer close(w.done)
+	ticker := time.NewTicker(w.opts.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Refresh(ctx); err != nil && ctx.Err() == nil && w.opts.OnError != nil {
+				w.opts.OnError(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWebhooksService_WatchEventTypes(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks/event-types" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if calls.Add(1) == 1 {
+			w.Write([]byte(`{"events":[{"type":"message.delivered"},{"type":"message.failed"}]}`))
+			return
+		}
+		w.Write([]byte(`{"events":[{"type":"message.delivered"},{"type":"message.failed"},{"type":"message.read"}]}`))
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var notified [][]string
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	w, err := client.WebhooksService.WatchEventTypes(context.Background(), &EventTypeWatchOptions{
+		Interval: 10 * time.Millisecond,
+		Handled:  []WebhookEventType{WebhookEventMessageDelivered},
+		OnNew: func(added []string) {
+			mu.Lock()
+			notified = append(notified, added)
+			mu.Unlock()
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for !w.Has("message.read") && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	w.Stop()
+
+	if got := w.EventTypes(); len(got) != 3 {
+		t.Errorf("expected 3 event types, got %v", got)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(notified) != 2 {
+		t.Fatalf("expected 2 notifications, got %v", notified)
+	}
+	if len(notified[0]) != 1 || notified[0][0] != "message.failed" {
+		t.Errorf("expected first notification to be [message.failed], got %v", notified[0])
+	}
+	if len(notified[1]) != 1 || notified[1][0] != "message.read" {
+		t.Errorf("expected second notification to be [message.read], got %v", notified[1])
+	}
+}
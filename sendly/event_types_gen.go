@@ -0,0 +1,74 @@
+// Code generated by sendly-eventgen. DO NOT EDIT.
+
+package sendly
+
+// Event types in the Sendly event catalog.
+const (
+	// EventContactOptedIn is sent when a contact confirms an opt-in.
+	EventContactOptedIn WebhookEventType = "contact.opted_in"
+	// EventMessageDelivered is sent when the carrier confirms delivery.
+	EventMessageDelivered WebhookEventType = "message.delivered"
+	// EventMessageExpired is sent when a message's validity period ends before delivery.
+	EventMessageExpired WebhookEventType = "message.expired"
+	// EventMessageFailed is sent when a message could not be sent.
+	EventMessageFailed WebhookEventType = "message.failed"
+	// EventMessageQueued is sent when a message is accepted and queued for sending.
+	EventMessageQueued WebhookEventType = "message.queued"
+	// EventMessageReceived is sent when an inbound message arrives on one of your numbers.
+	EventMessageReceived WebhookEventType = "message.received"
+	// EventMessageSent is sent when a message is handed to the carrier.
+	EventMessageSent WebhookEventType = "message.sent"
+	// EventMessageUndelivered is sent when the carrier reports that a message was not delivered.
+	EventMessageUndelivered WebhookEventType = "message.undelivered"
+	// EventNumberUpdated is sent when a phone number's configuration changes.
+	EventNumberUpdated WebhookEventType = "number.updated"
+	// EventTemplateCreated is sent when a template is created.
+	EventTemplateCreated WebhookEventType = "template.created"
+	// EventTemplateDeleted is sent when a template is deleted.
+	EventTemplateDeleted WebhookEventType = "template.deleted"
+	// EventTemplatePublished is sent when a template version is published.
+	EventTemplatePublished WebhookEventType = "template.published"
+	// EventTemplateUpdated is sent when a template is updated.
+	EventTemplateUpdated WebhookEventType = "template.updated"
+	// EventVerifyCompleted is sent when a verification code is checked successfully.
+	EventVerifyCompleted WebhookEventType = "verify.completed"
+	// EventVerifyExpired is sent when a verification expires unchecked.
+	EventVerifyExpired WebhookEventType = "verify.expired"
+	// EventVerifyFailed is sent when a verification fails.
+	EventVerifyFailed WebhookEventType = "verify.failed"
+	// EventVerifySessionCompleted is sent when a hosted verification session is completed.
+	EventVerifySessionCompleted WebhookEventType = "verify.session.completed"
+	// EventVerifySessionExpired is sent when a hosted verification session expires.
+	EventVerifySessionExpired WebhookEventType = "verify.session.expired"
+	// EventWebhookCreated is sent when a webhook is created.
+	EventWebhookCreated WebhookEventType = "webhook.created"
+	// EventWebhookDeleted is sent when a webhook is deleted.
+	EventWebhookDeleted WebhookEventType = "webhook.deleted"
+	// EventWebhookUpdated is sent when a webhook is updated.
+	EventWebhookUpdated WebhookEventType = "webhook.updated"
+)
+
+// eventCatalog lists every catalog event type, sorted.
+var eventCatalog = []WebhookEventType{
+	EventContactOptedIn,
+	EventMessageDelivered,
+	EventMessageExpired,
+	EventMessageFailed,
+	EventMessageQueued,
+	EventMessageReceived,
+	EventMessageSent,
+	EventMessageUndelivered,
+	EventNumberUpdated,
+	EventTemplateCreated,
+	EventTemplateDeleted,
+	EventTemplatePublished,
+	EventTemplateUpdated,
+	EventVerifyCompleted,
+	EventVerifyExpired,
+	EventVerifyFailed,
+	EventVerifySessionCompleted,
+	EventVerifySessionExpired,
+	EventWebhookCreated,
+	EventWebhookDeleted,
+	EventWebhookUpdated,
+}
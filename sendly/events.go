@@ -0,0 +1,264 @@
+package sendly
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// VerifyEventData contains the data payload for verify.* webhook events
+type VerifyEventData struct {
+	VerificationID VerificationID     `json:"verification_id"`
+	Status         VerificationStatus `json:"status"`
+	Phone          string             `json:"phone"`
+	Attempts       int                `json:"attempts"`
+	VerifiedAt     string             `json:"verified_at,omitempty"`
+	ExpiresAt      string             `json:"expires_at,omitempty"`
+	SessionID      VerifySessionID    `json:"session_id,omitempty"`
+	Sandbox        bool               `json:"sandbox"`
+}
+
+// VerifySessionEventData contains the data payload for verify.session.*
+// webhook events
+type VerifySessionEventData struct {
+	SessionID      VerifySessionID        `json:"session_id"`
+	Status         VerifySessionStatus    `json:"status"`
+	Phone          string                 `json:"phone,omitempty"`
+	VerificationID VerificationID         `json:"verification_id,omitempty"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	CompletedAt    string                 `json:"completed_at,omitempty"`
+	ExpiredAt      string                 `json:"expired_at,omitempty"`
+}
+
+// OptInEventData contains the data payload for contact.opted_in webhook events
+type OptInEventData struct {
+	OptInID           string                 `json:"opt_in_id"`
+	Phone             string                 `json:"phone"`
+	ReplyKeyword      string                 `json:"reply_keyword"`
+	ConsentRecordedAt string                 `json:"consent_recorded_at"`
+	Metadata          map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// InboundMessageEventData contains the data payload for message.received
+// webhook events
+type InboundMessageEventData struct {
+	MessageID      string  `json:"message_id"`
+	ConversationID string  `json:"conversation_id"`
+	From           string  `json:"from"`
+	To             string  `json:"to"`
+	Text           string  `json:"text"`
+	Segments       int     `json:"segments"`
+	Keyword        string  `json:"keyword,omitempty"`
+	Media          []Media `json:"media,omitempty"`
+	ReceivedAt     string  `json:"received_at"`
+}
+
+// Event is a webhook event with its data decoded into a typed payload.
+//
+// Payload holds a pointer to the struct matching Type:
+//   - message.received: *InboundMessageEventData
+//   - other message.*: *WebhookMessageData
+//   - verify.session.*: *VerifySessionEventData
+//   - other verify.*: *VerifyEventData
+//   - webhook.*, template.*, number.*: *ResourceEventData
+//   - contact.opted_in: *OptInEventData
+//
+// For event types unknown to this SDK version Payload is an *UnknownEvent
+// and the raw JSON remains available in Data.
+type Event struct {
+	ID         string           `json:"id"`
+	Type       WebhookEventType `json:"type"`
+	Data       json.RawMessage  `json:"data"`
+	CreatedAt  string           `json:"created_at"`
+	APIVersion string           `json:"api_version"`
+
+	Payload interface{} `json:"-"`
+}
+
+// UnknownEvent is the payload of an event that could not be decoded into a
+// typed struct: an event type this SDK version does not know, or, with
+// EventDecodeLenient, a known type whose data has an unexpected shape.
+type UnknownEvent struct {
+	Type WebhookEventType
+	Data json.RawMessage
+	// Err is why the data could not be decoded, or nil for unknown types.
+	Err error
+}
+
+// EventDecodeMode controls how events whose data cannot be decoded into a
+// typed payload are handled.
+type EventDecodeMode int
+
+const (
+	// EventDecodeDefault decodes unknown event types into an *UnknownEvent
+	// and rejects known types whose data has an unexpected shape.
+	EventDecodeDefault EventDecodeMode = iota
+	// EventDecodeLenient also decodes known types with unexpected data into
+	// an *UnknownEvent, so only a malformed envelope is an error. Use it in
+	// workers that must keep consuming whatever arrives.
+	EventDecodeLenient
+	// EventDecodeStrict rejects unknown event types and data with fields
+	// this SDK version does not know. Use it in tests and staging to catch
+	// schema drift early.
+	EventDecodeStrict
+)
+
+// Message returns the payload of a message.* event.
+func (e *Event) Message() (*WebhookMessageData, bool) {
+	data, ok := e.Payload.(*WebhookMessageData)
+	return data, ok
+}
+
+// InboundMessage returns the payload of a message.received event.
+func (e *Event) InboundMessage() (*InboundMessageEventData, bool) {
+	data, ok := e.Payload.(*InboundMessageEventData)
+	return data, ok
+}
+
+// Verification returns the payload of a verify.* event.
+func (e *Event) Verification() (*VerifyEventData, bool) {
+	data, ok := e.Payload.(*VerifyEventData)
+	return data, ok
+}
+
+// Session returns the payload of a verify.session.* event.
+func (e *Event) Session() (*VerifySessionEventData, bool) {
+	data, ok := e.Payload.(*VerifySessionEventData)
+	return data, ok
+}
+
+// Unknown returns the payload of an event that could not be decoded into a
+// typed struct.
+func (e *Event) Unknown() (*UnknownEvent, bool) {
+	data, ok := e.Payload.(*UnknownEvent)
+	return data, ok
+}
+
+// Resource returns the payload of a webhook.*, template.* or number.* event.
+func (e *Event) Resource() (*ResourceEventData, bool) {
+	data, ok := e.Payload.(*ResourceEventData)
+	return data, ok
+}
+
+// OptIn returns the payload of a contact.opted_in event.
+func (e *Event) OptIn() (*OptInEventData, bool) {
+	data, ok := e.Payload.(*OptInEventData)
+	return data, ok
+}
+
+// newEventPayload returns a pointer to the payload struct for an event type,
+// or nil if the type is unknown.
+func newEventPayload(t WebhookEventType) interface{} {
+	if t == WebhookEventMessageReceived {
+		return &InboundMessageEventData{}
+	}
+	if strings.HasPrefix(string(t), "verify.session.") {
+		return &VerifySessionEventData{}
+	}
+	category, _, _ := strings.Cut(string(t), ".")
+	switch category {
+	case "message":
+		return &WebhookMessageData{}
+	case "verify":
+		return &VerifyEventData{}
+	case "webhook", "template", "number":
+		return &ResourceEventData{}
+	}
+	if t == WebhookEventContactOptedIn {
+		return &OptInEventData{}
+	}
+	return nil
+}
+
+// ParseEvent decodes a webhook body into an Event with a typed payload. It
+// does not verify the signature; use ConstructEvent for untrusted input.
+func ParseEvent(body []byte) (*Event, error) {
+	return ParseEventWithMode(body, EventDecodeDefault)
+}
+
+// ParseEventWithMode is like ParseEvent but handles undecodable data as
+// mode says. It never panics, whatever the body contains.
+//
+// Example:
+//
+//	if !(sendly.Webhooks{}).VerifySignature(string(body), signature, secret) {
+//	    return sendly.ErrInvalidSignature
+//	}
+//	event, err := sendly.ParseEventWithMode(body, sendly.EventDecodeLenient)
+//	if unknown, ok := event.Unknown(); ok {
+//	    slog.Warn("undecodable Sendly event", "type", unknown.Type, "error", unknown.Err)
+//	}
+func ParseEventWithMode(body []byte, mode EventDecodeMode) (*Event, error) {
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook payload: %w", err)
+	}
+
+	if event.ID == "" || event.Type == "" || event.CreatedAt == "" {
+		return nil, errors.New("invalid event structure")
+	}
+
+	payload := newEventPayload(event.Type)
+	if payload == nil {
+		if mode == EventDecodeStrict {
+			return nil, fmt.Errorf("unknown event type %s", event.Type)
+		}
+		event.Payload = &UnknownEvent{Type: event.Type, Data: event.Data}
+		return &event, nil
+	}
+	if len(event.Data) == 0 {
+		return &event, nil
+	}
+
+	if err := decodeEventData(event.Data, payload, mode == EventDecodeStrict); err != nil {
+		if mode != EventDecodeLenient {
+			return nil, fmt.Errorf("failed to parse %s event data: %w", event.Type, err)
+		}
+		event.Payload = &UnknownEvent{Type: event.Type, Data: event.Data, Err: err}
+		return &event, nil
+	}
+	event.Payload = payload
+	return &event, nil
+}
+
+// decodeEventData decodes data into payload, turning a panic in a custom
+// unmarshaler into an error so one bad event can't crash a consumer.
+func decodeEventData(data json.RawMessage, payload interface{}, strict bool) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic decoding event data: %v", r)
+		}
+	}()
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if strict {
+		dec.DisallowUnknownFields()
+	}
+	return dec.Decode(payload)
+}
+
+// ConstructEvent verifies the webhook signature and decodes the body into an
+// Event with a typed payload.
+//
+// Example:
+//
+//	event, err := sendly.ConstructEvent(body, r.Header.Get("X-Sendly-Signature"), secret)
+//	if err != nil {
+//	    http.Error(w, "invalid webhook", http.StatusBadRequest)
+//	    return
+//	}
+//	if msg, ok := event.Message(); ok {
+//	    fmt.Printf("Message %s is %s\n", msg.MessageID, msg.Status)
+//	}
+func ConstructEvent(body []byte, signature, secret string) (*Event, error) {
+	return constructEvent(body, signature, secret, EventDecodeDefault)
+}
+
+func constructEvent(body []byte, signature, secret string, mode EventDecodeMode) (*Event, error) {
+	if !(Webhooks{}).VerifySignature(string(body), signature, secret) {
+		return nil, ErrInvalidSignature
+	}
+	return ParseEventWithMode(body, mode)
+}
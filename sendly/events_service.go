@@ -0,0 +1,60 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// EventsService provides access to the webhook event schema registry.
+type EventsService struct {
+	client *Client
+}
+
+// EventSchemaSummary describes an event type available in the registry.
+type EventSchemaSummary struct {
+	Type        WebhookEventType `json:"type"`
+	Description string           `json:"description,omitempty"`
+	Versions    []string         `json:"versions"`
+	Deprecated  bool             `json:"deprecated,omitempty"`
+}
+
+// EventSchema is the JSON Schema for one event type at one API version.
+type EventSchema struct {
+	Type        WebhookEventType `json:"type"`
+	APIVersion  string           `json:"api_version"`
+	Description string           `json:"description,omitempty"`
+	// Schema is the JSON Schema (draft 2020-12) document describing the
+	// full event envelope, including data.
+	Schema json.RawMessage `json:"schema"`
+}
+
+// ListSchemas returns all event types in the schema registry. If apiVersion
+// is empty, event types from every API version are returned.
+func (s *EventsService) ListSchemas(ctx context.Context, apiVersion string) ([]EventSchemaSummary, error) {
+	var resp struct {
+		Events []EventSchemaSummary `json:"events"`
+	}
+
+	path := "/events/schemas" + buildQueryString(map[string]string{"api_version": apiVersion})
+	if err := s.client.request(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Events, nil
+}
+
+// Schema returns the JSON Schema for an event type. If apiVersion is empty,
+// the account's current webhook API version is used.
+func (s *EventsService) Schema(ctx context.Context, eventType WebhookEventType, apiVersion string) (*EventSchema, error) {
+	if eventType == "" {
+		return nil, &ValidationError{APIError: APIError{Message: "event type is required"}}
+	}
+
+	path := "/events/schemas/" + url.PathEscape(string(eventType)) + buildQueryString(map[string]string{"api_version": apiVersion})
+
+	var resp EventSchema
+	if err := s.client.request(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,83 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEventsService_ListSchemas(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/events/schemas" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("api_version"); got != "2024-06-01" {
+			t.Errorf("expected api_version to be '2024-06-01', got '%s'", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"events":[{"type":"message.delivered","description":"A message was delivered","versions":["v1","2024-06-01"]},{"type":"message.undelivered","versions":["v1"],"deprecated":true}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	schemas, err := client.Events.ListSchemas(context.Background(), "2024-06-01")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(schemas) != 2 {
+		t.Fatalf("expected 2 schemas, got %d", len(schemas))
+	}
+	if schemas[0].Type != WebhookEventMessageDelivered || len(schemas[0].Versions) != 2 || schemas[0].Deprecated {
+		t.Errorf("unexpected schema: %+v", schemas[0])
+	}
+	if !schemas[1].Deprecated {
+		t.Errorf("expected second schema to be deprecated: %+v", schemas[1])
+	}
+}
+
+func TestEventsService_ListSchemasAllVersions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("expected no query, got '%s'", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"events":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	if _, err := client.Events.ListSchemas(context.Background(), ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestEventsService_Schema(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/events/schemas/verify.completed" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Has("api_version") {
+			t.Error("expected api_version to be omitted")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"type":"verify.completed","api_version":"v1","schema":{"type":"object","required":["id","type","data"]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	schema, err := client.Events.Schema(context.Background(), WebhookEventVerifyCompleted, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schema.Type != WebhookEventVerifyCompleted || schema.APIVersion != "v1" {
+		t.Errorf("unexpected schema: %+v", schema)
+	}
+	if string(schema.Schema) != `{"type":"object","required":["id","type","data"]}` {
+		t.Errorf("expected raw schema to be kept, got %s", schema.Schema)
+	}
+
+	if _, err := client.Events.Schema(context.Background(), "", ""); !IsValidationError(err) {
+		t.Errorf("expected validation error for missing event type, got %v", err)
+	}
+}
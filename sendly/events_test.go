@@ -0,0 +1,173 @@
+package sendly
+
+import "testing"
+
+func TestParseEvent_TypedPayloads(t *testing.T) {
+	tests := []struct {
+		name  string
+		body  string
+		check func(*testing.T, *Event)
+	}{
+		{
+			name: "message.delivered",
+			body: `{"id":"evt_1","type":"message.delivered","created_at":"2024-01-01T00:00:00Z","data":{"message_id":"msg_1","status":"delivered","to":"+1555","segments":2}}`,
+			check: func(t *testing.T, e *Event) {
+				msg, ok := e.Message()
+				if !ok {
+					t.Fatalf("expected message payload, got %T", e.Payload)
+				}
+				if msg.MessageID != "msg_1" || msg.Status != WebhookStatusDelivered || msg.Segments != 2 {
+					t.Errorf("unexpected payload: %+v", msg)
+				}
+			},
+		},
+		{
+			name: "message.received",
+			body: `{"id":"evt_5","type":"message.received","created_at":"2024-01-01T00:00:00Z","data":{"message_id":"inb_1","conversation_id":"conv_1","from":"+1555","text":"YES"}}`,
+			check: func(t *testing.T, e *Event) {
+				msg, ok := e.InboundMessage()
+				if !ok {
+					t.Fatalf("expected inbound message payload, got %T", e.Payload)
+				}
+				if msg.ConversationID != "conv_1" || msg.Text != "YES" {
+					t.Errorf("unexpected payload: %+v", msg)
+				}
+			},
+		},
+		{
+			name: "verify.completed",
+			body: `{"id":"evt_2","type":"verify.completed","created_at":"2024-01-01T00:00:00Z","data":{"verification_id":"ver_1","status":"verified","phone":"+1555"}}`,
+			check: func(t *testing.T, e *Event) {
+				v, ok := e.Verification()
+				if !ok {
+					t.Fatalf("expected verification payload, got %T", e.Payload)
+				}
+				if v.VerificationID != "ver_1" {
+					t.Errorf("expected VerificationID to be 'ver_1', got '%s'", v.VerificationID)
+				}
+			},
+		},
+		{
+			name: "template.updated",
+			body: `{"id":"evt_3","type":"template.updated","created_at":"2024-01-01T00:00:00Z","data":{"resource_type":"template","resource_id":"tpl_1","changed_fields":["text"]}}`,
+			check: func(t *testing.T, e *Event) {
+				r, ok := e.Resource()
+				if !ok {
+					t.Fatalf("expected resource payload, got %T", e.Payload)
+				}
+				if r.ResourceID != "tpl_1" || len(r.ChangedFields) != 1 {
+					t.Errorf("unexpected payload: %+v", r)
+				}
+			},
+		},
+		{
+			name: "unknown type",
+			body: `{"id":"evt_4","type":"future.thing","created_at":"2024-01-01T00:00:00Z","data":{"x":1}}`,
+			check: func(t *testing.T, e *Event) {
+				unknown, ok := e.Unknown()
+				if !ok {
+					t.Fatalf("expected unknown payload, got %T", e.Payload)
+				}
+				if unknown.Type != "future.thing" || unknown.Err != nil {
+					t.Errorf("unexpected payload: %+v", unknown)
+				}
+				if string(e.Data) != `{"x":1}` || string(unknown.Data) != `{"x":1}` {
+					t.Errorf("expected raw data to be preserved, got %s", e.Data)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := ParseEvent([]byte(tt.body))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tt.check(t, event)
+		})
+	}
+}
+
+func TestParseEvent_Invalid(t *testing.T) {
+	for _, body := range []string{`not json`, `{"id":"evt_1"}`, `{"id":"e","type":"message.sent","created_at":"x","data":"oops"}`} {
+		if _, err := ParseEvent([]byte(body)); err == nil {
+			t.Errorf("expected error for %s", body)
+		}
+	}
+}
+
+func TestConstructEvent_Signature(t *testing.T) {
+	body := []byte(`{"id":"evt_1","type":"message.sent","created_at":"2024-01-01T00:00:00Z","data":{"message_id":"msg_1"}}`)
+	signature := Webhooks{}.GenerateSignature(string(body), "secret")
+
+	if _, err := ConstructEvent(body, signature, "secret"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := ConstructEvent(body, signature, "wrong"); err != ErrInvalidSignature {
+		t.Errorf("expected ErrInvalidSignature, got %v", err)
+	}
+}
+
+func TestParseEventWithMode(t *testing.T) {
+	malformed := []byte(`{"id":"e","type":"message.sent","created_at":"x","data":{"segments":"two"}}`)
+	extraField := []byte(`{"id":"e","type":"message.sent","created_at":"x","data":{"message_id":"msg_1","new_field":true}}`)
+	unknownType := []byte(`{"id":"e","type":"future.thing","created_at":"x","data":{}}`)
+
+	event, err := ParseEventWithMode(malformed, EventDecodeLenient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unknown, ok := event.Unknown(); !ok || unknown.Err == nil || unknown.Type != WebhookEventMessageSent {
+		t.Errorf("expected unknown payload with decode error, got %+v", event.Payload)
+	}
+	if _, err := ParseEventWithMode(malformed, EventDecodeDefault); err == nil {
+		t.Error("expected error for malformed data in default mode")
+	}
+
+	if _, err := ParseEventWithMode(extraField, EventDecodeDefault); err != nil {
+		t.Errorf("unexpected error for unknown field in default mode: %v", err)
+	}
+	if _, err := ParseEventWithMode(extraField, EventDecodeStrict); err == nil {
+		t.Error("expected error for unknown field in strict mode")
+	}
+	if _, err := ParseEventWithMode(unknownType, EventDecodeStrict); err == nil {
+		t.Error("expected error for unknown event type in strict mode")
+	}
+}
+
+func FuzzParseEvent(f *testing.F) {
+	for _, seed := range []string{
+		`{"id":"evt_1","type":"message.delivered","created_at":"2024-01-01T00:00:00Z","data":{"message_id":"msg_1","segments":2}}`,
+		`{"id":"evt_2","type":"message.received","created_at":"x","data":{"media":[{"id":"m"}]}}`,
+		`{"id":"evt_3","type":"verify.session.completed","created_at":"x","data":{"metadata":{"a":[1,{"b":null}]}}}`,
+		`{"id":"evt_4","type":"template.updated","created_at":"x","data":null}`,
+		`{"id":"evt_5","type":"contact.opted_in","created_at":"x","data":"oops"}`,
+		`{"id":"evt_6","type":"future.thing","created_at":"x","data":[1,2]}`,
+		`{"id":1}`,
+		`[]`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, body []byte) {
+		for _, mode := range []EventDecodeMode{EventDecodeDefault, EventDecodeLenient, EventDecodeStrict} {
+			event, err := ParseEventWithMode(body, mode)
+			if err != nil {
+				continue
+			}
+			if event.ID == "" || event.Type == "" {
+				t.Errorf("mode %d: accepted event without ID or type", mode)
+			}
+			if mode == EventDecodeLenient && len(event.Data) > 0 && event.Payload == nil {
+				t.Errorf("mode %d: expected a payload", mode)
+			}
+		}
+
+		if _, err := ParseEventWithMode(body, EventDecodeDefault); err == nil {
+			if _, err := ParseEventWithMode(body, EventDecodeLenient); err != nil {
+				t.Errorf("lenient mode rejected an event default mode accepted: %v", err)
+			}
+		}
+	})
+}
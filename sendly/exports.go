@@ -0,0 +1,186 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// ExportsService provides bulk data exports.
+type ExportsService struct {
+	client *Client
+}
+
+// ExportResource is the kind of record an export contains.
+type ExportResource string
+
+const (
+	ExportMessages          ExportResource = "messages"
+	ExportVerifications     ExportResource = "verifications"
+	ExportWebhookDeliveries ExportResource = "webhook_deliveries"
+	ExportContacts          ExportResource = "contacts"
+	ExportInboundMessages   ExportResource = "inbound_messages"
+	ExportSuppressions      ExportResource = "suppressions"
+)
+
+// ExportFormat is the file format of an export.
+type ExportFormat string
+
+const (
+	ExportCSV   ExportFormat = "csv"
+	ExportJSONL ExportFormat = "jsonl"
+)
+
+// ExportStatus represents the state of an export job.
+type ExportStatus string
+
+const (
+	ExportStatusPending   ExportStatus = "pending"
+	ExportStatusRunning   ExportStatus = "running"
+	ExportStatusCompleted ExportStatus = "completed"
+	ExportStatusFailed    ExportStatus = "failed"
+)
+
+// IsTerminal reports whether the export job has finished.
+func (s ExportStatus) IsTerminal() bool {
+	return s == ExportStatusCompleted || s == ExportStatusFailed
+}
+
+// CreateExportRequest represents the parameters for starting an export.
+type CreateExportRequest struct {
+	Resource ExportResource `json:"resource"`
+	// Format defaults to ExportCSV.
+	Format ExportFormat `json:"format,omitempty"`
+	// Start and End bound the export by creation time (RFC 3339 or
+	// YYYY-MM-DD). Both are optional.
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+	// Destination writes the file straight to a customer-owned bucket. If
+	// nil, the file is hosted by Sendly and fetched from Export.DownloadURL.
+	Destination *StorageDestination `json:"destination,omitempty"`
+}
+
+// ExportDestination describes where a finished export was written.
+// Credentials are never returned.
+type ExportDestination struct {
+	Provider StorageProvider `json:"provider"`
+	Bucket   string          `json:"bucket,omitempty"`
+	// ObjectKey is the full object name written to the bucket.
+	ObjectKey string `json:"object_key,omitempty"`
+}
+
+// Export is an asynchronous bulk export job.
+type Export struct {
+	ID          string             `json:"id"`
+	Resource    ExportResource     `json:"resource"`
+	Format      ExportFormat       `json:"format"`
+	Status      ExportStatus       `json:"status"`
+	Start       string             `json:"start,omitempty"`
+	End         string             `json:"end,omitempty"`
+	Destination *ExportDestination `json:"destination,omitempty"`
+	RowCount    int64              `json:"row_count"`
+	SizeBytes   int64              `json:"size_bytes"`
+	// DownloadURL is a pre-signed URL for exports hosted by Sendly, valid
+	// until DownloadURLExpiresAt. It is empty for bucket destinations.
+	DownloadURL          string `json:"download_url,omitempty"`
+	DownloadURLExpiresAt string `json:"download_url_expires_at,omitempty"`
+	// Error describes why a failed export failed, for example denied
+	// bucket access.
+	Error       string `json:"error,omitempty"`
+	CreatedAt   string `json:"created_at"`
+	CompletedAt string `json:"completed_at,omitempty"`
+}
+
+// ExportListOptions are options for listing exports.
+type ExportListOptions struct {
+	// Limit is the maximum number of exports per page (default: 50, max: 200).
+	Limit int
+	// Cursor continues from a previous page's NextCursor.
+	Cursor string
+	// Status filters by job status.
+	Status ExportStatus
+}
+
+// ExportListResponse is a page of exports.
+type ExportListResponse struct {
+	Data       []Export `json:"data"`
+	NextCursor string   `json:"next_cursor,omitempty"`
+	HasMore    bool     `json:"has_more"`
+}
+
+// Create starts an export job. Poll Get until Status.IsTerminal().
+func (s *ExportsService) Create(ctx context.Context, req *CreateExportRequest) (*Export, error) {
+	if req == nil || req.Resource == "" {
+		return nil, invalidParamError("resource", "resource is required")
+	}
+	switch req.Format {
+	case "", ExportCSV, ExportJSONL:
+	default:
+		return nil, invalidParamError("format", "format must be csv or jsonl")
+	}
+	if req.Destination != nil {
+		if err := req.Destination.validate("destination"); err != nil {
+			return nil, err
+		}
+	}
+
+	var resp Export
+	if err := s.client.request(ctx, "POST", "/exports", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get retrieves an export job by ID.
+func (s *ExportsService) Get(ctx context.Context, id string) (*Export, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "export ID is required")
+	}
+
+	var resp Export
+	if err := s.client.request(ctx, "GET", "/exports/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// List retrieves a page of export jobs, newest first.
+func (s *ExportsService) List(ctx context.Context, opts *ExportListOptions) (*ExportListResponse, error) {
+	params := make(map[string]string)
+	if opts != nil {
+		if opts.Limit > 0 {
+			params["limit"] = strconv.Itoa(opts.Limit)
+		}
+		params["cursor"] = opts.Cursor
+		params["status"] = string(opts.Status)
+	}
+
+	var resp ExportListResponse
+	if err := s.client.request(ctx, "GET", "/exports"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAll returns every export job matching opts as an Iter, fetching pages
+// as needed.
+func (s *ExportsService) ListAll(ctx context.Context, opts *ExportListOptions) Iter[Export] {
+	var o ExportListOptions
+	if opts != nil {
+		o = *opts
+	}
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[Export], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[Export]{Items: resp.Data}
+		if resp.HasMore {
+			page.NextCursor = resp.NextCursor
+		}
+		return page, nil
+	}).Iter()
+}
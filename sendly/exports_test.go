@@ -0,0 +1,67 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExportsService_Create(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/exports" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var req CreateExportRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Destination == nil || req.Destination.Provider != StorageGCS || req.Destination.ServiceAccountEmail != "exports@acme.iam.gserviceaccount.com" {
+			t.Errorf("unexpected destination: %+v", req.Destination)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"exp_1","resource":"messages","format":"jsonl","status":"pending","destination":{"provider":"gcs","bucket":"acme-exports"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	export, err := client.Exports.Create(context.Background(), &CreateExportRequest{
+		Resource: ExportMessages,
+		Format:   ExportJSONL,
+		Destination: &StorageDestination{
+			Provider:            StorageGCS,
+			Bucket:              "acme-exports",
+			ServiceAccountEmail: "exports@acme.iam.gserviceaccount.com",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if export.ID != "exp_1" || export.Status.IsTerminal() || export.Destination.Bucket != "acme-exports" {
+		t.Errorf("unexpected export: %+v", export)
+	}
+}
+
+func TestStorageDestination_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		dest  StorageDestination
+		valid bool
+	}{
+		{"s3 role", StorageDestination{Provider: StorageS3, Bucket: "b", RoleARN: "arn:aws:iam::123:role/sendly"}, true},
+		{"s3 keys", StorageDestination{Provider: StorageS3, Bucket: "b", AccessKeyID: "AKIA", SecretAccessKey: "s"}, true},
+		{"presigned", StorageDestination{Provider: StorageS3, UploadURL: "https://b.s3.amazonaws.com/x?X-Amz-Signature=1"}, true},
+		{"gcs key", StorageDestination{Provider: StorageGCS, Bucket: "b", ServiceAccountKey: "{}"}, true},
+		{"no provider", StorageDestination{Bucket: "b", RoleARN: "r"}, false},
+		{"no bucket", StorageDestination{Provider: StorageS3, RoleARN: "r"}, false},
+		{"s3 no credentials", StorageDestination{Provider: StorageS3, Bucket: "b"}, false},
+		{"s3 half keys", StorageDestination{Provider: StorageS3, Bucket: "b", AccessKeyID: "AKIA"}, false},
+		{"gcs with role", StorageDestination{Provider: StorageGCS, Bucket: "b", RoleARN: "r"}, false},
+		{"http upload", StorageDestination{Provider: StorageGCS, UploadURL: "http://example.com"}, false},
+	}
+	for _, tt := range tests {
+		err := tt.dest.validate("destination")
+		if (err == nil) != tt.valid {
+			t.Errorf("%s: expected valid to be %v, got error %v", tt.name, tt.valid, err)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package sendly
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// MaxGroupMembersPerRequest is the maximum number of contacts added to or
+// removed from a group in one call.
+const MaxGroupMembersPerRequest = 1000
+
+// GroupsService provides contact group management.
+type GroupsService struct {
+	client *Client
+}
+
+// Group is a named list of contacts, usable as a campaign audience.
+type Group struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MemberCount int    `json:"member_count"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// CreateGroupRequest represents the parameters for creating a group.
+type CreateGroupRequest struct {
+	// Name is the group name (required).
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// UpdateGroupRequest represents the parameters for updating a group.
+type UpdateGroupRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// GroupListOptions are options for listing groups or group members.
+type GroupListOptions struct {
+	// Limit is the maximum number of results per page (default: 50, max: 200).
+	Limit int
+	// Cursor continues from a previous page's NextCursor.
+	Cursor string
+}
+
+// GroupListResponse is a page of groups.
+type GroupListResponse struct {
+	Data       []Group `json:"data"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+	HasMore    bool    `json:"has_more"`
+}
+
+// GroupMembersResponse is a page of group members.
+type GroupMembersResponse struct {
+	Data       []Contact `json:"data"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+	HasMore    bool      `json:"has_more"`
+}
+
+// GroupMembershipResult summarises a bulk membership change.
+type GroupMembershipResult struct {
+	// Changed is the number of contacts added or removed.
+	Changed int `json:"changed"`
+	// Unchanged is the number already in (or already absent from) the group.
+	Unchanged int `json:"unchanged"`
+	// NotFound lists contact IDs that do not exist.
+	NotFound []string `json:"not_found,omitempty"`
+}
+
+func (o *GroupListOptions) params() map[string]string {
+	params := make(map[string]string)
+	if o != nil {
+		if o.Limit > 0 {
+			params["limit"] = strconv.Itoa(o.Limit)
+		}
+		params["cursor"] = o.Cursor
+	}
+	return params
+}
+
+// Create creates a contact group.
+func (s *GroupsService) Create(ctx context.Context, req *CreateGroupRequest) (*Group, error) {
+	if req == nil || req.Name == "" {
+		return nil, invalidParamError("name", "group name is required")
+	}
+
+	var resp Group
+	if err := s.client.request(ctx, "POST", "/groups", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get retrieves a group by ID.
+func (s *GroupsService) Get(ctx context.Context, id string) (*Group, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "group ID is required")
+	}
+
+	var resp Group
+	if err := s.client.request(ctx, "GET", "/groups/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Update updates a group's name or description.
+func (s *GroupsService) Update(ctx context.Context, id string, req *UpdateGroupRequest) (*Group, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "group ID is required")
+	}
+	if req == nil {
+		return nil, &ValidationError{APIError: APIError{Message: "request is required"}}
+	}
+
+	var resp Group
+	if err := s.client.request(ctx, "PATCH", "/groups/"+url.PathEscape(id), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Delete deletes a group. Member contacts are not deleted.
+func (s *GroupsService) Delete(ctx context.Context, id string) error {
+	if id == "" {
+		return invalidParamError("id", "group ID is required")
+	}
+	return s.client.request(ctx, "DELETE", "/groups/"+url.PathEscape(id), nil, nil)
+}
+
+// List retrieves a page of groups.
+func (s *GroupsService) List(ctx context.Context, opts *GroupListOptions) (*GroupListResponse, error) {
+	var resp GroupListResponse
+	if err := s.client.request(ctx, "GET", "/groups"+buildQueryString(opts.params()), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AddMembers adds up to MaxGroupMembersPerRequest contacts to a group.
+func (s *GroupsService) AddMembers(ctx context.Context, groupID string, contactIDs []string) (*GroupMembershipResult, error) {
+	return s.changeMembers(ctx, "POST", groupID, contactIDs)
+}
+
+// RemoveMembers removes up to MaxGroupMembersPerRequest contacts from a group.
+func (s *GroupsService) RemoveMembers(ctx context.Context, groupID string, contactIDs []string) (*GroupMembershipResult, error) {
+	return s.changeMembers(ctx, "DELETE", groupID, contactIDs)
+}
+
+func (s *GroupsService) changeMembers(ctx context.Context, method, groupID string, contactIDs []string) (*GroupMembershipResult, error) {
+	if groupID == "" {
+		return nil, invalidParamError("group_id", "group ID is required")
+	}
+	if len(contactIDs) == 0 {
+		return nil, invalidParamError("contact_ids", "at least one contact ID is required")
+	}
+	if len(contactIDs) > MaxGroupMembersPerRequest {
+		return nil, invalidParamError("contact_ids", fmt.Sprintf("cannot change more than %d members at once", MaxGroupMembersPerRequest))
+	}
+
+	body := map[string]interface{}{"contact_ids": contactIDs}
+	var resp GroupMembershipResult
+	if err := s.client.request(ctx, method, "/groups/"+url.PathEscape(groupID)+"/members", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListMembers retrieves a page of a group's members.
+func (s *GroupsService) ListMembers(ctx context.Context, groupID string, opts *GroupListOptions) (*GroupMembersResponse, error) {
+	if groupID == "" {
+		return nil, invalidParamError("group_id", "group ID is required")
+	}
+
+	path := "/groups/" + url.PathEscape(groupID) + "/members" + buildQueryString(opts.params())
+	var resp GroupMembersResponse
+	if err := s.client.request(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAllMembers returns every member of a group as an Iter, fetching pages
+// as needed.
+func (s *GroupsService) ListAllMembers(ctx context.Context, groupID string, opts *GroupListOptions) Iter[Contact] {
+	var o GroupListOptions
+	if opts != nil {
+		o = *opts
+	}
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[Contact], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.ListMembers(ctx, groupID, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[Contact]{Items: resp.Data}
+		if resp.HasMore {
+			page.NextCursor = resp.NextCursor
+		}
+		return page, nil
+	}).Iter()
+}
@@ -0,0 +1,32 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGroupsService_AddMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/groups/grp_1/members" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"changed":2,"unchanged":0,"not_found":["con_x"]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	resp, err := client.Groups.AddMembers(context.Background(), "grp_1", []string{"con_1", "con_2", "con_x"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Changed != 2 || len(resp.NotFound) != 1 {
+		t.Errorf("unexpected result: %+v", resp)
+	}
+
+	if _, err := client.Groups.RemoveMembers(context.Background(), "grp_1", nil); !IsValidationError(err) {
+		t.Errorf("expected validation error for empty contact IDs, got %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+package sendly
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+)
+
+// idempotencyKeyHeader lets the API recognise a retried request and return
+// the original result instead of performing the operation twice.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+type idempotencyKey struct{}
+
+// WithIdempotencyKey returns a context that sends key as the Idempotency-Key
+// for the request. The same key is reused across automatic retries, and
+// replaying it later returns the original response. Helpers that send
+// several requests, such as Parallel, SendAll and SendMany, derive a
+// separate key per request from it. The key is only sent on mutating
+// requests (POST, PUT, PATCH and DELETE), so lookups made along the way,
+// such as the suppression check for FailIfSuppressed, don't consume it.
+//
+// Example:
+//
+//	ctx = sendly.WithIdempotencyKey(ctx, "otp-"+userID+"-"+attemptID)
+//	verification, err := client.Verify.Send(ctx, req)
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// idempotencyKeyFromContext returns the idempotency key attached to ctx, if any.
+func idempotencyKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKey{}).(string)
+	return key
+}
+
+// withAutoIdempotencyKey attaches a generated key to ctx for POST requests
+// when AutoIdempotency is enabled and the caller didn't supply one.
+func (c *Client) withAutoIdempotencyKey(ctx context.Context, method string) context.Context {
+	if !c.AutoIdempotency || method != http.MethodPost || idempotencyKeyFromContext(ctx) != "" {
+		return ctx
+	}
+	key, err := newIdempotencyKey()
+	if err != nil {
+		return ctx
+	}
+	return WithIdempotencyKey(ctx, key)
+}
+
+// mutatingMethod reports whether requests with method carry an idempotency
+// key.
+func mutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// newIdempotencyKey returns a random UUIDv4.
+func newIdempotencyKey() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,136 @@
+package sendly
+
+import (
+	"context"
+	"strings"
+)
+
+// KeywordCategory classifies an inbound keyword.
+type KeywordCategory string
+
+const (
+	// KeywordCategoryOptOut covers STOP and the other opt-out keywords.
+	KeywordCategoryOptOut KeywordCategory = "opt_out"
+	// KeywordCategoryOptIn covers START, UNSTOP and opt-in confirmations.
+	KeywordCategoryOptIn KeywordCategory = "opt_in"
+	// KeywordCategoryHelp covers HELP and INFO.
+	KeywordCategoryHelp KeywordCategory = "help"
+	// KeywordCategoryCustom covers keywords configured on the account.
+	KeywordCategoryCustom KeywordCategory = "custom"
+)
+
+// KeywordGroupBy is the dimension used to group inbound keyword rows.
+type KeywordGroupBy string
+
+const (
+	// KeywordGroupByNumber groups rows by the number the keyword was sent to.
+	KeywordGroupByNumber KeywordGroupBy = "number"
+	// KeywordGroupByCampaign groups rows by the campaign of the last message
+	// the sender received before replying, to find campaigns causing
+	// opt-out spikes.
+	KeywordGroupByCampaign KeywordGroupBy = "campaign"
+)
+
+// InboundKeywordOptions are options for the inbound keyword report.
+type InboundKeywordOptions struct {
+	// Start is the beginning of the period in ISO 8601 format (required).
+	Start string
+	// End is the end of the period in ISO 8601 format (default: now).
+	End string
+	// Granularity is the bucket size of each row's time series (default: day).
+	Granularity UsageGranularity
+	// GroupBy is the grouping dimension (default: number).
+	GroupBy KeywordGroupBy
+	// Number restricts the report to one owned number (E.164).
+	Number string
+	// Category restricts the report to one keyword category.
+	Category KeywordCategory
+	// Keywords restricts the report to these keywords (case-insensitive).
+	Keywords []string
+}
+
+// KeywordBucket is one period of a keyword time series.
+type KeywordBucket struct {
+	// Start is the beginning of the bucket (ISO 8601).
+	Start string `json:"start"`
+	Count int    `json:"count"`
+}
+
+// InboundKeywordRow is the traffic for one keyword on one number or
+// campaign.
+type InboundKeywordRow struct {
+	Number     string `json:"number,omitempty"`
+	CampaignID string `json:"campaign_id,omitempty"`
+	// Keyword is the normalized (upper case) keyword.
+	Keyword  string          `json:"keyword"`
+	Category KeywordCategory `json:"category"`
+	Count    int             `json:"count"`
+	Buckets  []KeywordBucket `json:"buckets"`
+}
+
+// InboundKeywordTotals summarizes keyword traffic for one number or
+// campaign.
+type InboundKeywordTotals struct {
+	Number     string `json:"number,omitempty"`
+	CampaignID string `json:"campaign_id,omitempty"`
+	// MessagesSent is the number of outbound messages in the period.
+	MessagesSent int `json:"messages_sent"`
+	// InboundMessages is the number of inbound messages in the period,
+	// including those that matched no keyword.
+	InboundMessages int `json:"inbound_messages"`
+	OptOuts         int `json:"opt_outs"`
+	HelpRequests    int `json:"help_requests"`
+	// OptOutRate is OptOuts per outbound message (0-1).
+	OptOutRate float64 `json:"opt_out_rate"`
+}
+
+// InboundKeywordReport contains inbound keyword counts over a period.
+type InboundKeywordReport struct {
+	PeriodStart string                 `json:"period_start"`
+	PeriodEnd   string                 `json:"period_end"`
+	Granularity UsageGranularity       `json:"granularity"`
+	GroupBy     KeywordGroupBy         `json:"group_by"`
+	Rows        []InboundKeywordRow    `json:"rows"`
+	Totals      []InboundKeywordTotals `json:"totals"`
+}
+
+// InboundKeywords returns STOP, HELP and custom keyword counts per number or
+// campaign as a time series, with opt-out rates, for monitoring compliance.
+//
+// Example:
+//
+//	report, err := client.Reports.InboundKeywords(ctx, &sendly.InboundKeywordOptions{
+//	    Start:   time.Now().AddDate(0, 0, -30).Format(time.RFC3339),
+//	    GroupBy: sendly.KeywordGroupByCampaign,
+//	})
+//	for _, t := range report.Totals {
+//	    if t.OptOutRate > 0.02 {
+//	        fmt.Printf("campaign %s: %.1f%% opt-outs\n", t.CampaignID, t.OptOutRate*100)
+//	    }
+//	}
+func (s *ReportsService) InboundKeywords(ctx context.Context, opts *InboundKeywordOptions) (*InboundKeywordReport, error) {
+	if opts == nil || opts.Start == "" {
+		return nil, invalidParamError("start", "start is required")
+	}
+	switch opts.GroupBy {
+	case "", KeywordGroupByNumber, KeywordGroupByCampaign:
+	default:
+		return nil, invalidParamError("group_by", "group_by must be number or campaign")
+	}
+
+	params := map[string]string{
+		"start":       opts.Start,
+		"end":         opts.End,
+		"granularity": string(opts.Granularity),
+		"group_by":    string(opts.GroupBy),
+		"number":      opts.Number,
+		"category":    string(opts.Category),
+		"keywords":    strings.Join(opts.Keywords, ","),
+	}
+
+	var resp InboundKeywordReport
+	if err := s.client.request(ctx, "GET", "/reports/inbound-keywords"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,47 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReportsService_InboundKeywords(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reports/inbound-keywords" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("keywords") != "STOP,QUIT" || q.Get("group_by") != "campaign" || q.Has("number") {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"period_start":"2025-01-01T00:00:00Z","granularity":"day","group_by":"campaign",
+			"rows":[{"campaign_id":"cmp_1","keyword":"STOP","category":"opt_out","count":12,"buckets":[{"start":"2025-01-01T00:00:00Z","count":2},{"start":"2025-01-02T00:00:00Z","count":10}]}],
+			"totals":[{"campaign_id":"cmp_1","messages_sent":400,"inbound_messages":30,"opt_outs":12,"opt_out_rate":0.03}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	report, err := client.Reports.InboundKeywords(context.Background(), &InboundKeywordOptions{
+		Start:    "2025-01-01T00:00:00Z",
+		GroupBy:  KeywordGroupByCampaign,
+		Keywords: []string{"STOP", "QUIT"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Rows) != 1 || report.Rows[0].Category != KeywordCategoryOptOut || len(report.Rows[0].Buckets) != 2 {
+		t.Errorf("unexpected rows: %+v", report.Rows)
+	}
+	if len(report.Totals) != 1 || report.Totals[0].OptOutRate != 0.03 {
+		t.Errorf("unexpected totals: %+v", report.Totals)
+	}
+
+	for _, opts := range []*InboundKeywordOptions{nil, {}, {Start: "2025-01-01T00:00:00Z", GroupBy: "country"}} {
+		if _, err := client.Reports.InboundKeywords(context.Background(), opts); !IsValidationError(err) {
+			t.Errorf("expected validation error for %+v, got %v", opts, err)
+		}
+	}
+}
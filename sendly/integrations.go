@@ -0,0 +1,304 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// IntegrationsService manages connectors that stream delivery events to
+// analytics warehouses, without a webhook-to-warehouse bridge.
+type IntegrationsService struct {
+	client *Client
+}
+
+// WarehouseProvider is an analytics warehouse a connector writes to.
+type WarehouseProvider string
+
+const (
+	WarehouseBigQuery   WarehouseProvider = "bigquery"
+	WarehouseSnowflake  WarehouseProvider = "snowflake"
+	WarehouseClickHouse WarehouseProvider = "clickhouse"
+)
+
+// ConnectorStream is a kind of event a connector forwards. Each stream is
+// written to its own table.
+type ConnectorStream string
+
+const (
+	// ConnectorStreamMessageStatus forwards message status changes
+	// (queued, sent, delivered, failed).
+	ConnectorStreamMessageStatus ConnectorStream = "message_status"
+	// ConnectorStreamVerificationStatus forwards verification status
+	// changes.
+	ConnectorStreamVerificationStatus ConnectorStream = "verification_status"
+)
+
+// ConnectorStatus is the health of a connector.
+type ConnectorStatus string
+
+const (
+	ConnectorStatusActive  ConnectorStatus = "active"
+	ConnectorStatusPaused  ConnectorStatus = "paused"
+	ConnectorStatusFailing ConnectorStatus = "failing"
+)
+
+// BigQueryConfig locates a BigQuery dataset. Grant access with
+// ServiceAccountEmail (preferred; Sendly impersonates it) or a
+// ServiceAccountKey JSON document.
+type BigQueryConfig struct {
+	ProjectID string `json:"project_id"`
+	Dataset   string `json:"dataset"`
+	// Location is the dataset location, e.g. "US" or "europe-west1".
+	Location            string `json:"location,omitempty"`
+	ServiceAccountEmail string `json:"service_account_email,omitempty"`
+	ServiceAccountKey   string `json:"service_account_key,omitempty"`
+}
+
+// SnowflakeConfig locates a Snowflake schema. Sendly authenticates as User
+// with key-pair authentication.
+type SnowflakeConfig struct {
+	// Account is the account identifier, e.g. "myorg-myaccount".
+	Account   string `json:"account"`
+	Database  string `json:"database"`
+	Schema    string `json:"schema"`
+	Warehouse string `json:"warehouse"`
+	Role      string `json:"role,omitempty"`
+	User      string `json:"user"`
+	// PrivateKey is the PEM-encoded private key of User.
+	PrivateKey string `json:"private_key,omitempty"`
+}
+
+// ClickHouseConfig locates a ClickHouse database.
+type ClickHouseConfig struct {
+	// URL is the HTTPS endpoint of the server, e.g.
+	// "https://abc123.clickhouse.cloud:8443".
+	URL      string `json:"url"`
+	Database string `json:"database"`
+	Username string `json:"username"`
+	Password string `json:"password,omitempty"`
+}
+
+// WarehouseConnector streams delivery events to a warehouse. Credentials
+// are write-only: they are never returned by the API.
+type WarehouseConnector struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Provider   WarehouseProvider `json:"provider"`
+	Streams    []ConnectorStream `json:"streams"`
+	BigQuery   *BigQueryConfig   `json:"bigquery,omitempty"`
+	Snowflake  *SnowflakeConfig  `json:"snowflake,omitempty"`
+	ClickHouse *ClickHouseConfig `json:"clickhouse,omitempty"`
+	// TablePrefix is prepended to the table names (default: "sendly_").
+	TablePrefix string          `json:"table_prefix,omitempty"`
+	Status      ConnectorStatus `json:"status"`
+	// LastError describes the most recent write failure of a failing
+	// connector, for example a revoked grant.
+	LastError string `json:"last_error,omitempty"`
+	// LastDeliveredAt is when events were last written.
+	LastDeliveredAt string `json:"last_delivered_at,omitempty"`
+	// LagSeconds is the age of the oldest event not yet written.
+	LagSeconds int    `json:"lag_seconds"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// CreateConnectorRequest represents the parameters for creating a warehouse
+// connector. Set the config matching Provider.
+type CreateConnectorRequest struct {
+	Name     string            `json:"name,omitempty"`
+	Provider WarehouseProvider `json:"provider"`
+	// Streams defaults to every stream.
+	Streams     []ConnectorStream `json:"streams,omitempty"`
+	BigQuery    *BigQueryConfig   `json:"bigquery,omitempty"`
+	Snowflake   *SnowflakeConfig  `json:"snowflake,omitempty"`
+	ClickHouse  *ClickHouseConfig `json:"clickhouse,omitempty"`
+	TablePrefix string            `json:"table_prefix,omitempty"`
+	// Backfill also writes events from the last 30 days.
+	Backfill bool `json:"backfill,omitempty"`
+}
+
+// UpdateConnectorRequest represents the parameters for updating a warehouse
+// connector. Nil fields are left unchanged; a new config replaces the old
+// one, including its credentials.
+type UpdateConnectorRequest struct {
+	Name       *string           `json:"name,omitempty"`
+	Streams    []ConnectorStream `json:"streams,omitempty"`
+	BigQuery   *BigQueryConfig   `json:"bigquery,omitempty"`
+	Snowflake  *SnowflakeConfig  `json:"snowflake,omitempty"`
+	ClickHouse *ClickHouseConfig `json:"clickhouse,omitempty"`
+	Paused     *bool             `json:"paused,omitempty"`
+}
+
+// ConnectorListResponse is the list of warehouse connectors.
+type ConnectorListResponse struct {
+	Data []WarehouseConnector `json:"data"`
+}
+
+// ConnectorTestResult is the outcome of a connector test.
+type ConnectorTestResult struct {
+	Success bool `json:"success"`
+	// Error describes why the warehouse could not be written to.
+	Error string `json:"error,omitempty"`
+}
+
+func validateConnectorStreams(streams []ConnectorStream) error {
+	for _, s := range streams {
+		switch s {
+		case ConnectorStreamMessageStatus, ConnectorStreamVerificationStatus:
+		default:
+			return invalidParamError("streams", "unknown stream "+string(s))
+		}
+	}
+	return nil
+}
+
+func (c *BigQueryConfig) validate() error {
+	if c.ProjectID == "" || c.Dataset == "" {
+		return invalidParamError("bigquery", "project_id and dataset are required")
+	}
+	if (c.ServiceAccountEmail == "") == (c.ServiceAccountKey == "") {
+		return invalidParamError("bigquery.service_account_email", "exactly one of service_account_email and service_account_key is required")
+	}
+	return nil
+}
+
+func (c *SnowflakeConfig) validate() error {
+	if c.Account == "" || c.Database == "" || c.Schema == "" || c.Warehouse == "" {
+		return invalidParamError("snowflake", "account, database, schema and warehouse are required")
+	}
+	if c.User == "" || c.PrivateKey == "" {
+		return invalidParamError("snowflake.private_key", "user and private_key are required")
+	}
+	return nil
+}
+
+func (c *ClickHouseConfig) validate() error {
+	if !strings.HasPrefix(c.URL, "https://") {
+		return invalidParamError("clickhouse.url", "ClickHouse URL must be HTTPS")
+	}
+	if c.Database == "" || c.Username == "" {
+		return invalidParamError("clickhouse", "database and username are required")
+	}
+	return nil
+}
+
+// CreateConnector creates a warehouse connector. Sendly creates the tables
+// and starts streaming once it has verified write access.
+func (s *IntegrationsService) CreateConnector(ctx context.Context, req *CreateConnectorRequest) (*WarehouseConnector, error) {
+	if req == nil {
+		return nil, invalidParamError("provider", "provider is required")
+	}
+	if err := validateConnectorStreams(req.Streams); err != nil {
+		return nil, err
+	}
+
+	var err error
+	switch req.Provider {
+	case WarehouseBigQuery:
+		if req.BigQuery == nil || req.Snowflake != nil || req.ClickHouse != nil {
+			return nil, invalidParamError("bigquery", "only bigquery config must be set for bigquery connectors")
+		}
+		err = req.BigQuery.validate()
+	case WarehouseSnowflake:
+		if req.Snowflake == nil || req.BigQuery != nil || req.ClickHouse != nil {
+			return nil, invalidParamError("snowflake", "only snowflake config must be set for snowflake connectors")
+		}
+		err = req.Snowflake.validate()
+	case WarehouseClickHouse:
+		if req.ClickHouse == nil || req.BigQuery != nil || req.Snowflake != nil {
+			return nil, invalidParamError("clickhouse", "only clickhouse config must be set for clickhouse connectors")
+		}
+		err = req.ClickHouse.validate()
+	default:
+		return nil, invalidParamError("provider", "provider must be bigquery, snowflake or clickhouse")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var resp WarehouseConnector
+	if err := s.client.request(ctx, "POST", "/integrations/connectors", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListConnectors retrieves the account's warehouse connectors.
+func (s *IntegrationsService) ListConnectors(ctx context.Context) (*ConnectorListResponse, error) {
+	var resp ConnectorListResponse
+	if err := s.client.request(ctx, "GET", "/integrations/connectors", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetConnector retrieves a warehouse connector by ID.
+func (s *IntegrationsService) GetConnector(ctx context.Context, id string) (*WarehouseConnector, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "connector ID is required")
+	}
+
+	var resp WarehouseConnector
+	if err := s.client.request(ctx, "GET", "/integrations/connectors/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateConnector changes a warehouse connector, including pausing it.
+// Events are buffered while a connector is paused and written when it is
+// resumed.
+func (s *IntegrationsService) UpdateConnector(ctx context.Context, id string, req *UpdateConnectorRequest) (*WarehouseConnector, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "connector ID is required")
+	}
+	if req == nil {
+		return nil, invalidParamError("request", "request is required")
+	}
+	if err := validateConnectorStreams(req.Streams); err != nil {
+		return nil, err
+	}
+	switch {
+	case req.BigQuery != nil:
+		if err := req.BigQuery.validate(); err != nil {
+			return nil, err
+		}
+	case req.Snowflake != nil:
+		if err := req.Snowflake.validate(); err != nil {
+			return nil, err
+		}
+	case req.ClickHouse != nil:
+		if err := req.ClickHouse.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	var resp WarehouseConnector
+	if err := s.client.request(ctx, "PATCH", "/integrations/connectors/"+url.PathEscape(id), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteConnector stops a warehouse connector. Tables already written are
+// left in place.
+func (s *IntegrationsService) DeleteConnector(ctx context.Context, id string) error {
+	if id == "" {
+		return invalidParamError("id", "connector ID is required")
+	}
+	return s.client.request(ctx, "DELETE", "/integrations/connectors/"+url.PathEscape(id), nil, nil)
+}
+
+// TestConnector checks that Sendly can still write to the connector's
+// warehouse, for example after rotating credentials.
+func (s *IntegrationsService) TestConnector(ctx context.Context, id string) (*ConnectorTestResult, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "connector ID is required")
+	}
+
+	var resp ConnectorTestResult
+	if err := s.client.request(ctx, "POST", "/integrations/connectors/"+url.PathEscape(id)+"/test", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
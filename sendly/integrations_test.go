@@ -0,0 +1,57 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIntegrationsService_CreateConnector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/integrations/connectors" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body CreateConnectorRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.Provider != WarehouseBigQuery || body.BigQuery == nil || body.BigQuery.Dataset != "sms" {
+			t.Errorf("unexpected body: %+v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"conn_1","provider":"bigquery","streams":["message_status","verification_status"],"bigquery":{"project_id":"acme","dataset":"sms"},"status":"active","created_at":"2025-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	conn, err := client.Integrations.CreateConnector(context.Background(), &CreateConnectorRequest{
+		Provider: WarehouseBigQuery,
+		BigQuery: &BigQueryConfig{ProjectID: "acme", Dataset: "sms", ServiceAccountEmail: "sendly@acme.iam.gserviceaccount.com"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if conn.ID != "conn_1" || conn.Status != ConnectorStatusActive || len(conn.Streams) != 2 {
+		t.Errorf("unexpected connector: %+v", conn)
+	}
+}
+
+func TestIntegrationsService_CreateConnectorValidation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	tests := []*CreateConnectorRequest{
+		nil,
+		{Provider: "redshift"},
+		{Provider: WarehouseBigQuery},
+		{Provider: WarehouseSnowflake, BigQuery: &BigQueryConfig{ProjectID: "p", Dataset: "d", ServiceAccountKey: "{}"}},
+		{Provider: WarehouseSnowflake, Snowflake: &SnowflakeConfig{Account: "a", Database: "d", Schema: "s", Warehouse: "w", User: "u"}},
+		{Provider: WarehouseClickHouse, ClickHouse: &ClickHouseConfig{URL: "http://ch:8123", Database: "d", Username: "u"}},
+		{Provider: WarehouseClickHouse, Streams: []ConnectorStream{"clicks"}, ClickHouse: &ClickHouseConfig{URL: "https://ch:8443", Database: "d", Username: "u"}},
+	}
+	for i, req := range tests {
+		if _, err := client.Integrations.CreateConnector(ctx, req); !IsValidationError(err) {
+			t.Errorf("case %d: expected validation error, got %v", i, err)
+		}
+	}
+}
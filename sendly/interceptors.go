@@ -0,0 +1,52 @@
+package sendly
+
+import "net/http"
+
+// RequestInterceptor is called with every outgoing HTTP request, including
+// retries, after the SDK has set its own headers. It may modify the request,
+// for example to refresh credentials or add tracing headers. When request
+// signing is enabled, the request is signed after the interceptors run.
+// Returning an error aborts the call with that error.
+type RequestInterceptor func(req *http.Request) error
+
+// ResponseInterceptor is called with every HTTP response, including error
+// responses and retried attempts, before the SDK reads it. The original
+// request is available as resp.Request. Interceptors must not read or close
+// resp.Body. Returning an error aborts the call with that error.
+type ResponseInterceptor func(resp *http.Response) error
+
+// WithRequestInterceptor appends request interceptors. They run in the
+// order added.
+func WithRequestInterceptor(interceptors ...RequestInterceptor) ClientOption {
+	return func(c *Client) {
+		c.RequestInterceptors = append(c.RequestInterceptors, interceptors...)
+	}
+}
+
+// WithResponseInterceptor appends response interceptors. They run in the
+// order added.
+func WithResponseInterceptor(interceptors ...ResponseInterceptor) ClientOption {
+	return func(c *Client) {
+		c.ResponseInterceptors = append(c.ResponseInterceptors, interceptors...)
+	}
+}
+
+// interceptRequest runs the client's request interceptors.
+func (c *Client) interceptRequest(req *http.Request) error {
+	for _, intercept := range c.RequestInterceptors {
+		if err := intercept(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// interceptResponse runs the client's response interceptors.
+func (c *Client) interceptResponse(resp *http.Response) error {
+	for _, intercept := range c.ResponseInterceptors {
+		if err := intercept(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
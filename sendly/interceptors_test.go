@@ -0,0 +1,82 @@
+package sendly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClientRequest_Interceptors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "Bearer refreshed-key" {
+			t.Errorf("expected Authorization to be 'Bearer refreshed-key', got '%s'", auth)
+		}
+		w.Header().Set("X-Request-Id", "req_1")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var seen []string
+	client := NewClient("test-api-key",
+		WithBaseURL(server.URL),
+		WithRequestInterceptor(func(req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer refreshed-key")
+			return nil
+		}),
+		WithResponseInterceptor(func(resp *http.Response) error {
+			seen = append(seen, resp.Request.URL.Path+" "+resp.Header.Get("X-Request-Id"))
+			return nil
+		}),
+	)
+
+	if err := client.request(context.Background(), "GET", "/test", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(seen) != 1 || seen[0] != "/test req_1" {
+		t.Errorf("unexpected responses seen: %v", seen)
+	}
+}
+
+func TestClientRequest_InterceptorError(t *testing.T) {
+	errBlocked := errors.New("blocked by policy")
+	client := NewClient("test-api-key",
+		WithBaseURL("http://127.0.0.1:0"),
+		WithRequestInterceptor(func(req *http.Request) error { return errBlocked }),
+	)
+
+	if err := client.request(context.Background(), "GET", "/test", nil, nil); !errors.Is(err, errBlocked) {
+		t.Errorf("expected interceptor error, got %v", err)
+	}
+}
+
+func TestClientRequest_InterceptorWrappedRateLimitIsRetried(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key",
+		WithBaseURL(server.URL),
+		WithRetry(2, time.Millisecond),
+		WithResponseInterceptor(func(resp *http.Response) error {
+			if calls == 1 {
+				return fmt.Errorf("gateway throttled: %w", &RateLimitError{APIError: APIError{Code: "RATE_LIMITED"}})
+			}
+			return nil
+		}),
+	)
+
+	if err := client.request(context.Background(), "GET", "/test", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected the wrapped rate limit error to be retried, got %d calls", calls)
+	}
+}
@@ -0,0 +1,151 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// redacted replaces sensitive values in logged request and response bodies.
+const redacted = "[REDACTED]"
+
+// sensitiveLogKeys are JSON keys whose values are never logged. Keys
+// containing "secret" or "token" are redacted as well.
+var sensitiveLogKeys = map[string]bool{
+	"code":        true,
+	"otp":         true,
+	"key":         true,
+	"api_key":     true,
+	"apikey":      true,
+	"password":    true,
+	"signing_key": true,
+	"short_code":  true,
+	// Storage destination credentials.
+	"service_account_key": true,
+	"upload_url":          true,
+	// Warehouse connector credentials.
+	"private_key": true,
+	// TOTP enrollment.
+	"provisioning_uri": true,
+	"qr_code":          true,
+	"backup_codes":     true,
+	// Webhook endpoint headers, which often carry credentials.
+	"headers": true,
+}
+
+// WithLogger emits a structured log record for every HTTP attempt: method,
+// path, status, duration, request ID and attempt number. Bodies are added
+// when the log level is slog.LevelDebug or lower, with API keys, OTP codes
+// and secrets redacted.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.Logger = logger
+	}
+}
+
+// WithLogLevel sets the level of successful request logs (default:
+// slog.LevelDebug). Failed attempts are logged at slog.LevelWarn or this
+// level, whichever is higher.
+func WithLogLevel(level slog.Level) ClientOption {
+	return func(c *Client) {
+		c.LogLevel = level
+	}
+}
+
+// logger returns the logger to use, or nil if logging is off. Debug mode
+// without a logger logs to slog.Default at info level so records are
+// visible with the default handler.
+func (c *Client) logger() (*slog.Logger, slog.Level) {
+	if c.Logger != nil {
+		return c.Logger, c.LogLevel
+	}
+	if c.Debug {
+		return slog.Default(), slog.LevelInfo
+	}
+	return nil, 0
+}
+
+// logAttempt logs one HTTP attempt. resp is nil for network errors.
+func (c *Client) logAttempt(ctx context.Context, req *http.Request, resp *http.Response, duration time.Duration, err error, reqBody, respBody []byte) {
+	logger, level := c.logger()
+	if logger == nil {
+		return
+	}
+	if err != nil || (resp != nil && resp.StatusCode >= 400) {
+		level = max(level, slog.LevelWarn)
+	}
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Duration("duration", duration),
+	}
+	if info := callInfoFromContext(ctx); info != nil {
+		attrs = append(attrs, slog.Int("attempt", info.Attempts))
+	}
+	if resp != nil {
+		attrs = append(attrs,
+			slog.Int("status", resp.StatusCode),
+			slog.String("request_id", resp.Header.Get("X-Request-Id")),
+		)
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	if logger.Enabled(ctx, slog.LevelDebug) && level <= slog.LevelDebug {
+		if len(reqBody) > 0 {
+			attrs = append(attrs, slog.String("request_body", redactJSON(reqBody)))
+		}
+		if len(respBody) > 0 {
+			attrs = append(attrs, slog.String("response_body", redactJSON(respBody)))
+		}
+	}
+
+	logger.LogAttrs(ctx, level, "sendly request", attrs...)
+}
+
+// redactJSON returns body with sensitive values replaced. Bodies that are
+// not JSON are omitted entirely rather than risk leaking secrets.
+func redactJSON(body []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "[non-JSON body omitted]"
+	}
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return "[unloggable body omitted]"
+	}
+	return string(out)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if isSensitiveLogKey(k) {
+				v[k] = redacted
+			} else {
+				v[k] = redactValue(val)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactValue(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitiveLogKey(key string) bool {
+	key = strings.ToLower(key)
+	return sensitiveLogKeys[key] || strings.Contains(key, "secret") || strings.Contains(key, "token")
+}
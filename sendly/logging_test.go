@@ -0,0 +1,67 @@
+package sendly
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClientLogger_RedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_123")
+		w.Write([]byte(`{"id":"whk_1","secret":"whsec_live","nested":{"otp":"987123"}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := NewClient("sk_live_v1_secretkey", WithBaseURL(server.URL), WithLogger(logger))
+
+	body := map[string]string{"code": "654321", "to": "+15551234567"}
+	if err := client.request(context.Background(), "POST", "/verify/check", body, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	for _, leaked := range []string{"sk_live_v1_secretkey", "654321", "987123", "whsec_live"} {
+		if strings.Contains(out, leaked) {
+			t.Errorf("expected %q to be redacted, got %s", leaked, out)
+		}
+	}
+	for _, want := range []string{`"path":"/verify/check"`, `"status":200`, `"request_id":"req_123"`, `+15551234567`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected log to contain %s, got %s", want, out)
+		}
+	}
+}
+
+func TestClientLogger_LevelFiltering(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithLogger(logger))
+	if err := client.request(context.Background(), "GET", "/account", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected debug-level request log to be filtered, got %s", buf.String())
+	}
+
+	client = NewClient("test-api-key", WithBaseURL(server.URL), WithLogger(logger), WithLogLevel(slog.LevelInfo))
+	if err := client.request(context.Background(), "GET", "/account", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "path=/account") || strings.Contains(buf.String(), "response_body") {
+		t.Errorf("expected info log without bodies, got %s", buf.String())
+	}
+}
@@ -0,0 +1,125 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// LookupService provides phone number intelligence: carrier, line type,
+// portability and live reachability.
+type LookupService struct {
+	client *Client
+}
+
+// LineType classifies the kind of line a number belongs to.
+type LineType string
+
+const (
+	LineTypeMobile   LineType = "mobile"
+	LineTypeLandline LineType = "landline"
+	LineTypeVoIP     LineType = "voip"
+	LineTypeTollFree LineType = "toll_free"
+	LineTypePremium  LineType = "premium"
+	LineTypeUnknown  LineType = "unknown"
+)
+
+// Reachability is the live network status of a number, as reported by an
+// HLR query.
+type Reachability string
+
+const (
+	ReachabilityReachable   Reachability = "reachable"
+	ReachabilityUnreachable Reachability = "unreachable"
+	ReachabilityAbsent      Reachability = "absent"
+	ReachabilityUnknown     Reachability = "unknown"
+)
+
+// LookupPackage selects optional, separately billed lookup data.
+type LookupPackage string
+
+const (
+	// LookupCarrier adds current carrier and portability data.
+	LookupCarrier LookupPackage = "carrier"
+	// LookupHLR adds a live HLR query for reachability and roaming.
+	LookupHLR LookupPackage = "hlr"
+)
+
+// LookupOptions are options for a phone number lookup.
+type LookupOptions struct {
+	// Packages selects optional lookup data. Format, country and line type
+	// are always returned.
+	Packages []LookupPackage
+	// CountryCode is the ISO 3166-1 alpha-2 country used to parse numbers
+	// not in E.164 format.
+	CountryCode string
+}
+
+// Carrier identifies a mobile network operator.
+type Carrier struct {
+	Name string `json:"name"`
+	// MCC and MNC are the mobile country and network codes.
+	MCC string `json:"mcc,omitempty"`
+	MNC string `json:"mnc,omitempty"`
+}
+
+// PhoneLookup is the result of a phone number lookup.
+type PhoneLookup struct {
+	// Phone is the number normalised to E.164.
+	Phone string `json:"phone"`
+	// Valid reports whether the number is a dialable number.
+	Valid       bool     `json:"valid"`
+	CountryCode string   `json:"country_code"`
+	CountryName string   `json:"country_name,omitempty"`
+	LineType    LineType `json:"line_type"`
+	// Carrier is the current carrier. Set with LookupCarrier.
+	Carrier *Carrier `json:"carrier,omitempty"`
+	// OriginalCarrier is the carrier the number was allocated to. Set with
+	// LookupCarrier.
+	OriginalCarrier *Carrier `json:"original_carrier,omitempty"`
+	// Ported reports whether the number was ported. Set with LookupCarrier.
+	Ported bool `json:"ported"`
+	// Reachability is set with LookupHLR.
+	Reachability Reachability `json:"reachability,omitempty"`
+	// Roaming reports whether the handset is roaming. Set with LookupHLR.
+	Roaming     bool `json:"roaming"`
+	CreditsUsed int  `json:"credits_used"`
+}
+
+// CanReceiveSMS reports whether the lookup suggests SMS to this number can
+// be delivered. Unknown line types and reachability are given the benefit
+// of the doubt.
+func (l *PhoneLookup) CanReceiveSMS() bool {
+	if !l.Valid {
+		return false
+	}
+	switch l.LineType {
+	case LineTypeLandline, LineTypePremium:
+		return false
+	}
+	return l.Reachability != ReachabilityUnreachable && l.Reachability != ReachabilityAbsent
+}
+
+// Lookup retrieves carrier, country, line type and, optionally,
+// portability and reachability data for a phone number.
+func (s *LookupService) Lookup(ctx context.Context, phone string, opts *LookupOptions) (*PhoneLookup, error) {
+	if phone == "" {
+		return nil, invalidParamError("phone", "phone number is required")
+	}
+
+	params := make(map[string]string)
+	if opts != nil {
+		packages := make([]string, len(opts.Packages))
+		for i, p := range opts.Packages {
+			packages[i] = string(p)
+		}
+		params["packages"] = strings.Join(packages, ",")
+		params["country_code"] = opts.CountryCode
+	}
+
+	var resp PhoneLookup
+	if err := s.client.request(ctx, "GET", "/lookup/"+url.PathEscape(phone)+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,36 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLookupService_Lookup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/lookup/+15551234567" {
+			t.Errorf("expected path to be '/lookup/+15551234567', got '%s'", r.URL.Path)
+		}
+		if p := r.URL.Query().Get("packages"); p != "carrier,hlr" {
+			t.Errorf("expected packages to be 'carrier,hlr', got '%s'", p)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"phone":"+15551234567","valid":true,"country_code":"US","line_type":"landline","carrier":{"name":"Example Tel"},"ported":true,"reachability":"reachable"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	result, err := client.Lookup.Lookup(context.Background(), "+15551234567", &LookupOptions{
+		Packages: []LookupPackage{LookupCarrier, LookupHLR},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Carrier == nil || result.Carrier.Name != "Example Tel" || !result.Ported {
+		t.Errorf("unexpected carrier data: %+v", result)
+	}
+	if result.CanReceiveSMS() {
+		t.Error("expected landline not to receive SMS")
+	}
+}
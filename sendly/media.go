@@ -0,0 +1,125 @@
+package sendly
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// MediaService provides access to MMS media attachments.
+type MediaService struct {
+	client *Client
+}
+
+// Media is an MMS attachment. Its content is fetched separately with
+// MediaService.Download.
+type Media struct {
+	ID          string `json:"id"`
+	ContentType string `json:"content_type"`
+	// Size is the content length in bytes.
+	Size      int64  `json:"size"`
+	Filename  string `json:"filename,omitempty"`
+	MessageID string `json:"message_id,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+// MediaDownload describes a completed download.
+type MediaDownload struct {
+	ContentType string
+	// Written is the number of bytes written to the destination.
+	Written int64
+	// Size is the full size of the media in bytes, or -1 if unknown.
+	Size int64
+}
+
+// Get retrieves a media object's metadata.
+func (s *MediaService) Get(ctx context.Context, id string) (*Media, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "media ID is required")
+	}
+
+	var resp Media
+	if err := s.client.request(ctx, "GET", "/media/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Download streams a media object's content to w without buffering it in
+// memory.
+//
+// If the transfer fails part way, the returned MediaDownload reports how
+// many bytes were written; resume with DownloadRange from that offset.
+func (s *MediaService) Download(ctx context.Context, id string, w io.Writer) (*MediaDownload, error) {
+	return s.DownloadRange(ctx, id, w, 0, -1)
+}
+
+// DownloadRange streams length bytes of a media object, starting at offset,
+// to w. A negative length reads to the end.
+func (s *MediaService) DownloadRange(ctx context.Context, id string, w io.Writer, offset, length int64) (*MediaDownload, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "media ID is required")
+	}
+	if offset < 0 {
+		return nil, invalidParamError("offset", "offset must not be negative")
+	}
+	if length == 0 {
+		return nil, invalidParamError("length", "length must not be zero")
+	}
+
+	header := make(http.Header)
+	if offset > 0 || length > 0 {
+		if length > 0 {
+			header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+		} else {
+			header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		}
+	}
+
+	resp, err := s.client.stream(ctx, "/media/"+url.PathEscape(id)+"/content", header)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	download := &MediaDownload{
+		ContentType: resp.Header.Get("Content-Type"),
+		Size:        resp.ContentLength,
+	}
+	body := io.Reader(resp.Body)
+	if resp.StatusCode == http.StatusPartialContent {
+		download.Size = parseContentRangeSize(resp.Header.Get("Content-Range"))
+	} else if offset > 0 || length > 0 {
+		// The server ignored the range; skip to it ourselves.
+		if _, err := io.CopyN(io.Discard, body, offset); err != nil {
+			return nil, &NetworkError{Message: "failed to read media", Err: err}
+		}
+		if length > 0 {
+			body = io.LimitReader(body, length)
+		}
+	}
+
+	download.Written, err = io.Copy(w, body)
+	if err != nil {
+		return download, &NetworkError{Message: "failed to read media", Err: err}
+	}
+	return download, nil
+}
+
+// parseContentRangeSize returns the complete length from a Content-Range
+// header such as "bytes 0-99/2048", or -1 if it is absent or unknown.
+func parseContentRangeSize(contentRange string) int64 {
+	_, total, ok := strings.Cut(contentRange, "/")
+	if !ok {
+		return -1
+	}
+	size, err := strconv.ParseInt(total, 10, 64)
+	if err != nil {
+		return -1
+	}
+	return size
+}
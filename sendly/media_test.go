@@ -0,0 +1,63 @@
+package sendly
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMediaService_Download(t *testing.T) {
+	content := []byte("0123456789abcdef")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/media/med_1/content" {
+			t.Errorf("expected path to be '/media/med_1/content', got '%s'", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-api-key" {
+			t.Errorf("expected Authorization header, got '%s'", r.Header.Get("Authorization"))
+		}
+		w.Header().Set("Content-Type", "image/png")
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(content))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	var buf bytes.Buffer
+	download, err := client.Media.Download(ctx, "med_1", &buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != string(content) || download.Written != 16 || download.ContentType != "image/png" {
+		t.Errorf("unexpected download: %+v %q", download, buf.String())
+	}
+
+	buf.Reset()
+	download, err = client.Media.DownloadRange(ctx, "med_1", &buf, 10, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "abcd" {
+		t.Errorf("expected range to be 'abcd', got '%s'", buf.String())
+	}
+	if download.Size != 16 {
+		t.Errorf("expected Size to be 16, got %d", download.Size)
+	}
+}
+
+func TestMediaService_DownloadError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":"NOT_FOUND","message":"media not found"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	var buf bytes.Buffer
+	if _, err := client.Media.Download(context.Background(), "med_missing", &buf); !IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+package sendly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// MessageClass is the SMS message class.
+type MessageClass string
+
+const (
+	// MessageClassFlash sends a class 0 ("flash") SMS, which is shown on
+	// screen immediately and is not stored in the inbox by default. Use it
+	// for urgent alerts. Not every country and carrier supports it; check
+	// with Messages.Capabilities.
+	MessageClassFlash MessageClass = "flash"
+)
+
+// errCodeMessageClassUnsupported is the API error code for a message class
+// the destination does not support.
+const errCodeMessageClassUnsupported = "MESSAGE_CLASS_UNSUPPORTED"
+
+// DestinationCapabilities reports which message features a destination
+// supports.
+type DestinationCapabilities struct {
+	// Phone is the destination normalised to E.164.
+	Phone   string `json:"phone"`
+	Country string `json:"country"`
+	Carrier string `json:"carrier,omitempty"`
+	// Flash reports whether MessageClassFlash messages are delivered as
+	// flash SMS.
+	Flash bool `json:"flash"`
+	MMS   bool `json:"mms"`
+}
+
+// Supports reports whether messages of class can be sent to the
+// destination.
+func (d *DestinationCapabilities) Supports(class MessageClass) bool {
+	switch class {
+	case "":
+		return true
+	case MessageClassFlash:
+		return d.Flash
+	default:
+		return false
+	}
+}
+
+// UnsupportedMessageClassError is returned when a message is sent with a
+// MessageClass its destination does not support. Nothing is sent or charged.
+type UnsupportedMessageClassError struct {
+	APIError
+}
+
+func (e *UnsupportedMessageClassError) Error() string {
+	return fmt.Sprintf("sendly: message class not supported: %s", e.Message)
+}
+
+func (e *UnsupportedMessageClassError) Unwrap() error {
+	return e.APIError.toError(0)
+}
+
+// IsUnsupportedMessageClassError checks if the error is an
+// UnsupportedMessageClassError.
+func IsUnsupportedMessageClassError(err error) bool {
+	var target *UnsupportedMessageClassError
+	return errors.As(err, &target)
+}
+
+func validateMessageClass(param string, class MessageClass) error {
+	switch class {
+	case "", MessageClassFlash:
+		return nil
+	default:
+		return invalidParamError(param, "messageClass must be flash")
+	}
+}
+
+// Capabilities reports which message features, such as flash SMS, a
+// destination supports.
+//
+// Example:
+//
+//	caps, err := client.Messages.Capabilities(ctx, "+15551234567")
+//	class := sendly.MessageClass("")
+//	if err == nil && caps.Supports(sendly.MessageClassFlash) {
+//	    class = sendly.MessageClassFlash
+//	}
+func (s *MessagesService) Capabilities(ctx context.Context, phone string) (*DestinationCapabilities, error) {
+	if phone == "" {
+		return nil, invalidParamError("phone", "phone number is required")
+	}
+
+	var resp DestinationCapabilities
+	if err := s.client.request(ctx, "GET", "/messages/capabilities/"+url.PathEscape(phone), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
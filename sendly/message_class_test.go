@@ -0,0 +1,78 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMessagesSend_FlashUnsupported(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["messageClass"] != "flash" {
+			t.Errorf("expected messageClass to be 'flash', got %v", body["messageClass"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"code":"MESSAGE_CLASS_UNSUPPORTED","message":"flash SMS is not supported in JP","param":"messageClass"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	_, err := client.Messages.Send(context.Background(), &SendMessageRequest{
+		To:           "+819012345678",
+		Text:         "Evacuate now",
+		MessageClass: MessageClassFlash,
+	})
+	if !IsUnsupportedMessageClassError(err) {
+		t.Fatalf("expected UnsupportedMessageClassError, got %T: %v", err, err)
+	}
+	if !HasErrorCode(err, "MESSAGE_CLASS_UNSUPPORTED") {
+		t.Errorf("expected error code to be preserved, got %v", err)
+	}
+	if advice := AdviceForError(err); advice.Class != RetryClassPermanent {
+		t.Errorf("expected permanent advice, got %s", advice.Class)
+	}
+}
+
+func TestMessagesService_Capabilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/messages/capabilities/+15551234567" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"phone":"+15551234567","country":"US","flash":true,"mms":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	caps, err := client.Messages.Capabilities(context.Background(), "+15551234567")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !caps.Supports(MessageClassFlash) || caps.Supports("binary") {
+		t.Errorf("unexpected capabilities: %+v", caps)
+	}
+}
+
+func TestMessagesSend_MessageClassValidation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	if _, err := client.Messages.Send(ctx, &SendMessageRequest{To: "+15551234567", Text: "Hi", MessageClass: "class2"}); !IsValidationError(err) {
+		t.Errorf("expected validation error for unknown class, got %v", err)
+	}
+	_, err := client.Messages.Send(ctx, &SendMessageRequest{
+		To:           "+15551234567",
+		Text:         "Hi",
+		MessageClass: MessageClassFlash,
+		ScheduleAt:   time.Now().Add(time.Hour),
+	})
+	if !IsValidationError(err) {
+		t.Errorf("expected validation error for scheduled flash message, got %v", err)
+	}
+}
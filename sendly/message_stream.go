@@ -0,0 +1,323 @@
+package sendly
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultStreamBatchSize is the number of messages per batch request
+	// sent by a MessageStream.
+	DefaultStreamBatchSize = 100
+	// DefaultStreamFlushInterval is how long a MessageStream waits for a
+	// batch to fill before sending it anyway.
+	DefaultStreamFlushInterval = 250 * time.Millisecond
+	// DefaultStreamMaxInFlight is the number of batch requests a
+	// MessageStream sends concurrently.
+	DefaultStreamMaxInFlight = 4
+)
+
+// ErrStreamClosed is returned by MessageStream.Send after Close.
+var ErrStreamClosed = errors.New("sendly: message stream is closed")
+
+// StreamOptions configures MessagesService.OpenStream.
+type StreamOptions struct {
+	// BatchSize is the number of messages per batch request (default:
+	// DefaultStreamBatchSize).
+	BatchSize int
+	// FlushInterval sends a partial batch once its oldest message has
+	// waited this long (default: DefaultStreamFlushInterval).
+	FlushInterval time.Duration
+	// MaxInFlight is the number of batch requests sent concurrently
+	// (default: DefaultStreamMaxInFlight).
+	MaxInFlight int
+}
+
+// StreamItem is a message queued on a MessageStream.
+type StreamItem struct {
+	// Ref is an opaque caller reference, such as a row ID, echoed in the
+	// item's StreamAck.
+	Ref     string
+	Message BatchMessageItem
+}
+
+// StreamAck reports the outcome of one StreamItem.
+type StreamAck struct {
+	Ref string
+	// Message is the accepted message. It is nil if Err is set.
+	Message *Message
+	Err     error
+}
+
+// MessageStream sends an unbounded sequence of messages through the batch
+// endpoint. See MessagesService.OpenStream.
+type MessageStream struct {
+	client  *Client
+	ctx     context.Context
+	opts    StreamOptions
+	in      chan StreamItem
+	results chan StreamAck
+
+	mu     sync.RWMutex
+	closed bool
+
+	pauseMu     sync.Mutex
+	pausedUntil time.Time
+
+	// batches numbers sent batches, to derive per-batch idempotency keys.
+	batches atomic.Int64
+}
+
+// OpenStream starts a MessageStream for pipelines that produce more messages
+// than fit in one batch. Items passed to Send are grouped into batch
+// requests, sent with bounded concurrency, and acknowledged one by one on
+// Results.
+//
+// The stream applies backpressure: Send blocks while MaxInFlight batches
+// are outstanding, and the stream pauses when the API reports that the rate
+// limit is exhausted or answers 429, resending rate-limited batches once
+// the limit resets. Results must be drained for the stream to make
+// progress.
+//
+// Call Close when there is nothing more to send, even if ctx has been
+// cancelled; Results is closed once every accepted item has been
+// acknowledged. Items still queued when ctx is done are acknowledged with
+// ctx.Err().
+//
+// Example:
+//
+//	stream := client.Messages.OpenStream(ctx, nil)
+//	go func() {
+//	    defer stream.Close()
+//	    for row := range rows {
+//	        if err := stream.Send(sendly.StreamItem{Ref: row.ID, Message: row.Message()}); err != nil {
+//	            return
+//	        }
+//	    }
+//	}()
+//	for ack := range stream.Results() {
+//	    if ack.Err != nil {
+//	        log.Printf("%s: %v", ack.Ref, ack.Err)
+//	    }
+//	}
+func (s *MessagesService) OpenStream(ctx context.Context, opts *StreamOptions) *MessageStream {
+	var o StreamOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = DefaultStreamBatchSize
+	}
+	if o.FlushInterval <= 0 {
+		o.FlushInterval = DefaultStreamFlushInterval
+	}
+	if o.MaxInFlight <= 0 {
+		o.MaxInFlight = DefaultStreamMaxInFlight
+	}
+
+	st := &MessageStream{
+		client:  s.client,
+		ctx:     ctx,
+		opts:    o,
+		in:      make(chan StreamItem, o.BatchSize),
+		results: make(chan StreamAck, o.BatchSize*o.MaxInFlight),
+	}
+
+	batches := make(chan []StreamItem)
+	var wg sync.WaitGroup
+	for i := 0; i < o.MaxInFlight; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for items := range batches {
+				st.sendBatch(items)
+			}
+		}()
+	}
+	go func() {
+		st.collect(batches)
+		close(batches)
+		wg.Wait()
+		close(st.results)
+	}()
+	return st
+}
+
+// Send queues item, blocking while the stream is saturated. Items that are
+// obviously invalid are rejected with a *ValidationError and never
+// acknowledged on Results.
+func (st *MessageStream) Send(item StreamItem) error {
+	if item.Message.To == "" {
+		return invalidParamError("to", "to is required")
+	}
+	if item.Message.Text == "" && item.Message.TemplateID == "" {
+		return invalidParamError("text", "text is required")
+	}
+
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	if st.closed {
+		return ErrStreamClosed
+	}
+	select {
+	case st.in <- item:
+		return nil
+	case <-st.ctx.Done():
+		return st.ctx.Err()
+	}
+}
+
+// Results returns the channel of per-item acknowledgements. It is closed
+// after Close once every accepted item has been acknowledged.
+func (st *MessageStream) Results() <-chan StreamAck {
+	return st.results
+}
+
+// Close stops accepting items and flushes the ones already queued. It does
+// not wait for them to be sent; read Results until it is closed for that.
+func (st *MessageStream) Close() error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.closed {
+		return nil
+	}
+	st.closed = true
+	close(st.in)
+	return nil
+}
+
+// collect groups queued items into batches until the input is closed.
+func (st *MessageStream) collect(out chan<- []StreamItem) {
+	ticker := time.NewTicker(st.opts.FlushInterval)
+	defer ticker.Stop()
+
+	var pending []StreamItem
+	flush := func() {
+		if len(pending) == 0 {
+			return
+		}
+		select {
+		case out <- pending:
+		case <-st.ctx.Done():
+			st.fail(pending, st.ctx.Err())
+		}
+		pending = nil
+	}
+
+	for {
+		select {
+		case item, ok := <-st.in:
+			if !ok {
+				flush()
+				return
+			}
+			if err := st.ctx.Err(); err != nil {
+				st.fail([]StreamItem{item}, err)
+				continue
+			}
+			pending = append(pending, item)
+			if len(pending) >= st.opts.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// sendBatch sends items as one batch, waiting out and retrying rate limits,
+// and acknowledges every item.
+func (st *MessageStream) sendBatch(items []StreamItem) {
+	req := &SendBatchRequest{Messages: make([]BatchMessageItem, len(items))}
+	for i, item := range items {
+		req.Messages[i] = item.Message
+	}
+	ctx := itemContext(st.ctx, int(st.batches.Add(1)-1))
+
+	for {
+		if err := st.waitForCapacity(); err != nil {
+			st.fail(items, err)
+			return
+		}
+		resp, err := st.client.Messages.SendBatch(ctx, req)
+		var rateLimited *RateLimitError
+		if errors.As(err, &rateLimited) {
+			st.pause(time.Duration(rateLimited.RetryAfter) * time.Second)
+			continue
+		}
+		if err != nil {
+			st.fail(items, err)
+			return
+		}
+		st.ack(items, resp)
+		return
+	}
+}
+
+// pause holds every batch for d (at least one second).
+func (st *MessageStream) pause(d time.Duration) {
+	d = max(d, time.Second)
+	st.pauseMu.Lock()
+	if until := time.Now().Add(d); until.After(st.pausedUntil) {
+		st.pausedUntil = until
+	}
+	st.pauseMu.Unlock()
+}
+
+// waitForCapacity blocks while the stream is paused or the last response
+// reported an exhausted rate limit.
+func (st *MessageStream) waitForCapacity() error {
+	st.pauseMu.Lock()
+	wait := time.Until(st.pausedUntil)
+	st.pauseMu.Unlock()
+	if rl := st.client.LastRateLimit(); rl != nil && rl.Remaining == 0 {
+		wait = max(wait, rl.ResetIn())
+	}
+	if wait <= 0 {
+		return st.ctx.Err()
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-st.ctx.Done():
+		return st.ctx.Err()
+	}
+}
+
+// ack reports the per-item results of a batch response.
+func (st *MessageStream) ack(items []StreamItem, resp *BatchMessageResponse) {
+	for i, item := range items {
+		ack := StreamAck{Ref: item.Ref}
+		switch {
+		case i >= len(resp.Messages):
+			ack.Err = &SendlyError{APIError: APIError{Code: "MISSING_RESULT", Message: "no result returned for message"}}
+		case resp.Messages[i].Error != nil || resp.Messages[i].MessageID == nil:
+			msg := "message rejected"
+			if resp.Messages[i].Error != nil {
+				msg = *resp.Messages[i].Error
+			}
+			ack.Err = &SendlyError{APIError: APIError{Code: "BATCH_ITEM_FAILED", Message: msg}}
+		default:
+			ack.Message = &Message{
+				ID:     *resp.Messages[i].MessageID,
+				To:     resp.Messages[i].To,
+				Text:   item.Message.Text,
+				Status: resp.Messages[i].Status,
+			}
+		}
+		st.results <- ack
+	}
+}
+
+// fail acknowledges every item with err.
+func (st *MessageStream) fail(items []StreamItem, err error) {
+	for _, item := range items {
+		st.results <- StreamAck{Ref: item.Ref, Err: err}
+	}
+}
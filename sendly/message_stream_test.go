@@ -0,0 +1,122 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMessagesService_OpenStream(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/messages/batch" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"error":"rate_limit_exceeded","message":"slow down"}`))
+			return
+		}
+
+		var req SendBatchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if len(req.Messages) > 2 {
+			t.Errorf("expected at most 2 messages per batch, got %d", len(req.Messages))
+		}
+		resp := BatchMessageResponse{BatchID: "batch_1"}
+		for i, m := range req.Messages {
+			if m.Text == "bad" {
+				errMsg := "invalid number"
+				resp.Messages = append(resp.Messages, BatchMessageResult{To: string(m.To), Status: MessageStatusFailed, Error: &errMsg})
+				continue
+			}
+			id := "msg_" + strconv.Itoa(i)
+			resp.Messages = append(resp.Messages, BatchMessageResult{To: string(m.To), MessageID: &id, Status: MessageStatusQueued})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithMaxRetries(0))
+	stream := client.Messages.OpenStream(context.Background(), &StreamOptions{BatchSize: 2, MaxInFlight: 1})
+
+	texts := []string{"a", "b", "bad", "c", "d"}
+	go func() {
+		defer stream.Close()
+		for i, text := range texts {
+			if err := stream.Send(StreamItem{Ref: strconv.Itoa(i), Message: BatchMessageItem{To: "+15551234567", Text: text}}); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}
+	}()
+
+	acks := make(map[string]StreamAck)
+	for ack := range stream.Results() {
+		acks[ack.Ref] = ack
+	}
+	if len(acks) != len(texts) {
+		t.Fatalf("expected %d acks, got %d", len(texts), len(acks))
+	}
+	for i, text := range texts {
+		ack := acks[strconv.Itoa(i)]
+		if text == "bad" {
+			if ack.Err == nil {
+				t.Errorf("expected error for item %d", i)
+			}
+			continue
+		}
+		if ack.Err != nil || ack.Message == nil || ack.Message.Text != text {
+			t.Errorf("unexpected ack for item %d: %+v", i, ack)
+		}
+	}
+	if calls.Load() < 4 {
+		t.Errorf("expected the rate-limited batch to be resent, got %d calls", calls.Load())
+	}
+
+	if err := stream.Send(StreamItem{Message: BatchMessageItem{To: "+15551234567", Text: "late"}}); err != ErrStreamClosed {
+		t.Errorf("expected ErrStreamClosed, got %v", err)
+	}
+	if err := client.Messages.OpenStream(context.Background(), nil).Send(StreamItem{}); !IsValidationError(err) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
+
+func TestMessagesService_OpenStreamIdempotencyKeys(t *testing.T) {
+	var mu sync.Mutex
+	keys := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys[r.Header.Get("Idempotency-Key")] = true
+		mu.Unlock()
+		var req SendBatchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		resp := BatchMessageResponse{BatchID: "batch_1"}
+		for range req.Messages {
+			id := "msg_1"
+			resp.Messages = append(resp.Messages, BatchMessageResult{MessageID: &id, Status: MessageStatusQueued})
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := WithIdempotencyKey(context.Background(), "import-3")
+	stream := client.Messages.OpenStream(ctx, &StreamOptions{BatchSize: 1})
+	go func() {
+		defer stream.Close()
+		stream.Send(StreamItem{Message: BatchMessageItem{To: "+15551234567", Text: "a"}})
+		stream.Send(StreamItem{Message: BatchMessageItem{To: "+15551234567", Text: "b"}})
+	}()
+	for range stream.Results() {
+	}
+	if len(keys) != 2 || !keys["import-3-0"] || !keys["import-3-1"] {
+		t.Errorf("expected a key per batch, got %v", keys)
+	}
+}
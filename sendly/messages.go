@@ -2,15 +2,60 @@ package sendly
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 )
 
+// MaxDuplicateWindow is the longest supported duplicate suppression window.
+const MaxDuplicateWindow = 24 * time.Hour
+
+// MaxValidityPeriod is the longest supported message validity period.
+const MaxValidityPeriod = 72 * time.Hour
+
+func validateValidityPeriod(d time.Duration) error {
+	if d < 0 || d > MaxValidityPeriod {
+		return invalidParamError("validityPeriodSecs", "validityPeriod must be between 0 and 72h")
+	}
+	return nil
+}
+
 // MessagesService handles message-related API operations.
 type MessagesService struct {
 	client *Client
 }
 
+// validateCallbackURL checks that a per-message status callback, if set, is
+// an absolute HTTPS URL.
+func validateCallbackURL(param, rawURL string) error {
+	if rawURL == "" {
+		return nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return invalidParamError(param, "status callback URL must be an absolute HTTPS URL")
+	}
+	return nil
+}
+
+// validateSenderStrategy checks that an automatic sender strategy is not
+// combined with an explicit sender.
+func validateSenderStrategy(strategy SenderStrategy, from string) error {
+	switch strategy {
+	case "":
+		return nil
+	case SenderStrategyAuto:
+		if from != "" {
+			return invalidParamError("from", "from cannot be set with senderStrategy auto")
+		}
+		return nil
+	default:
+		return invalidParamError("senderStrategy", "senderStrategy must be auto")
+	}
+}
+
 // Send sends an SMS message.
 func (s *MessagesService) Send(ctx context.Context, req *SendMessageRequest) (*Message, error) {
 	if req == nil {
@@ -19,12 +64,53 @@ func (s *MessagesService) Send(ctx context.Context, req *SendMessageRequest) (*M
 	if req.To == "" {
 		return nil, &ValidationError{APIError: APIError{Message: "to is required"}}
 	}
-	if req.Text == "" {
+	if req.Text == "" && req.TemplateID == "" {
 		return nil, &ValidationError{APIError: APIError{Message: "text is required"}}
 	}
+	if req.Text != "" && req.TemplateID != "" {
+		return nil, &ValidationError{APIError: APIError{Message: "text and templateId are mutually exclusive"}}
+	}
+	if req.Locale != "" && req.TemplateID == "" {
+		return nil, &ValidationError{APIError: APIError{Message: "locale requires templateId"}}
+	}
+	to, err := s.client.normalizePhone("to", req.To)
+	if err != nil {
+		return nil, err
+	}
+	if to != req.To {
+		normalized := *req
+		normalized.To = to
+		req = &normalized
+	}
+	if req.DuplicateWindow < 0 || req.DuplicateWindow > MaxDuplicateWindow {
+		return nil, &ValidationError{APIError: APIError{Message: "duplicateWindow must be between 0 and 24h"}}
+	}
+	if err := validateValidityPeriod(req.ValidityPeriod); err != nil {
+		return nil, err
+	}
+	if err := validateMessageClass("messageClass", req.MessageClass); err != nil {
+		return nil, err
+	}
+	if err := validateCallbackURL("statusCallbackUrl", req.StatusCallbackURL); err != nil {
+		return nil, err
+	}
+	if err := validateSenderStrategy(req.SenderStrategy, req.From); err != nil {
+		return nil, err
+	}
+	if req.FailIfSuppressed {
+		if err := s.client.checkSuppressed(ctx, string(req.To)); err != nil {
+			return nil, err
+		}
+	}
+	if !req.ScheduleAt.IsZero() {
+		return s.sendScheduled(ctx, req)
+	}
+	if err := s.client.validateTemplateSend(ctx, req.TemplateID, req.Variables); err != nil {
+		return nil, err
+	}
 
 	var resp Message
-	err := s.client.request(ctx, "POST", "/messages", req, &resp)
+	err = s.client.request(ctx, "POST", "/messages", req, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -32,6 +118,57 @@ func (s *MessagesService) Send(ctx context.Context, req *SendMessageRequest) (*M
 	return &resp, nil
 }
 
+// sendScheduled sends req through the schedule endpoint and returns the
+// scheduled message as a Message.
+func (s *MessagesService) sendScheduled(ctx context.Context, req *SendMessageRequest) (*Message, error) {
+	if !req.ScheduleAt.After(time.Now()) {
+		return nil, invalidParamError("scheduleAt", "scheduleAt must be in the future")
+	}
+	if req.DuplicateWindow != 0 {
+		return nil, invalidParamError("duplicateWindowSecs", "duplicateWindow is not supported for scheduled messages")
+	}
+	if req.MessageClass != "" {
+		return nil, invalidParamError("messageClass", "messageClass is not supported for scheduled messages")
+	}
+
+	scheduled, err := s.Schedule(ctx, &ScheduleMessageRequest{
+		To:                req.To,
+		Text:              req.Text,
+		TemplateID:        req.TemplateID,
+		Variables:         req.Variables,
+		Locale:            req.Locale,
+		Metadata:          req.Metadata,
+		ScheduledAt:       req.ScheduleAt.UTC().Format(time.RFC3339),
+		From:              req.From,
+		SenderStrategy:    req.SenderStrategy,
+		MessageType:       req.MessageType,
+		Links:             req.Links,
+		StatusCallbackURL: req.StatusCallbackURL,
+		ValidityPeriod:    req.ValidityPeriod,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &Message{
+		ID:          scheduled.ID,
+		To:          scheduled.To,
+		From:        scheduled.From,
+		Text:        scheduled.Text,
+		Status:      MessageStatusScheduled,
+		CreatedAt:   scheduled.CreatedAt,
+		Metadata:    req.Metadata,
+		ScheduledAt: &scheduled.ScheduledAt,
+	}
+	if req.TemplateID != "" {
+		msg.TemplateID = &req.TemplateID
+	}
+	if req.StatusCallbackURL != "" {
+		msg.StatusCallbackURL = &req.StatusCallbackURL
+	}
+	return msg, nil
+}
+
 // List retrieves a list of messages.
 func (s *MessagesService) List(ctx context.Context, req *ListMessagesRequest) (*ListMessagesResponse, error) {
 	params := make(map[string]string)
@@ -62,6 +199,27 @@ func (s *MessagesService) List(ctx context.Context, req *ListMessagesRequest) (*
 	return &resp, nil
 }
 
+// ListAll returns every message matching req as an Iter, fetching pages as
+// needed. req.Offset sets the starting position.
+func (s *MessagesService) ListAll(ctx context.Context, req *ListMessagesRequest) Iter[Message] {
+	var r ListMessagesRequest
+	if req != nil {
+		r = *req
+	}
+	start := r.Offset
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[Message], error) {
+		r.Offset = start
+		if cursor != "" {
+			r.Offset, _ = strconv.Atoi(cursor)
+		}
+		resp, err := s.List(ctx, &r)
+		if err != nil {
+			return nil, err
+		}
+		return offsetPage(resp.Data, r.Offset, resp.Count), nil
+	}).Iter()
+}
+
 // Get retrieves a single message by ID.
 func (s *MessagesService) Get(ctx context.Context, id string) (*Message, error) {
 	if id == "" {
@@ -80,6 +238,46 @@ func (s *MessagesService) Get(ctx context.Context, id string) (*Message, error)
 	return &resp, nil
 }
 
+// Cancel cancels a queued message that has not yet been sent to the carrier.
+func (s *MessagesService) Cancel(ctx context.Context, id string) (*Message, error) {
+	if id == "" {
+		return nil, &ValidationError{APIError: APIError{Message: "message ID is required"}}
+	}
+
+	path := "/messages/" + url.PathEscape(id) + "/cancel"
+
+	var resp Message
+	err := s.client.request(ctx, "POST", path, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// SimulateStatus moves a sandbox message to the given status and triggers the
+// corresponding webhook event. It is only available with test API keys.
+func (s *MessagesService) SimulateStatus(ctx context.Context, messageID string, status MessageStatus) (*Message, error) {
+	if messageID == "" {
+		return nil, &ValidationError{APIError: APIError{Message: "message ID is required"}}
+	}
+	switch status {
+	case MessageStatusDelivered, MessageStatusFailed, MessageStatusExpired:
+	default:
+		return nil, &ValidationError{APIError: APIError{Message: "status must be delivered, failed, or expired"}}
+	}
+
+	path := "/messages/" + url.PathEscape(messageID) + "/simulate"
+
+	var resp Message
+	err := s.client.request(ctx, "POST", path, &SimulateStatusRequest{Status: status}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
 // Schedule schedules an SMS message for future delivery.
 func (s *MessagesService) Schedule(ctx context.Context, req *ScheduleMessageRequest) (*ScheduledMessage, error) {
 	if req == nil {
@@ -88,12 +286,39 @@ func (s *MessagesService) Schedule(ctx context.Context, req *ScheduleMessageRequ
 	if req.To == "" {
 		return nil, &ValidationError{APIError: APIError{Message: "to is required"}}
 	}
-	if req.Text == "" {
+	if req.Text == "" && req.TemplateID == "" {
 		return nil, &ValidationError{APIError: APIError{Message: "text is required"}}
 	}
-	if req.ScheduledAt == "" {
+	if req.Text != "" && req.TemplateID != "" {
+		return nil, &ValidationError{APIError: APIError{Message: "text and templateId are mutually exclusive"}}
+	}
+	if req.Locale != "" && req.TemplateID == "" {
+		return nil, &ValidationError{APIError: APIError{Message: "locale requires templateId"}}
+	}
+	if req.ScheduledAt == "" && req.SendAtLocalTime == "" {
 		return nil, &ValidationError{APIError: APIError{Message: "scheduledAt is required"}}
 	}
+	if req.ScheduledAt != "" && req.SendAtLocalTime != "" {
+		return nil, &ValidationError{APIError: APIError{Message: "scheduledAt and sendAtLocalTime are mutually exclusive"}}
+	}
+	if req.SendAtLocalTime != "" {
+		if _, err := time.Parse(LocalTimeLayout, req.SendAtLocalTime); err != nil {
+			return nil, &ValidationError{APIError: APIError{Message: "sendAtLocalTime must be a local time without offset"}, Err: err}
+		}
+	}
+	if err := validateCallbackURL("statusCallbackUrl", req.StatusCallbackURL); err != nil {
+		return nil, err
+	}
+	if err := validateSenderStrategy(req.SenderStrategy, req.From); err != nil {
+		return nil, err
+	}
+	if err := validateValidityPeriod(req.ValidityPeriod); err != nil {
+		return nil, err
+	}
+
+	if err := s.client.validateTemplateSend(ctx, req.TemplateID, req.Variables); err != nil {
+		return nil, err
+	}
 
 	var resp ScheduledMessage
 	err := s.client.request(ctx, "POST", "/messages/schedule", req, &resp)
@@ -165,6 +390,27 @@ func (s *MessagesService) CancelScheduled(ctx context.Context, id string) (*Canc
 	return &resp, nil
 }
 
+// Reschedule moves a scheduled message to a new send time.
+func (s *MessagesService) Reschedule(ctx context.Context, id string, at time.Time) (*ScheduledMessage, error) {
+	if id == "" {
+		return nil, &ValidationError{APIError: APIError{Message: "scheduled message ID is required"}}
+	}
+	if !at.After(time.Now()) {
+		return nil, invalidParamError("scheduledAt", "scheduledAt must be in the future")
+	}
+
+	path := "/messages/scheduled/" + url.PathEscape(id)
+	body := map[string]string{"scheduledAt": at.UTC().Format(time.RFC3339)}
+
+	var resp ScheduledMessage
+	err := s.client.request(ctx, "PATCH", path, body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
 // SendBatch sends multiple SMS messages in a batch.
 func (s *MessagesService) SendBatch(ctx context.Context, req *SendBatchRequest) (*BatchMessageResponse, error) {
 	if req == nil {
@@ -173,19 +419,50 @@ func (s *MessagesService) SendBatch(ctx context.Context, req *SendBatchRequest)
 	if len(req.Messages) == 0 {
 		return nil, &ValidationError{APIError: APIError{Message: "messages are required"}}
 	}
+	if err := validateCallbackURL("statusCallbackUrl", req.StatusCallbackURL); err != nil {
+		return nil, err
+	}
+	if err := validateSenderStrategy(req.SenderStrategy, req.From); err != nil {
+		return nil, err
+	}
+	if err := validateValidityPeriod(req.ValidityPeriod); err != nil {
+		return nil, err
+	}
+	if err := validateMessageClass("messageClass", req.MessageClass); err != nil {
+		return nil, err
+	}
 
 	// Validate each message
 	for i, msg := range req.Messages {
 		if msg.To == "" {
 			return nil, &ValidationError{APIError: APIError{Message: "to is required for message at index " + strconv.Itoa(i)}}
 		}
-		if msg.Text == "" {
+		if msg.Text == "" && msg.TemplateID == "" {
 			return nil, &ValidationError{APIError: APIError{Message: "text is required for message at index " + strconv.Itoa(i)}}
 		}
+		if msg.Locale != "" && msg.TemplateID == "" {
+			return nil, &ValidationError{APIError: APIError{Message: "locale requires templateId for message at index " + strconv.Itoa(i)}}
+		}
+		if err := validateCallbackURL("messages["+strconv.Itoa(i)+"].statusCallbackUrl", msg.StatusCallbackURL); err != nil {
+			return nil, err
+		}
+		if req.SenderStrategy == SenderStrategyAuto && msg.From != "" {
+			return nil, invalidParamError("messages["+strconv.Itoa(i)+"].from", "from cannot be set with senderStrategy auto")
+		}
+		if err := s.client.validateTemplateSend(ctx, msg.TemplateID, msg.Variables); err != nil {
+			if ve, ok := err.(*ValidationError); ok {
+				ve.Message += " for message at index " + strconv.Itoa(i)
+			}
+			return nil, err
+		}
+	}
+	req, err := s.client.normalizeBatchPhones(req)
+	if err != nil {
+		return nil, err
 	}
 
 	var resp BatchMessageResponse
-	err := s.client.request(ctx, "POST", "/messages/batch", req, &resp)
+	err = s.client.request(ctx, "POST", "/messages/batch", req, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -193,6 +470,147 @@ func (s *MessagesService) SendBatch(ctx context.Context, req *SendBatchRequest)
 	return &resp, nil
 }
 
+// SendMany sends a list of messages using the bulk batch endpoint and
+// returns per-message results. If the batch endpoint is unavailable, or a
+// request uses an option the batch endpoint cannot express, it sends the
+// messages individually with Send, concurrently within the client's rate
+// limit.
+//
+// A non-nil error means no message was attempted; individual failures are
+// reported in the result.
+func (s *MessagesService) SendMany(ctx context.Context, reqs []SendMessageRequest) (*SendManyResult, error) {
+	if len(reqs) == 0 {
+		return nil, &ValidationError{APIError: APIError{Message: "messages are required"}}
+	}
+
+	batch, ok := sendManyBatch(reqs)
+	if !ok {
+		return s.sendManyIndividually(ctx, reqs), nil
+	}
+
+	resp, err := s.SendBatch(ctx, batch)
+	if err != nil {
+		if !isEndpointUnavailable(err) {
+			return nil, err
+		}
+		return s.sendManyIndividually(ctx, reqs), nil
+	}
+
+	result := &SendManyResult{
+		BatchID: resp.BatchID,
+		Results: make([]ParallelResult[*Message], len(reqs)),
+	}
+	for i := range reqs {
+		result.Results[i].Index = i
+		if i >= len(resp.Messages) {
+			result.Results[i].Err = &SendlyError{APIError: APIError{Code: "MISSING_RESULT", Message: "no result returned for message"}}
+			result.Failed++
+			continue
+		}
+		item := resp.Messages[i]
+		if item.Error != nil || item.MessageID == nil {
+			msg := "message rejected"
+			if item.Error != nil {
+				msg = *item.Error
+			}
+			result.Results[i].Err = &SendlyError{APIError: APIError{Code: "BATCH_ITEM_FAILED", Message: msg}}
+			result.Failed++
+			continue
+		}
+		result.Results[i].Value = &Message{
+			ID:     *item.MessageID,
+			To:     item.To,
+			Text:   reqs[i].Text,
+			Status: MessageStatus(item.Status),
+		}
+		result.Sent++
+	}
+	return result, nil
+}
+
+// sendManyBatch converts reqs into a batch request. It reports false if a
+// request uses an option the batch endpoint cannot express, so that no
+// option is silently dropped.
+func sendManyBatch(reqs []SendMessageRequest) (*SendBatchRequest, bool) {
+	first := reqs[0]
+	batch := &SendBatchRequest{
+		Messages:        make([]BatchMessageItem, len(reqs)),
+		DuplicateWindow: first.DuplicateWindow,
+		SenderStrategy:  first.SenderStrategy,
+		ValidityPeriod:  first.ValidityPeriod,
+		MessageClass:    first.MessageClass,
+	}
+	for i, req := range reqs {
+		// The batch endpoint cannot schedule messages, and suppression
+		// checks run in Send.
+		if !req.ScheduleAt.IsZero() || req.FailIfSuppressed {
+			return nil, false
+		}
+		// Options that apply to the whole batch must be the same for
+		// every message.
+		if req.DuplicateWindow != first.DuplicateWindow || req.SenderStrategy != first.SenderStrategy ||
+			req.ValidityPeriod != first.ValidityPeriod || req.MessageClass != first.MessageClass {
+			return nil, false
+		}
+		batch.Messages[i] = BatchMessageItem{
+			To:                req.To,
+			Text:              req.Text,
+			From:              req.From,
+			MessageType:       req.MessageType,
+			TemplateID:        req.TemplateID,
+			Variables:         req.Variables,
+			Locale:            req.Locale,
+			Metadata:          req.Metadata,
+			Links:             req.Links,
+			StatusCallbackURL: req.StatusCallbackURL,
+		}
+	}
+	return batch, true
+}
+
+// sendManyIndividually sends each request concurrently with Send.
+func (s *MessagesService) sendManyIndividually(ctx context.Context, reqs []SendMessageRequest) *SendManyResult {
+	ptrs := make([]*SendMessageRequest, len(reqs))
+	for i := range reqs {
+		ptrs[i] = &reqs[i]
+	}
+
+	result := &SendManyResult{Results: s.SendAll(ctx, ptrs)}
+	for _, r := range result.Results {
+		if r.Err != nil {
+			result.Failed++
+		} else {
+			result.Sent++
+		}
+	}
+	return result
+}
+
+// errCodeRouteNotFound is the code the API returns for a 404 on a route it
+// does not serve, as opposed to a missing resource.
+const errCodeRouteNotFound = "ROUTE_NOT_FOUND"
+
+// isEndpointUnavailable reports whether err indicates the endpoint does not
+// exist on the server (e.g. an older or self-hosted deployment). A 404 only
+// counts if it names an unknown route, or has no API error body because it
+// did not come from an API handler; a 404 for a missing template or sender
+// is a real failure that sending individually would only repeat.
+func isEndpointUnavailable(err error) bool {
+	var notFound *NotFoundError
+	if errors.As(err, &notFound) {
+		switch notFound.Code {
+		case errCodeRouteNotFound, "UNKNOWN_ERROR", "":
+			return true
+		}
+		return false
+	}
+	var sendlyErr *SendlyError
+	if errors.As(err, &sendlyErr) {
+		return sendlyErr.StatusCode == http.StatusNotImplemented || sendlyErr.StatusCode == http.StatusMethodNotAllowed
+	}
+	return false
+}
+
 // GetBatch retrieves the status of a batch by ID.
 func (s *MessagesService) GetBatch(ctx context.Context, batchID string) (*BatchMessageResponse, error) {
 	if batchID == "" {
@@ -251,13 +669,17 @@ func (s *MessagesService) PreviewBatch(ctx context.Context, req *SendBatchReques
 		if msg.To == "" {
 			return nil, &ValidationError{APIError: APIError{Message: "to is required for message at index " + strconv.Itoa(i)}}
 		}
-		if msg.Text == "" {
+		if msg.Text == "" && msg.TemplateID == "" {
 			return nil, &ValidationError{APIError: APIError{Message: "text is required for message at index " + strconv.Itoa(i)}}
 		}
 	}
+	req, err := s.client.normalizeBatchPhones(req)
+	if err != nil {
+		return nil, err
+	}
 
 	var resp BatchPreviewResponse
-	err := s.client.request(ctx, "POST", "/messages/batch/preview", req, &resp)
+	err = s.client.request(ctx, "POST", "/messages/batch/preview", req, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -3,10 +3,14 @@ package sendly
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestMessagesSendBatch_Success(t *testing.T) {
@@ -628,3 +632,362 @@ func TestMessagesListBatches_ServerError(t *testing.T) {
 		t.Errorf("expected status code 500, got %d", sendlyErr.StatusCode)
 	}
 }
+
+func TestMessagesSendMany_Bulk(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/messages/batch" {
+			t.Errorf("expected path '/messages/batch', got '%s'", r.URL.Path)
+		}
+
+		var req SendBatchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Messages[1].From != "Acme" {
+			t.Errorf("expected per-item From to be 'Acme', got '%s'", req.Messages[1].From)
+		}
+
+		id := "msg_1"
+		errMsg := "invalid number"
+		json.NewEncoder(w).Encode(BatchMessageResponse{
+			BatchID: "batch_123",
+			Messages: []BatchMessageResult{
+				{To: string(req.Messages[0].To), MessageID: &id, Status: "queued"},
+				{To: string(req.Messages[1].To), Status: "failed", Error: &errMsg},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	result, err := client.Messages.SendMany(context.Background(), []SendMessageRequest{
+		{To: "+1234567890", Text: "Message 1"},
+		{To: "+1000", Text: "Message 2", From: "Acme"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.BatchID != "batch_123" {
+		t.Errorf("expected BatchID to be 'batch_123', got '%s'", result.BatchID)
+	}
+	if !result.PartialFailure() || result.Sent != 1 || result.Failed != 1 {
+		t.Errorf("expected partial failure with 1 sent and 1 failed, got %+v", result)
+	}
+	if result.Results[0].Value.ID != "msg_1" {
+		t.Errorf("expected first message ID to be 'msg_1', got %+v", result.Results[0])
+	}
+	if result.Results[1].Err == nil || !strings.Contains(result.Results[1].Err.Error(), "invalid number") {
+		t.Errorf("expected second message to fail with 'invalid number', got %v", result.Results[1].Err)
+	}
+}
+
+func TestMessagesSendMany_FallbackToIndividualSends(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/messages/batch" {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(APIError{Code: "ROUTE_NOT_FOUND", Message: "Not found"})
+			return
+		}
+
+		var req SendMessageRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		json.NewEncoder(w).Encode(Message{ID: "msg_" + string(req.To), To: string(req.To), Status: MessageStatusQueued})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	result, err := client.Messages.SendMany(context.Background(), []SendMessageRequest{
+		{To: "+1", Text: "a"},
+		{To: "+2", Text: "b"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.BatchID != "" || result.Sent != 2 || result.Failed != 0 {
+		t.Errorf("expected 2 individual sends, got %+v", result)
+	}
+	if result.Results[1].Value.ID != "msg_+2" {
+		t.Errorf("expected second message ID to be 'msg_+2', got %+v", result.Results[1])
+	}
+}
+
+func TestMessagesSendMany_FallbackOnlyWhenEndpointMissing(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		status   int
+		body     string
+		fallback bool
+	}{
+		{"unknown route", http.StatusNotFound, `{"code":"ROUTE_NOT_FOUND","message":"Not found"}`, true},
+		{"plain 404", http.StatusNotFound, `404 page not found`, true},
+		{"not implemented", http.StatusNotImplemented, `{"code":"NOT_IMPLEMENTED","message":"Not implemented"}`, true},
+		{"method not allowed", http.StatusMethodNotAllowed, ``, true},
+		{"missing template", http.StatusNotFound, `{"code":"TEMPLATE_NOT_FOUND","message":"Template not found"}`, false},
+		{"server error", http.StatusInternalServerError, `{"code":"INTERNAL","message":"boom"}`, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			sends := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/messages/batch" {
+					w.WriteHeader(tc.status)
+					w.Write([]byte(tc.body))
+					return
+				}
+				mu.Lock()
+				sends++
+				mu.Unlock()
+				json.NewEncoder(w).Encode(Message{ID: "msg_1", Status: MessageStatusQueued})
+			}))
+			defer server.Close()
+
+			client := NewClient("test-api-key", WithBaseURL(server.URL), WithMaxRetries(0))
+			_, err := client.Messages.SendMany(context.Background(), []SendMessageRequest{
+				{To: "+1", Text: "a"},
+				{To: "+2", Text: "b"},
+			})
+			if tc.fallback {
+				if err != nil || sends != 2 {
+					t.Errorf("expected 2 individual sends, got %d (err %v)", sends, err)
+				}
+				return
+			}
+			if err == nil || sends != 0 {
+				t.Errorf("expected the batch error without individual sends, got %d sends (err %v)", sends, err)
+			}
+		})
+	}
+}
+
+// recordSendMany runs SendMany against a test server and returns the batch
+// request body, or the individual send bodies if SendMany did not batch.
+func recordSendMany(t *testing.T, reqs []SendMessageRequest) (batch map[string]interface{}, sends []map[string]interface{}) {
+	t.Helper()
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/messages/batch":
+			batch = body
+			results := make([]BatchMessageResult, len(reqs))
+			for i := range results {
+				id := "msg_" + strconv.Itoa(i)
+				results[i] = BatchMessageResult{MessageID: &id, Status: "queued"}
+			}
+			json.NewEncoder(w).Encode(BatchMessageResponse{BatchID: "batch_1", Messages: results})
+		case "/messages", "/messages/schedule":
+			sends = append(sends, body)
+			json.NewEncoder(w).Encode(Message{ID: "msg_1", Status: MessageStatusQueued})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(APIError{Code: "NOT_FOUND", Message: "Not found"})
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	if _, err := client.Messages.SendMany(context.Background(), reqs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return batch, sends
+}
+
+func TestMessagesSendMany_MapsItemFields(t *testing.T) {
+	shorten := true
+	batch, _ := recordSendMany(t, []SendMessageRequest{{
+		To:          "+1234567890",
+		TemplateID:  "tpl_1",
+		Variables:   map[string]string{"name": "Ada"},
+		Locale:      "de-DE",
+		From:        "Acme",
+		MessageType: MessageTypeTransactional,
+		Metadata:    map[string]interface{}{"order": "1"},
+		Links:       &LinkOptions{Shorten: &shorten},
+	}})
+	if batch == nil {
+		t.Fatal("expected a batch request")
+	}
+	item := batch["messages"].([]interface{})[0].(map[string]interface{})
+	for _, key := range []string{"to", "templateId", "variables", "locale", "from", "messageType", "metadata", "links"} {
+		if _, ok := item[key]; !ok {
+			t.Errorf("expected batch item to include %q, got %v", key, item)
+		}
+	}
+}
+
+func TestMessagesSendMany_DuplicateWindow(t *testing.T) {
+	batch, _ := recordSendMany(t, []SendMessageRequest{
+		{To: "+1", Text: "a", DuplicateWindow: time.Hour},
+		{To: "+2", Text: "b", DuplicateWindow: time.Hour},
+	})
+	if batch == nil || batch["duplicateWindowSecs"] != float64(3600) {
+		t.Errorf("expected batch duplicateWindowSecs 3600, got %v", batch)
+	}
+
+	batch, sends := recordSendMany(t, []SendMessageRequest{
+		{To: "+1", Text: "a", DuplicateWindow: time.Hour},
+		{To: "+2", Text: "b"},
+	})
+	if batch != nil || len(sends) != 2 {
+		t.Fatalf("expected 2 individual sends for differing windows, got batch %v and %d sends", batch, len(sends))
+	}
+	for _, send := range sends {
+		if send["to"] == "+1" && send["duplicateWindowSecs"] != float64(3600) {
+			t.Errorf("expected duplicateWindowSecs 3600, got %v", send)
+		}
+	}
+}
+
+func TestMessagesSendMany_ScheduleAt(t *testing.T) {
+	at := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	batch, sends := recordSendMany(t, []SendMessageRequest{
+		{To: "+1", Text: "a", ScheduleAt: at},
+		{To: "+2", Text: "b"},
+	})
+	if batch != nil || len(sends) != 2 {
+		t.Fatalf("expected 2 individual sends, got batch %v and %d sends", batch, len(sends))
+	}
+	for _, send := range sends {
+		if send["to"] == "+1" && send["scheduledAt"] != at.Format(time.RFC3339) {
+			t.Errorf("expected scheduledAt %s, got %v", at.Format(time.RFC3339), send)
+		}
+	}
+}
+
+func TestMessagesSendMany_FailIfSuppressed(t *testing.T) {
+	var batched bool
+	var sent []string
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.URL.Path == "/messages/batch":
+			batched = true
+			w.WriteHeader(http.StatusInternalServerError)
+		case r.URL.Path == "/suppressions/+2":
+			json.NewEncoder(w).Encode(Suppression{Phone: "+2", Reason: "opt_out"})
+		case strings.HasPrefix(r.URL.Path, "/suppressions/"):
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(APIError{Code: "NOT_FOUND", Message: "Not found"})
+		case r.URL.Path == "/messages":
+			var req SendMessageRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			sent = append(sent, string(req.To))
+			json.NewEncoder(w).Encode(Message{ID: "msg_1", To: string(req.To), Status: MessageStatusQueued})
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	result, err := client.Messages.SendMany(context.Background(), []SendMessageRequest{
+		{To: "+1", Text: "a", FailIfSuppressed: true},
+		{To: "+2", Text: "b", FailIfSuppressed: true},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if batched {
+		t.Error("expected FailIfSuppressed requests not to be batched")
+	}
+	if len(sent) != 1 || sent[0] != "+1" {
+		t.Errorf("expected only +1 to be sent, got %v", sent)
+	}
+	var suppressed *SuppressedError
+	if !errors.As(result.Results[1].Err, &suppressed) {
+		t.Errorf("expected SuppressedError for +2, got %v", result.Results[1].Err)
+	}
+}
+
+func TestMessagesSendMany_StatusCallbackURL(t *testing.T) {
+	batch, _ := recordSendMany(t, []SendMessageRequest{
+		{To: "+1", Text: "a", StatusCallbackURL: "https://example.com/status"},
+		{To: "+2", Text: "b"},
+	})
+	if batch == nil {
+		t.Fatal("expected a batch request")
+	}
+	items := batch["messages"].([]interface{})
+	if got := items[0].(map[string]interface{})["statusCallbackUrl"]; got != "https://example.com/status" {
+		t.Errorf("expected statusCallbackUrl on the first item, got %v", got)
+	}
+	if _, ok := items[1].(map[string]interface{})["statusCallbackUrl"]; ok {
+		t.Error("expected no statusCallbackUrl on the second item")
+	}
+}
+
+func TestMessagesSendMany_SenderStrategy(t *testing.T) {
+	batch, _ := recordSendMany(t, []SendMessageRequest{
+		{To: "+1", Text: "a", SenderStrategy: SenderStrategyAuto},
+		{To: "+2", Text: "b", SenderStrategy: SenderStrategyAuto},
+	})
+	if batch == nil || batch["senderStrategy"] != string(SenderStrategyAuto) {
+		t.Errorf("expected batch senderStrategy auto, got %v", batch)
+	}
+
+	batch, sends := recordSendMany(t, []SendMessageRequest{
+		{To: "+1", Text: "a", SenderStrategy: SenderStrategyAuto},
+		{To: "+2", Text: "b", From: "Acme"},
+	})
+	if batch != nil || len(sends) != 2 {
+		t.Fatalf("expected 2 individual sends for mixed strategies, got batch %v and %d sends", batch, len(sends))
+	}
+	for _, send := range sends {
+		if send["to"] == "+1" && send["senderStrategy"] != string(SenderStrategyAuto) {
+			t.Errorf("expected senderStrategy auto, got %v", send)
+		}
+	}
+}
+
+func TestMessagesSendMany_ValidityPeriod(t *testing.T) {
+	batch, _ := recordSendMany(t, []SendMessageRequest{
+		{To: "+1", Text: "a", ValidityPeriod: 10 * time.Minute},
+		{To: "+2", Text: "b", ValidityPeriod: 10 * time.Minute},
+	})
+	if batch == nil || batch["validityPeriodSecs"] != float64(600) {
+		t.Errorf("expected batch validityPeriodSecs 600, got %v", batch)
+	}
+
+	batch, sends := recordSendMany(t, []SendMessageRequest{
+		{To: "+1", Text: "a", ValidityPeriod: 10 * time.Minute},
+		{To: "+2", Text: "b"},
+	})
+	if batch != nil || len(sends) != 2 {
+		t.Fatalf("expected 2 individual sends for differing periods, got batch %v and %d sends", batch, len(sends))
+	}
+	for _, send := range sends {
+		if send["to"] == "+1" && send["validityPeriodSecs"] != float64(600) {
+			t.Errorf("expected validityPeriodSecs 600, got %v", send)
+		}
+	}
+}
+
+func TestMessagesSendMany_MessageClass(t *testing.T) {
+	batch, _ := recordSendMany(t, []SendMessageRequest{
+		{To: "+1", Text: "a", MessageClass: MessageClassFlash},
+		{To: "+2", Text: "b", MessageClass: MessageClassFlash},
+	})
+	if batch == nil || batch["messageClass"] != string(MessageClassFlash) {
+		t.Errorf("expected batch messageClass flash, got %v", batch)
+	}
+
+	batch, sends := recordSendMany(t, []SendMessageRequest{
+		{To: "+1", Text: "a", MessageClass: MessageClassFlash},
+		{To: "+2", Text: "b"},
+	})
+	if batch != nil || len(sends) != 2 {
+		t.Fatalf("expected 2 individual sends for mixed classes, got batch %v and %d sends", batch, len(sends))
+	}
+	for _, send := range sends {
+		if send["to"] == "+1" && send["messageClass"] != string(MessageClassFlash) {
+			t.Errorf("expected messageClass flash, got %v", send)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestMessagesSchedule_Success(t *testing.T) {
@@ -35,7 +36,7 @@ func TestMessagesSchedule_Success(t *testing.T) {
 
 		resp := ScheduledMessage{
 			ID:              "sched_123",
-			To:              req.To,
+			To:              string(req.To),
 			Text:            req.Text,
 			ScheduledAt:     req.ScheduledAt,
 			Status:          ScheduledMessageStatusScheduled,
@@ -118,6 +119,25 @@ func TestMessagesSchedule_ValidationErrors(t *testing.T) {
 			},
 			expectedErr: "scheduledAt is required",
 		},
+		{
+			name: "both scheduledAt and sendAtLocalTime",
+			req: &ScheduleMessageRequest{
+				To:              "+1234567890",
+				Text:            "Test",
+				ScheduledAt:     "2024-12-31T23:59:59Z",
+				SendAtLocalTime: "2024-12-31T09:00:00",
+			},
+			expectedErr: "mutually exclusive",
+		},
+		{
+			name: "sendAtLocalTime with offset",
+			req: &ScheduleMessageRequest{
+				To:              "+1234567890",
+				Text:            "Test",
+				SendAtLocalTime: "2024-12-31T09:00:00Z",
+			},
+			expectedErr: "validation error",
+		},
 	}
 
 	for _, tt := range tests {
@@ -136,6 +156,57 @@ func TestMessagesSchedule_ValidationErrors(t *testing.T) {
 	}
 }
 
+func TestMessagesSchedule_SendAtLocalTime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ScheduleMessageRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		if req.ScheduledAt != "" {
+			t.Errorf("expected ScheduledAt to be empty, got '%s'", req.ScheduledAt)
+		}
+		if req.SendAtLocalTime != "2024-12-31T09:00:00" {
+			t.Errorf("expected SendAtLocalTime to be '2024-12-31T09:00:00', got '%s'", req.SendAtLocalTime)
+		}
+		if req.TimezoneResolution != TimezoneResolutionNumberPrefix {
+			t.Errorf("expected TimezoneResolution to be 'number_prefix', got '%s'", req.TimezoneResolution)
+		}
+
+		tz := "Europe/Berlin"
+		resp := ScheduledMessage{
+			ID:                "sched_123",
+			To:                string(req.To),
+			Text:              req.Text,
+			ScheduledAt:       "2024-12-31T08:00:00Z",
+			SendAtLocalTime:   &req.SendAtLocalTime,
+			RecipientTimezone: &tz,
+			Status:            ScheduledMessageStatusScheduled,
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	msg, err := client.Messages.Schedule(ctx, &ScheduleMessageRequest{
+		To:                 "+4915112345678",
+		Text:               "Good morning!",
+		SendAtLocalTime:    "2024-12-31T09:00:00",
+		TimezoneResolution: TimezoneResolutionNumberPrefix,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if msg.RecipientTimezone == nil || *msg.RecipientTimezone != "Europe/Berlin" {
+		t.Errorf("expected RecipientTimezone to be 'Europe/Berlin', got %v", msg.RecipientTimezone)
+	}
+}
+
 func TestMessagesSchedule_InvalidPhoneFormat(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
@@ -582,3 +653,80 @@ func TestMessagesCancelScheduled_ServerError(t *testing.T) {
 		t.Errorf("expected status code 500, got %d", sendlyErr.StatusCode)
 	}
 }
+
+func TestMessagesSend_ScheduleAt(t *testing.T) {
+	at := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/messages/schedule" {
+			t.Errorf("expected path /messages/schedule, got %s", r.URL.Path)
+		}
+
+		var req ScheduleMessageRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.ScheduledAt != at.UTC().Format(time.RFC3339) {
+			t.Errorf("expected scheduledAt to be '%s', got '%s'", at.UTC().Format(time.RFC3339), req.ScheduledAt)
+		}
+
+		json.NewEncoder(w).Encode(ScheduledMessage{
+			ID:          "sched_123",
+			To:          string(req.To),
+			Text:        req.Text,
+			ScheduledAt: req.ScheduledAt,
+			Status:      ScheduledMessageStatusScheduled,
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	msg, err := client.Messages.Send(context.Background(), &SendMessageRequest{
+		To:         "+15551234567",
+		Text:       "Your appointment is tomorrow",
+		ScheduleAt: at,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.ID != "sched_123" {
+		t.Errorf("expected ID to be 'sched_123', got '%s'", msg.ID)
+	}
+	if msg.Status != MessageStatusScheduled {
+		t.Errorf("expected status to be 'scheduled', got '%s'", msg.Status)
+	}
+}
+
+func TestMessagesSend_ScheduleAtInPast(t *testing.T) {
+	client := NewClient("test-api-key")
+	_, err := client.Messages.Send(context.Background(), &SendMessageRequest{
+		To:         "+15551234567",
+		Text:       "Too late",
+		ScheduleAt: time.Now().Add(-time.Minute),
+	})
+	if !IsValidationError(err) {
+		t.Errorf("expected ValidationError, got %v", err)
+	}
+}
+
+func TestMessagesReschedule_Success(t *testing.T) {
+	at := time.Now().Add(48 * time.Hour)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/messages/scheduled/sched_123" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		json.NewEncoder(w).Encode(ScheduledMessage{ID: "sched_123", ScheduledAt: body["scheduledAt"], Status: ScheduledMessageStatusScheduled})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	msg, err := client.Messages.Reschedule(context.Background(), "sched_123", at)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.ScheduledAt != at.UTC().Format(time.RFC3339) {
+		t.Errorf("expected ScheduledAt to be '%s', got '%s'", at.UTC().Format(time.RFC3339), msg.ScheduledAt)
+	}
+}
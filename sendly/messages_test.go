@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestMessagesSend_Success(t *testing.T) {
@@ -32,7 +33,7 @@ func TestMessagesSend_Success(t *testing.T) {
 
 		resp := Message{
 			ID:          "msg_123",
-			To:          req.To,
+			To:          string(req.To),
 			Text:        req.Text,
 			Status:      MessageStatusQueued,
 			Segments:    1,
@@ -524,3 +525,361 @@ func TestMessagesGet_URLEncoding(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestMessagesSimulateStatus_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/messages/msg_123/simulate" {
+			t.Errorf("expected path '/messages/msg_123/simulate', got '%s'", r.URL.Path)
+		}
+
+		var req SimulateStatusRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Status != MessageStatusExpired {
+			t.Errorf("expected Status to be 'expired', got '%s'", req.Status)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Message{
+			ID:        "msg_123",
+			To:        TestNumberSuccess,
+			Status:    MessageStatusExpired,
+			IsSandbox: true,
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	msg, err := client.Messages.SimulateStatus(ctx, "msg_123", MessageStatusExpired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.Status != MessageStatusExpired {
+		t.Errorf("expected Status to be 'expired', got '%s'", msg.Status)
+	}
+}
+
+func TestMessagesSimulateStatus_ValidationErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("should not make request with validation error")
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	if _, err := client.Messages.SimulateStatus(ctx, "", MessageStatusDelivered); !IsValidationError(err) {
+		t.Errorf("expected ValidationError for empty ID, got %T", err)
+	}
+	if _, err := client.Messages.SimulateStatus(ctx, "msg_123", MessageStatusQueued); !IsValidationError(err) {
+		t.Errorf("expected ValidationError for unsupported status, got %T", err)
+	}
+}
+
+func TestMessagesSend_LinkOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		links, ok := body["links"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("expected links object, got %v", body["links"])
+		}
+		if links["suppressPreview"] != true {
+			t.Errorf("expected suppressPreview to be true, got %v", links["suppressPreview"])
+		}
+		if _, ok := links["shorten"]; ok {
+			t.Error("expected unset shorten to be omitted")
+		}
+		utm := links["utm"].(map[string]interface{})
+		if utm["campaign"] != "spring" {
+			t.Errorf("expected utm campaign to be 'spring', got %v", utm["campaign"])
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Message{ID: "msg_123", Status: MessageStatusQueued})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	suppress := true
+	_, err := client.Messages.Send(ctx, &SendMessageRequest{
+		To:   "+1234567890",
+		Text: "Sale: https://example.com/sale",
+		Links: &LinkOptions{
+			SuppressPreview: &suppress,
+			UTM:             &UTMParams{Source: "sms", Campaign: "spring"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestMessagesSend_Template(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SendMessageRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		if req.TemplateID != "tpl_123" {
+			t.Errorf("expected TemplateID to be 'tpl_123', got '%s'", req.TemplateID)
+		}
+		if req.Variables["code"] != "123456" {
+			t.Errorf("expected code variable to be '123456', got '%s'", req.Variables["code"])
+		}
+		if req.From != "Acme" {
+			t.Errorf("expected From to be 'Acme', got '%s'", req.From)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Message{
+			ID:         "msg_123",
+			To:         string(req.To),
+			Text:       "Your code is 123456",
+			Status:     MessageStatusQueued,
+			TemplateID: &req.TemplateID,
+			Metadata:   req.Metadata,
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	msg, err := client.Messages.Send(ctx, &SendMessageRequest{
+		To:         "+1234567890",
+		From:       "Acme",
+		TemplateID: "tpl_123",
+		Variables:  map[string]string{"code": "123456"},
+		Metadata:   map[string]interface{}{"order_id": "ord_1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if orderID, _ := GetMetadata[string](msg, "order_id"); orderID != "ord_1" {
+		t.Errorf("expected order_id metadata to be 'ord_1', got '%s'", orderID)
+	}
+
+	_, err = client.Messages.Send(ctx, &SendMessageRequest{To: "+1234567890", Text: "Hi", TemplateID: "tpl_123"})
+	if !IsValidationError(err) {
+		t.Errorf("expected ValidationError for text with template, got %T", err)
+	}
+}
+
+func TestMessagesCancel_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/messages/msg_123/cancel" {
+			t.Errorf("expected path '/messages/msg_123/cancel', got '%s'", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Message{ID: "msg_123", Status: MessageStatusCancelled})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	msg, err := client.Messages.Cancel(ctx, "msg_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.Status != MessageStatusCancelled {
+		t.Errorf("expected Status to be 'cancelled', got '%s'", msg.Status)
+	}
+
+	if _, err := client.Messages.Cancel(ctx, ""); !IsValidationError(err) {
+		t.Errorf("expected ValidationError for empty ID, got %T", err)
+	}
+}
+
+func TestMessagesSend_DuplicateSuppression(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if body["duplicateWindowSecs"] != float64(300) {
+			t.Errorf("expected duplicateWindowSecs to be 300, got %v", body["duplicateWindowSecs"])
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Message{ID: "msg_prior", Status: MessageStatusDelivered, IsDuplicate: true})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	msg, err := client.Messages.Send(ctx, &SendMessageRequest{
+		To:              "+1234567890",
+		Text:            "Your order shipped",
+		DuplicateWindow: 5 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !msg.IsDuplicate || msg.ID != "msg_prior" {
+		t.Errorf("expected prior message flagged as duplicate, got %+v", msg)
+	}
+
+	_, err = client.Messages.Send(ctx, &SendMessageRequest{To: "+1234567890", Text: "Hi", DuplicateWindow: MaxDuplicateWindow + time.Second})
+	if !IsValidationError(err) {
+		t.Errorf("expected ValidationError for oversized window, got %T", err)
+	}
+}
+
+func TestMessagesSend_ValidityPeriod(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if body["validityPeriodSecs"] != float64(600) {
+			t.Errorf("expected validityPeriodSecs to be 600, got %v", body["validityPeriodSecs"])
+		}
+
+		w.Write([]byte(`{"id":"msg_1","status":"queued","expiresAt":"2025-01-15T09:10:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	msg, err := client.Messages.Send(ctx, &SendMessageRequest{
+		To:             "+1234567890",
+		Text:           "Your code is 123456",
+		ValidityPeriod: 10 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.ExpiresAt == nil || *msg.ExpiresAt != "2025-01-15T09:10:00Z" {
+		t.Errorf("expected ExpiresAt to be set, got %v", msg.ExpiresAt)
+	}
+
+	_, err = client.Messages.Send(ctx, &SendMessageRequest{To: "+1234567890", Text: "Hi", ValidityPeriod: MaxValidityPeriod + time.Second})
+	if !IsValidationError(err) {
+		t.Errorf("expected ValidationError for oversized validity period, got %T", err)
+	}
+	_, err = client.Messages.SendBatch(ctx, &SendBatchRequest{
+		Messages:       []BatchMessageItem{{To: "+1234567890", Text: "Hi"}},
+		ValidityPeriod: -time.Minute,
+	})
+	if !IsValidationError(err) {
+		t.Errorf("expected ValidationError for negative validity period, got %T", err)
+	}
+}
+
+func TestMessagesSend_StatusCallbackURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if body["statusCallbackUrl"] != "https://tenant-a.example.com/sms" {
+			t.Errorf("expected statusCallbackUrl to be 'https://tenant-a.example.com/sms', got %v", body["statusCallbackUrl"])
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Message{ID: "msg_123", Status: MessageStatusQueued})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	_, err := client.Messages.Send(ctx, &SendMessageRequest{
+		To:                "+1234567890",
+		Text:              "Hello",
+		StatusCallbackURL: "https://tenant-a.example.com/sms",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = client.Messages.Send(ctx, &SendMessageRequest{
+		To:                "+1234567890",
+		Text:              "Hello",
+		StatusCallbackURL: "http://tenant-a.example.com/sms",
+	})
+	if !IsValidationError(err) {
+		t.Errorf("expected validation error for non-HTTPS callback, got %v", err)
+	}
+}
+
+func TestMessagesSend_SenderStrategyAuto(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if body["senderStrategy"] != "auto" {
+			t.Errorf("expected senderStrategy to be 'auto', got %v", body["senderStrategy"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"msg_123","status":"queued","senderSelection":{"type":"long_code","sender":"+15550001111","country":"US","reason":"alphanumeric sender IDs are not supported in US"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	msg, err := client.Messages.Send(ctx, &SendMessageRequest{
+		To:             "+15551234567",
+		Text:           "Hello",
+		SenderStrategy: SenderStrategyAuto,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.SenderSelection == nil || msg.SenderSelection.Type != "long_code" || msg.SenderSelection.Country != "US" {
+		t.Errorf("unexpected sender selection: %+v", msg.SenderSelection)
+	}
+
+	_, err = client.Messages.Send(ctx, &SendMessageRequest{
+		To:             "+15551234567",
+		Text:           "Hello",
+		From:           "ACME",
+		SenderStrategy: SenderStrategyAuto,
+	})
+	if !IsValidationError(err) {
+		t.Errorf("expected validation error for from with auto strategy, got %v", err)
+	}
+}
+
+func TestMessageStatus_IsTerminal(t *testing.T) {
+	terminal := map[MessageStatus]bool{
+		MessageStatusQueued:    false,
+		MessageStatusScheduled: false,
+		MessageStatusSent:      false,
+		MessageStatusDelivered: true,
+		MessageStatusFailed:    true,
+		MessageStatusBounced:   true,
+		MessageStatusExpired:   true,
+		MessageStatusCancelled: true,
+	}
+	for status, want := range terminal {
+		if got := status.IsTerminal(); got != want {
+			t.Errorf("expected %s.IsTerminal() to be %v, got %v", status, want, got)
+		}
+	}
+	if BatchStatusProcessing.IsTerminal() || !BatchStatusPartialFailure.IsTerminal() {
+		t.Error("unexpected BatchStatus.IsTerminal result")
+	}
+}
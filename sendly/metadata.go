@@ -0,0 +1,127 @@
+package sendly
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// MetadataHolder is implemented by resources that carry custom metadata.
+type MetadataHolder interface {
+	MetadataMap() map[string]interface{}
+}
+
+// MetadataMap returns the message's custom metadata.
+func (m Message) MetadataMap() map[string]interface{} { return m.Metadata }
+
+// MetadataMap returns the webhook's custom metadata.
+func (w Webhook) MetadataMap() map[string]interface{} { return w.Metadata }
+
+// MetadataMap returns the session's custom metadata.
+func (s VerifySession) MetadataMap() map[string]interface{} { return s.Metadata }
+
+// MetadataMap returns the session event's custom metadata.
+func (d VerifySessionEventData) MetadataMap() map[string]interface{} { return d.Metadata }
+
+// MetadataMap returns the validated session's custom metadata.
+func (r ValidateSessionResponse) MetadataMap() map[string]interface{} { return r.Metadata }
+
+// GetMetadata returns the metadata value stored under key as a T.
+//
+// Values decoded from JSON are loosely typed (numbers arrive as float64,
+// objects as maps), so when a direct type assertion fails the value is
+// converted through its JSON representation.
+//
+// Example:
+//
+//	userID, err := sendly.GetMetadata[int64](webhook, "user_id")
+func GetMetadata[T any](r MetadataHolder, key string) (T, error) {
+	var zero T
+	if r == nil {
+		return zero, fmt.Errorf("sendly: metadata key %q not found", key)
+	}
+	raw, ok := r.MetadataMap()[key]
+	if !ok {
+		return zero, fmt.Errorf("sendly: metadata key %q not found", key)
+	}
+	return convertMetadata[T](key, raw)
+}
+
+// GetMetadataOr returns the metadata value stored under key as a T, or
+// fallback if the key is missing or has an incompatible type.
+func GetMetadataOr[T any](r MetadataHolder, key string, fallback T) T {
+	v, err := GetMetadata[T](r, key)
+	if err != nil {
+		return fallback
+	}
+	return v
+}
+
+// convertMetadata converts a loosely typed metadata value to T.
+func convertMetadata[T any](key string, raw interface{}) (T, error) {
+	if v, ok := raw.(T); ok {
+		return v, nil
+	}
+
+	var v T
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return v, fmt.Errorf("sendly: metadata key %q: %w", key, err)
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return v, fmt.Errorf("sendly: metadata key %q has type %T, want %T", key, raw, v)
+	}
+	return v, nil
+}
+
+// MetadataSchema describes the expected keys and types of a metadata map.
+// Register keys with RegisterMetadataKey and check maps with Validate.
+type MetadataSchema struct {
+	fields map[string]metadataField
+}
+
+type metadataField struct {
+	required bool
+	check    func(raw interface{}) error
+}
+
+// NewMetadataSchema creates an empty metadata schema.
+func NewMetadataSchema() *MetadataSchema {
+	return &MetadataSchema{fields: make(map[string]metadataField)}
+}
+
+// RegisterMetadataKey declares that key holds a value of type T.
+func RegisterMetadataKey[T any](s *MetadataSchema, key string, required bool) {
+	s.fields[key] = metadataField{
+		required: required,
+		check: func(raw interface{}) error {
+			_, err := convertMetadata[T](key, raw)
+			return err
+		},
+	}
+}
+
+// Validate checks that metadata satisfies the schema. Keys not registered
+// in the schema are ignored.
+func (s *MetadataSchema) Validate(metadata map[string]interface{}) error {
+	keys := make([]string, 0, len(s.fields))
+	for key := range s.fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		field := s.fields[key]
+		raw, ok := metadata[key]
+		if !ok {
+			if field.required {
+				return &ValidationError{APIError: APIError{Message: fmt.Sprintf("metadata key %q is required", key)}}
+			}
+			continue
+		}
+		if err := field.check(raw); err != nil {
+			return &ValidationError{APIError: APIError{Message: fmt.Sprintf("invalid metadata key %q", key)}, Err: err}
+		}
+	}
+	return nil
+}
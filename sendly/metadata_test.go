@@ -0,0 +1,76 @@
+package sendly
+
+import "testing"
+
+func TestGetMetadata(t *testing.T) {
+	webhook := Webhook{
+		Metadata: map[string]interface{}{
+			"user_id":  float64(42),
+			"order_id": "ord_123",
+			"tags":     []interface{}{"a", "b"},
+		},
+	}
+
+	userID, err := GetMetadata[int64](webhook, "user_id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if userID != 42 {
+		t.Errorf("expected user_id to be 42, got %d", userID)
+	}
+
+	orderID, err := GetMetadata[string](webhook, "order_id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if orderID != "ord_123" {
+		t.Errorf("expected order_id to be 'ord_123', got '%s'", orderID)
+	}
+
+	tags, err := GetMetadata[[]string](webhook, "tags")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tags) != 2 || tags[0] != "a" {
+		t.Errorf("expected tags to be [a b], got %v", tags)
+	}
+
+	if _, err := GetMetadata[string](webhook, "missing"); err == nil {
+		t.Error("expected error for missing key, got nil")
+	}
+	if _, err := GetMetadata[int](webhook, "order_id"); err == nil {
+		t.Error("expected error for mismatched type, got nil")
+	}
+	if v := GetMetadataOr(webhook, "missing", "fallback"); v != "fallback" {
+		t.Errorf("expected fallback, got '%s'", v)
+	}
+}
+
+func TestMetadataSchema_Validate(t *testing.T) {
+	schema := NewMetadataSchema()
+	RegisterMetadataKey[int](schema, "user_id", true)
+	RegisterMetadataKey[string](schema, "plan", false)
+
+	tests := []struct {
+		name     string
+		metadata map[string]interface{}
+		wantErr  bool
+	}{
+		{name: "valid", metadata: map[string]interface{}{"user_id": float64(1), "plan": "pro"}},
+		{name: "optional missing", metadata: map[string]interface{}{"user_id": float64(1)}},
+		{name: "required missing", metadata: map[string]interface{}{"plan": "pro"}, wantErr: true},
+		{name: "wrong type", metadata: map[string]interface{}{"user_id": "abc"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Validate(tt.metadata)
+			if tt.wantErr && !IsValidationError(err) {
+				t.Errorf("expected ValidationError, got %v", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
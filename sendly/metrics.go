@@ -0,0 +1,94 @@
+package sendly
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MetricsCollector receives health metrics for SDK calls so they can be
+// exported to a monitoring system. Routes are templated ("/messages/{id}")
+// to keep label cardinality bounded. Implementations must be safe for
+// concurrent use. The sendlyprom package provides a Prometheus adapter.
+type MetricsCollector interface {
+	// ObserveRequest is called once per call, after any retries. status is
+	// the HTTP status of the last attempt, or 0 if no response was received.
+	ObserveRequest(method, route string, status int, duration time.Duration)
+	// ObserveError is called when a call fails. code is the API error code,
+	// or one of "network_error", "canceled", "deadline_exceeded" or
+	// "http_<status>" when the API did not return one.
+	ObserveError(method, route, code string)
+	// ObserveRetry is called before each retry.
+	ObserveRetry(method, route string)
+}
+
+// WithMetrics reports call counts, latency, errors and retries to m.
+func WithMetrics(m MetricsCollector) ClientOption {
+	return func(c *Client) {
+		c.Metrics = m
+	}
+}
+
+// beginMetrics makes sure ctx carries a CallInfo when metrics or auditing
+// are enabled, so the final status code and attempt numbers are known.
+func (c *Client) beginMetrics(ctx context.Context) context.Context {
+	if (c.Metrics == nil && c.AuditSink == nil) || callInfoFromContext(ctx) != nil {
+		return ctx
+	}
+	return WithCallInfo(ctx, &CallInfo{})
+}
+
+// observeCall reports a finished call.
+func (c *Client) observeCall(ctx context.Context, method, path string, start time.Time, err error) {
+	if c.Metrics == nil {
+		return
+	}
+	route := metricsRoute(path)
+	status := 0
+	if info := callInfoFromContext(ctx); info != nil {
+		status = info.StatusCode
+	}
+	c.Metrics.ObserveRequest(method, route, status, time.Since(start))
+	if err != nil {
+		c.Metrics.ObserveError(method, route, metricsErrorCode(err))
+	}
+}
+
+// observeRetry reports a retry.
+func (c *Client) observeRetry(method, path string) {
+	if c.Metrics != nil {
+		c.Metrics.ObserveRetry(method, metricsRoute(path))
+	}
+}
+
+// metricsRoute strips the query string and replaces ID-like path segments
+// with "{id}". Segments made only of lowercase letters and hyphens are kept.
+func metricsRoute(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		if seg != "" && strings.Trim(seg, "abcdefghijklmnopqrstuvwxyz-") != "" {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// metricsErrorCode returns the error code label for err.
+func metricsErrorCode(err error) string {
+	var apiErr *Error
+	switch {
+	case errors.As(err, &apiErr) && apiErr.Code != "":
+		return apiErr.Code
+	case apiErr != nil:
+		return "http_" + strconv.Itoa(apiErr.StatusCode)
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "deadline_exceeded"
+	default:
+		return "network_error"
+	}
+}
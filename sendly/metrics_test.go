@@ -0,0 +1,32 @@
+package sendly
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMetricsRoute(t *testing.T) {
+	tests := map[string]string{
+		"/messages/msg_abc123":             "/messages/{id}",
+		"/templates/tpl_1/preview-matrix":  "/templates/{id}/preview-matrix",
+		"/lookup/+15551234567?package=hlr": "/lookup/{id}",
+		"/verify/handoffs/claim":           "/verify/handoffs/claim",
+	}
+	for path, want := range tests {
+		if got := metricsRoute(path); got != want {
+			t.Errorf("metricsRoute(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestMetricsErrorCode(t *testing.T) {
+	if got := metricsErrorCode(&NotFoundError{APIError: APIError{Code: "not_found"}}); got != "not_found" {
+		t.Errorf("expected code to be 'not_found', got '%s'", got)
+	}
+	if got := metricsErrorCode(&NetworkError{Message: "request failed"}); got != "network_error" {
+		t.Errorf("expected code to be 'network_error', got '%s'", got)
+	}
+	if got := metricsErrorCode(context.Canceled); got != "canceled" {
+		t.Errorf("expected code to be 'canceled', got '%s'", got)
+	}
+}
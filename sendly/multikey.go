@@ -0,0 +1,149 @@
+package sendly
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// WeightedKey is an API key and its share of traffic.
+type WeightedKey struct {
+	Key string
+	// Weight is the key's relative share of requests (default: 1).
+	Weight int
+}
+
+// KeyStatus reports the state of one API key in a multi-key client.
+type KeyStatus struct {
+	// LastFour is the last four characters of the key.
+	LastFour string
+	Weight   int
+	// RateLimit is the most recent rate limit reported for the key, or nil
+	// if none has been seen yet.
+	RateLimit *RateLimit
+}
+
+// WithAPIKeys spreads requests across several API keys of the same account
+// in round-robin order, for accounts granted sharded rate limits. Each key's
+// rate limit is tracked separately, and keys whose window is exhausted are
+// skipped until it resets. The client-side rate limit scales with the
+// number of keys. The first key also becomes Client.APIKey.
+func WithAPIKeys(keys ...string) ClientOption {
+	weighted := make([]WeightedKey, len(keys))
+	for i, k := range keys {
+		weighted[i] = WeightedKey{Key: k, Weight: 1}
+	}
+	return WithWeightedAPIKeys(weighted...)
+}
+
+// WithWeightedAPIKeys is like WithAPIKeys but sends each key a share of
+// requests proportional to its weight.
+func WithWeightedAPIKeys(keys ...WeightedKey) ClientOption {
+	return func(c *Client) {
+		if len(keys) == 0 {
+			c.keys = nil
+			return
+		}
+		pool := &keyPool{}
+		for _, k := range keys {
+			weight := k.Weight
+			if weight <= 0 {
+				weight = 1
+			}
+			pool.keys = append(pool.keys, &pooledKey{key: k.Key, weight: weight})
+		}
+		c.keys = pool
+		if c.APIKey == "" {
+			c.APIKey = keys[0].Key
+		}
+	}
+}
+
+// KeyStatuses returns the state of each configured API key in the order
+// they were given, or nil if the client uses a single key.
+func (c *Client) KeyStatuses() []KeyStatus {
+	if c.keys == nil {
+		return nil
+	}
+	statuses := make([]KeyStatus, len(c.keys.keys))
+	for i, k := range c.keys.keys {
+		lastFour := k.key
+		if len(lastFour) > 4 {
+			lastFour = lastFour[len(lastFour)-4:]
+		}
+		statuses[i] = KeyStatus{LastFour: lastFour, Weight: k.weight, RateLimit: k.rateLimit.get()}
+	}
+	return statuses
+}
+
+// keyPool selects API keys with smooth weighted round-robin.
+type keyPool struct {
+	mu   sync.Mutex
+	keys []*pooledKey
+}
+
+type pooledKey struct {
+	key       string
+	weight    int
+	current   int
+	rateLimit rateLimitTracker
+}
+
+// exhausted reports whether the key's last reported window has no requests
+// left and has not reset yet.
+func (k *pooledKey) exhausted(now time.Time) bool {
+	rl := k.rateLimit.get()
+	return rl != nil && rl.Remaining == 0 && rl.Reset.After(now)
+}
+
+// next returns the key for the next request. Exhausted keys are skipped;
+// if every key is exhausted, the one that resets first is used.
+func (p *keyPool) next() *pooledKey {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	var best, soonest *pooledKey
+	total := 0
+	for _, k := range p.keys {
+		if k.exhausted(now) {
+			if soonest == nil || k.rateLimit.get().Reset.Before(soonest.rateLimit.get().Reset) {
+				soonest = k
+			}
+			continue
+		}
+		k.current += k.weight
+		total += k.weight
+		if best == nil || k.current > best.current {
+			best = k
+		}
+	}
+	if best == nil {
+		return soonest
+	}
+	best.current -= total
+	return best
+}
+
+// applyAPIKey sets the Authorization header, choosing a key from the pool
+// when several are configured. It returns the pooled key, or nil.
+func (c *Client) applyAPIKey(req *http.Request) *pooledKey {
+	apiKey := c.APIKey
+	var key *pooledKey
+	if c.keys != nil {
+		key = c.keys.next()
+		apiKey = key.key
+	}
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+	return key
+}
+
+// recordRateLimit stores the rate limit reported in h for the client and,
+// in multi-key mode, for the key that made the request.
+func (c *Client) recordRateLimit(key *pooledKey, h http.Header) {
+	rl := parseRateLimit(h)
+	c.rateLimit.set(rl)
+	if key != nil {
+		key.rateLimit.set(rl)
+	}
+}
@@ -0,0 +1,105 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_WeightedAPIKeys(t *testing.T) {
+	var mu sync.Mutex
+	counts := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		counts[r.Header.Get("Authorization")]++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("", WithBaseURL(server.URL), WithWeightedAPIKeys(
+		WeightedKey{Key: "sk_a", Weight: 3},
+		WeightedKey{Key: "sk_b", Weight: 1},
+	))
+	for i := 0; i < 8; i++ {
+		if err := client.request(context.Background(), "GET", "/account", nil, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if counts["Bearer sk_a"] != 6 || counts["Bearer sk_b"] != 2 {
+		t.Errorf("expected a 6/2 split, got %v", counts)
+	}
+	if client.APIKey != "sk_a" {
+		t.Errorf("expected APIKey to be 'sk_a', got '%s'", client.APIKey)
+	}
+}
+
+func TestClient_APIKeysSkipExhausted(t *testing.T) {
+	reset := strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10)
+	var used []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		used = append(used, auth)
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Reset", reset)
+		if auth == "Bearer sk_a" {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+		} else {
+			w.Header().Set("X-RateLimit-Remaining", "50")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("", WithBaseURL(server.URL), WithAPIKeys("sk_a", "sk_b"))
+	for i := 0; i < 4; i++ {
+		client.request(context.Background(), "GET", "/account", nil, nil)
+	}
+	want := []string{"Bearer sk_a", "Bearer sk_b", "Bearer sk_b", "Bearer sk_b"}
+	for i := range want {
+		if used[i] != want[i] {
+			t.Errorf("request %d: expected %s, got %s", i, want[i], used[i])
+		}
+	}
+
+	statuses := client.KeyStatuses()
+	if len(statuses) != 2 || statuses[0].LastFour != "sk_a" || statuses[0].RateLimit.Remaining != 0 || statuses[1].RateLimit.Remaining != 50 {
+		t.Errorf("unexpected key statuses: %+v", statuses)
+	}
+}
+
+func TestClient_APIKeysScaleRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	// A single-key client bursts 10 requests and then sends one per
+	// second, so 30 requests within a second need three keys.
+	client := NewClient("", WithBaseURL(server.URL), WithAPIKeys("sk_a", "sk_b", "sk_c"))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for i := 0; i < 30; i++ {
+		if err := client.request(ctx, "GET", "/account", nil, nil); err != nil {
+			t.Fatalf("request %d: unexpected error: %v", i, err)
+		}
+	}
+
+	single := NewClient("sk_a", WithBaseURL(server.URL))
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	var err error
+	for i := 0; i < 30 && err == nil; i++ {
+		err = single.request(ctx, "GET", "/account", nil, nil)
+	}
+	if err == nil {
+		t.Error("expected a single-key client to be rate limited")
+	}
+}
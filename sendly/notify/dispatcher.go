@@ -0,0 +1,149 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrDispatcherClosed is returned by Dispatch after Close.
+var ErrDispatcherClosed = errors.New("notify: dispatcher closed")
+
+// Job is a notification queued on a Dispatcher.
+type Job struct {
+	Name      string
+	ContactID string
+	Vars      map[string]string
+}
+
+// DispatcherOptions configures a Dispatcher.
+type DispatcherOptions struct {
+	// Workers is the number of concurrent senders (default: the client's
+	// RecommendedConcurrency).
+	Workers int
+	// QueueSize is the number of jobs buffered before Dispatch blocks
+	// (default: Workers).
+	QueueSize int
+	// OnResult is called from a worker goroutine when a job finishes, with
+	// either a result or the error from Notifier.Send.
+	OnResult func(job Job, res *Result, err error)
+}
+
+// Dispatcher sends notifications from a pool of worker goroutines, so
+// request handlers can queue notifications without waiting for them.
+//
+// Example:
+//
+//	d := notify.NewDispatcher(n, &notify.DispatcherOptions{
+//	    OnResult: func(job notify.Job, res *notify.Result, err error) {
+//	        if err != nil {
+//	            log.Printf("notify %s to %s: %v", job.Name, job.ContactID, err)
+//	        }
+//	    },
+//	})
+//	defer d.Close()
+//
+//	d.Dispatch(ctx, notify.Job{Name: "order_shipped", ContactID: contactID})
+type Dispatcher struct {
+	notifier *Notifier
+	onResult func(Job, *Result, error)
+	jobs     chan Job
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewDispatcher starts a Dispatcher sending through n.
+func NewDispatcher(n *Notifier, opts *DispatcherOptions) *Dispatcher {
+	var o DispatcherOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Workers <= 0 {
+		o.Workers = n.client.RecommendedConcurrency()
+	}
+	if o.QueueSize <= 0 {
+		o.QueueSize = o.Workers
+	}
+
+	d := &Dispatcher{
+		notifier: n,
+		onResult: o.OnResult,
+		jobs:     make(chan Job, o.QueueSize),
+	}
+	d.ctx, d.cancel = context.WithCancel(context.Background())
+	d.wg.Add(o.Workers)
+	for i := 0; i < o.Workers; i++ {
+		go d.work()
+	}
+	return d
+}
+
+func (d *Dispatcher) work() {
+	defer d.wg.Done()
+	for job := range d.jobs {
+		res, err := d.notifier.Send(d.ctx, job.Name, job.ContactID, job.Vars)
+		if d.onResult != nil {
+			d.onResult(job, res, err)
+		}
+	}
+}
+
+// Dispatch queues a job, blocking while the queue is full. It returns
+// ctx.Err() if ctx is done first, and ErrDispatcherClosed after Close.
+// ctx only bounds the wait for queue space; the send itself runs until
+// Close or Shutdown.
+func (d *Dispatcher) Dispatch(ctx context.Context, job Job) error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.closed {
+		return ErrDispatcherClosed
+	}
+	select {
+	case d.jobs <- job:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting jobs and waits for queued jobs to be sent.
+func (d *Dispatcher) Close() {
+	d.stop()
+	d.wg.Wait()
+	d.cancel()
+}
+
+// Shutdown stops accepting jobs and waits for queued jobs to be sent
+// until ctx is done, then cancels the remaining sends, which report
+// context.Canceled to OnResult, and returns ctx.Err().
+func (d *Dispatcher) Shutdown(ctx context.Context) error {
+	d.stop()
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		d.cancel()
+		return nil
+	case <-ctx.Done():
+		d.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+func (d *Dispatcher) stop() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.closed {
+		d.closed = true
+		close(d.jobs)
+	}
+}
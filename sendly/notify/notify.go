@@ -0,0 +1,318 @@
+// Package notify sends named notifications to contacts, combining the
+// Templates, Contacts and Messages APIs: it resolves the template for a
+// notification, the contact's locale and preferred channel, and checks
+// consent and the suppression list in one call.
+//
+// Example:
+//
+//	n := notify.New(client, nil)
+//	res, err := n.Send(ctx, "order_shipped", contactID, map[string]string{
+//	    "order_id": order.ID,
+//	})
+//	if errors.Is(err, notify.ErrUnsubscribed) {
+//	    // the contact opted out; nothing was sent
+//	}
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/SendlyHQ/sendly-go/v3/sendly"
+)
+
+// ChannelSMS is the default channel. Notifications on it are sent with
+// client.Messages.Send.
+const ChannelSMS = "sms"
+
+var (
+	// ErrUnknownNotification is returned when no template matches the
+	// notification name.
+	ErrUnknownNotification = errors.New("notify: no template for notification")
+	// ErrUnsubscribed is returned when the contact has unsubscribed.
+	ErrUnsubscribed = errors.New("notify: contact has unsubscribed")
+	// ErrNoChannel is returned when the contact prefers a channel with no
+	// registered sender.
+	ErrNoChannel = errors.New("notify: no sender for the contact's channel")
+)
+
+// Notification is a fully resolved notification, passed to channel
+// senders.
+type Notification struct {
+	Name       string
+	Contact    *sendly.Contact
+	TemplateID string
+	// Locale is the contact's locale, or "" for the template default.
+	Locale    string
+	Channel   string
+	Variables map[string]string
+}
+
+// ChannelSender delivers a notification on a channel other than SMS, such
+// as email or push, returning an identifier for the delivery.
+type ChannelSender func(ctx context.Context, n *Notification) (string, error)
+
+// Options configures a Notifier.
+type Options struct {
+	// Templates maps notification names to template IDs. Names not listed
+	// are matched against template names.
+	Templates map[string]string
+	// LocaleAttribute is the contact attribute holding the contact's BCP 47
+	// locale (default: "locale").
+	LocaleAttribute string
+	// ChannelAttribute is the contact attribute holding the contact's
+	// preferred channel (default: "channel"). Contacts without one get SMS.
+	ChannelAttribute string
+	// Channels registers senders for channels other than SMS.
+	Channels map[string]ChannelSender
+	// MessageType is the message type of SMS notifications (default:
+	// sendly.MessageTypeTransactional).
+	MessageType sendly.MessageType
+}
+
+// Result describes a sent notification.
+type Result struct {
+	Notification
+	// Message is the sent SMS, nil for other channels.
+	Message *sendly.Message
+	// DeliveryID is the message ID, or the identifier returned by the
+	// channel sender.
+	DeliveryID string
+}
+
+// Notifier sends named notifications to contacts. It is safe for
+// concurrent use.
+type Notifier struct {
+	client *sendly.Client
+	opts   Options
+
+	mu        sync.Mutex
+	templates map[string]string
+	// variables maps template IDs to the variable keys they declare.
+	variables map[string]map[string]bool
+	// misses records when a name was last not found, so unknown names do
+	// not list every template on each send.
+	misses map[string]time.Time
+	// listing is the template listing in progress, shared by every
+	// lookup that misses while it runs.
+	listing *templateListing
+}
+
+// templateListing is an in-flight Templates.ListAll call.
+type templateListing struct {
+	done chan struct{}
+	err  error
+}
+
+// unknownNameTTL is how long a name that matched no template is reported
+// unknown before templates are listed again.
+const unknownNameTTL = time.Minute
+
+// New returns a Notifier that sends through client.
+func New(client *sendly.Client, opts *Options) *Notifier {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	if o.LocaleAttribute == "" {
+		o.LocaleAttribute = "locale"
+	}
+	if o.ChannelAttribute == "" {
+		o.ChannelAttribute = "channel"
+	}
+	if o.MessageType == "" {
+		o.MessageType = sendly.MessageTypeTransactional
+	}
+	return &Notifier{client: client, opts: o, variables: make(map[string]map[string]bool), misses: make(map[string]time.Time)}
+}
+
+// Send sends the notification name to a contact. vars are merged over the
+// contact's first_name, last_name and string attributes to form the
+// template variables. Contact fields are only included for variables the
+// template declares, so attributes such as the locale are not passed
+// unless the template uses them. SMS notifications are checked against the
+// suppression list and fail with a *sendly.SuppressedError for suppressed
+// recipients.
+func (n *Notifier) Send(ctx context.Context, name, contactID string, vars map[string]string) (*Result, error) {
+	templateID, err := n.templateID(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	contact, err := n.client.Contacts.Get(ctx, contactID)
+	if err != nil {
+		return nil, err
+	}
+	if contact.OptInStatus == sendly.ContactOptInUnsubscribed {
+		return nil, ErrUnsubscribed
+	}
+	declared, err := n.templateVariables(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{Notification: Notification{
+		Name:       name,
+		Contact:    contact,
+		TemplateID: templateID,
+		Locale:     stringAttribute(contact, n.opts.LocaleAttribute),
+		Channel:    stringAttribute(contact, n.opts.ChannelAttribute),
+		Variables:  variables(contact, declared, vars),
+	}}
+	if res.Channel == "" {
+		res.Channel = ChannelSMS
+	}
+
+	if res.Channel != ChannelSMS {
+		send, ok := n.opts.Channels[res.Channel]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrNoChannel, res.Channel)
+		}
+		if res.DeliveryID, err = send(ctx, &res.Notification); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	res.Message, err = n.client.Messages.Send(ctx, &sendly.SendMessageRequest{
+		To:               sendly.Phone(contact.Phone),
+		TemplateID:       templateID,
+		Variables:        res.Variables,
+		Locale:           res.Locale,
+		MessageType:      n.opts.MessageType,
+		FailIfSuppressed: true,
+		Metadata: map[string]interface{}{
+			"notification": name,
+			"contact_id":   contact.ID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.DeliveryID = res.Message.ID
+	return res, nil
+}
+
+// templateID resolves a notification name. Templates are listed on the
+// first miss and again when a name is not found, unless it was not found
+// within unknownNameTTL. The listing runs without holding n.mu, and
+// concurrent misses wait for the same listing.
+func (n *Notifier) templateID(ctx context.Context, name string) (string, error) {
+	if id, ok := n.opts.Templates[name]; ok {
+		return id, nil
+	}
+
+	n.mu.Lock()
+	if id, ok := n.templates[name]; ok {
+		n.mu.Unlock()
+		return id, nil
+	}
+	if at, ok := n.misses[name]; ok && time.Since(at) < unknownNameTTL {
+		n.mu.Unlock()
+		return "", fmt.Errorf("%w %q", ErrUnknownNotification, name)
+	}
+	l := n.listing
+	if l == nil {
+		l = &templateListing{done: make(chan struct{})}
+		n.listing = l
+		n.mu.Unlock()
+		n.listTemplates(ctx, l)
+	} else {
+		n.mu.Unlock()
+		select {
+		case <-l.done:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+	if l.err != nil {
+		return "", l.err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if id, ok := n.templates[name]; ok {
+		return id, nil
+	}
+	n.misses[name] = time.Now()
+	return "", fmt.Errorf("%w %q", ErrUnknownNotification, name)
+}
+
+// listTemplates lists the published templates into n.templates and
+// completes l.
+func (n *Notifier) listTemplates(ctx context.Context, l *templateListing) {
+	templates, err := n.client.Templates.ListAll(ctx).Collect()
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if err == nil {
+		n.templates = make(map[string]string, len(templates))
+		for _, t := range templates {
+			if t.Status == sendly.TemplateStatusPublished {
+				n.templates[t.Name] = t.ID
+				n.variables[t.ID] = variableKeys(t.Variables)
+			}
+		}
+		n.misses = make(map[string]time.Time)
+	}
+	l.err = err
+	n.listing = nil
+	close(l.done)
+}
+
+// templateVariables returns the variable keys a template declares,
+// fetching the template the first time it is used.
+func (n *Notifier) templateVariables(ctx context.Context, templateID string) (map[string]bool, error) {
+	n.mu.Lock()
+	keys, ok := n.variables[templateID]
+	n.mu.Unlock()
+	if ok {
+		return keys, nil
+	}
+
+	t, err := n.client.Templates.Get(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	keys = variableKeys(t.Variables)
+	n.mu.Lock()
+	n.variables[templateID] = keys
+	n.mu.Unlock()
+	return keys, nil
+}
+
+func variableKeys(defs []sendly.TemplateVariable) map[string]bool {
+	keys := make(map[string]bool, len(defs))
+	for _, d := range defs {
+		keys[d.Key] = true
+	}
+	return keys
+}
+
+func stringAttribute(c *sendly.Contact, key string) string {
+	s, _ := c.Attributes[key].(string)
+	return s
+}
+
+// variables merges vars over the contact's names and string attributes,
+// keeping only contact fields whose keys are in declared.
+func variables(c *sendly.Contact, declared map[string]bool, vars map[string]string) map[string]string {
+	merged := make(map[string]string, len(declared)+len(vars))
+	for k, v := range c.Attributes {
+		if s, ok := v.(string); ok && declared[k] {
+			merged[k] = s
+		}
+	}
+	if c.FirstName != "" && declared["first_name"] {
+		merged["first_name"] = c.FirstName
+	}
+	if c.LastName != "" && declared["last_name"] {
+		merged["last_name"] = c.LastName
+	}
+	for k, v := range vars {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,238 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SendlyHQ/sendly-go/v3/sendly"
+)
+
+const orderShipped = `{"id":"tpl_1","name":"order_shipped","status":"published","variables":[` +
+	`{"key":"first_name","type":"string","fallback":"there"},{"key":"order_id","type":"string"},{"key":"tier","type":"string","fallback":"standard"}]}`
+
+func newTestServer(t *testing.T, sent *[]map[string]interface{}) *httptest.Server {
+	var mu sync.Mutex
+	contacts := map[string]string{
+		"/contacts/cnt_1": `{"id":"cnt_1","phone":"+15551234567","first_name":"Ada","attributes":{"locale":"de-DE","tier":"gold"},"opt_in_status":"subscribed"}`,
+		"/contacts/cnt_2": `{"id":"cnt_2","phone":"+15557654321","opt_in_status":"unsubscribed"}`,
+		"/contacts/cnt_3": `{"id":"cnt_3","phone":"+15550000000","attributes":{"channel":"email"},"opt_in_status":"subscribed"}`,
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/templates":
+			w.Write([]byte(`{"templates":[` + orderShipped + `,{"id":"tpl_2","name":"draft_only","status":"draft"}]}`))
+		case r.URL.Path == "/templates/tpl_1":
+			w.Write([]byte(orderShipped))
+		case r.URL.Path == "/templates/tpl_9":
+			w.Write([]byte(`{"id":"tpl_9","name":"welcome","status":"published","variables":[{"key":"first_name","type":"string","fallback":"there"}]}`))
+		case contacts[r.URL.Path] != "":
+			w.Write([]byte(contacts[r.URL.Path]))
+		case r.Method == "POST" && r.URL.Path == "/messages":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			mu.Lock()
+			*sent = append(*sent, body)
+			mu.Unlock()
+			w.Write([]byte(`{"id":"msg_1","to":"+15551234567","status":"queued"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":"not_found","message":"not found"}`))
+		}
+	}))
+}
+
+func TestNotifier_Send(t *testing.T) {
+	var sent []map[string]interface{}
+	server := newTestServer(t, &sent)
+	defer server.Close()
+
+	n := New(sendly.NewClient("test-api-key", sendly.WithBaseURL(server.URL)), nil)
+	res, err := n.Send(context.Background(), "order_shipped", "cnt_1", map[string]string{"order_id": "o_9", "tier": "vip"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.DeliveryID != "msg_1" || res.Channel != ChannelSMS || res.TemplateID != "tpl_1" || res.Locale != "de-DE" {
+		t.Errorf("unexpected result: %+v", res)
+	}
+	if len(sent) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(sent))
+	}
+	body := sent[0]
+	if body["templateId"] != "tpl_1" || body["locale"] != "de-DE" || body["messageType"] != "transactional" {
+		t.Errorf("unexpected message: %v", body)
+	}
+	vars := body["variables"].(map[string]interface{})
+	if vars["first_name"] != "Ada" || vars["order_id"] != "o_9" || vars["tier"] != "vip" {
+		t.Errorf("unexpected variables: %v", vars)
+	}
+	if meta := body["metadata"].(map[string]interface{}); meta["notification"] != "order_shipped" || meta["contact_id"] != "cnt_1" {
+		t.Errorf("unexpected metadata: %v", meta)
+	}
+}
+
+func TestNotifier_SendWithTemplateValidation(t *testing.T) {
+	var sent []map[string]interface{}
+	server := newTestServer(t, &sent)
+	defer server.Close()
+
+	client := sendly.NewClient("test-api-key", sendly.WithBaseURL(server.URL), sendly.WithTemplateValidation(0))
+	n := New(client, nil)
+	if _, err := n.Send(context.Background(), "order_shipped", "cnt_1", map[string]string{"order_id": "o_9"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	vars := sent[0]["variables"].(map[string]interface{})
+	if _, ok := vars["locale"]; ok {
+		t.Errorf("expected the locale attribute not to be passed as a variable, got %v", vars)
+	}
+	if vars["tier"] != "gold" || vars["first_name"] != "Ada" {
+		t.Errorf("expected declared contact attributes to be passed, got %v", vars)
+	}
+}
+
+func TestNotifier_SendErrors(t *testing.T) {
+	var sent []map[string]interface{}
+	server := newTestServer(t, &sent)
+	defer server.Close()
+
+	n := New(sendly.NewClient("test-api-key", sendly.WithBaseURL(server.URL)), nil)
+	ctx := context.Background()
+
+	if _, err := n.Send(ctx, "draft_only", "cnt_1", nil); !errors.Is(err, ErrUnknownNotification) {
+		t.Errorf("expected ErrUnknownNotification, got %v", err)
+	}
+	if _, err := n.Send(ctx, "order_shipped", "cnt_2", nil); !errors.Is(err, ErrUnsubscribed) {
+		t.Errorf("expected ErrUnsubscribed, got %v", err)
+	}
+	if _, err := n.Send(ctx, "order_shipped", "cnt_3", nil); !errors.Is(err, ErrNoChannel) {
+		t.Errorf("expected ErrNoChannel, got %v", err)
+	}
+	if len(sent) != 0 {
+		t.Errorf("expected no messages, got %d", len(sent))
+	}
+}
+
+func TestNotifier_CustomChannel(t *testing.T) {
+	var sent []map[string]interface{}
+	server := newTestServer(t, &sent)
+	defer server.Close()
+
+	n := New(sendly.NewClient("test-api-key", sendly.WithBaseURL(server.URL)), &Options{
+		Templates: map[string]string{"welcome": "tpl_9"},
+		Channels: map[string]ChannelSender{
+			"email": func(ctx context.Context, n *Notification) (string, error) {
+				return "email_1", nil
+			},
+		},
+	})
+	res, err := n.Send(context.Background(), "welcome", "cnt_3", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.DeliveryID != "email_1" || res.Channel != "email" || res.TemplateID != "tpl_9" || res.Message != nil {
+		t.Errorf("unexpected result: %+v", res)
+	}
+}
+
+func TestDispatcher(t *testing.T) {
+	var sent []map[string]interface{}
+	server := newTestServer(t, &sent)
+	defer server.Close()
+
+	var mu sync.Mutex
+	var results, failures int
+	d := NewDispatcher(New(sendly.NewClient("test-api-key", sendly.WithBaseURL(server.URL)), nil), &DispatcherOptions{
+		Workers: 2,
+		OnResult: func(job Job, res *Result, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures++
+			} else {
+				results++
+			}
+		},
+	})
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if err := d.Dispatch(ctx, Job{Name: "order_shipped", ContactID: "cnt_1"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	d.Dispatch(ctx, Job{Name: "order_shipped", ContactID: "cnt_2"})
+	d.Close()
+
+	if results != 2 || failures != 1 {
+		t.Errorf("expected 2 results and 1 failure, got %d and %d", results, failures)
+	}
+	if err := d.Dispatch(ctx, Job{Name: "order_shipped", ContactID: "cnt_1"}); !errors.Is(err, ErrDispatcherClosed) {
+		t.Errorf("expected ErrDispatcherClosed, got %v", err)
+	}
+}
+
+func TestNotifier_TemplateLookupCaching(t *testing.T) {
+	var mu sync.Mutex
+	lists := 0
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/templates":
+			mu.Lock()
+			lists++
+			mu.Unlock()
+			<-release
+			w.Write([]byte(`{"templates":[` + orderShipped + `]}`))
+		case "/templates/tpl_9":
+			w.Write([]byte(`{"id":"tpl_9","name":"welcome","status":"published","variables":[{"key":"first_name","type":"string"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	n := New(sendly.NewClient("test-api-key", sendly.WithBaseURL(server.URL)), nil)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if id, err := n.templateID(ctx, "order_shipped"); err != nil || id != "tpl_1" {
+				t.Errorf("expected tpl_1, got %q (err %v)", id, err)
+			}
+		}()
+	}
+
+	for {
+		mu.Lock()
+		started := lists == 1
+		mu.Unlock()
+		if started {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	// Other lookups are not blocked by the listing in progress.
+	if _, err := n.templateVariables(ctx, "tpl_9"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	close(release)
+	wg.Wait()
+
+	for i := 0; i < 3; i++ {
+		if _, err := n.templateID(ctx, "missing"); !errors.Is(err, ErrUnknownNotification) {
+			t.Errorf("expected ErrUnknownNotification, got %v", err)
+		}
+	}
+	if lists != 2 {
+		t.Errorf("expected one listing for the concurrent lookups and one for the unknown name, got %d", lists)
+	}
+}
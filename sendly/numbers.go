@@ -0,0 +1,281 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// NumbersService manages owned phone numbers and alphanumeric sender IDs.
+type NumbersService struct {
+	client *Client
+}
+
+// NumberCapability is a channel a number can be used for.
+type NumberCapability string
+
+const (
+	NumberCapabilitySMS   NumberCapability = "sms"
+	NumberCapabilityMMS   NumberCapability = "mms"
+	NumberCapabilityVoice NumberCapability = "voice"
+)
+
+// NumberType classifies a phone number.
+type NumberType string
+
+const (
+	NumberTypeLocal     NumberType = "local"
+	NumberTypeMobile    NumberType = "mobile"
+	NumberTypeTollFree  NumberType = "toll_free"
+	NumberTypeShortCode NumberType = "short_code"
+)
+
+// PhoneNumber is a number owned by the account.
+type PhoneNumber struct {
+	ID           string             `json:"id"`
+	Number       string             `json:"number"`
+	Country      string             `json:"country"`
+	Type         NumberType         `json:"type"`
+	Capabilities []NumberCapability `json:"capabilities"`
+	FriendlyName string             `json:"friendly_name,omitempty"`
+	// Routing controls where inbound messages to this number are delivered.
+	Routing          *InboundRouting  `json:"routing,omitempty"`
+	RegulatoryStatus RegulatoryStatus `json:"regulatory_status"`
+	// MonthlyCost is the recurring rental cost in credits.
+	MonthlyCost int    `json:"monthly_cost"`
+	PurchasedAt string `json:"purchased_at"`
+}
+
+// InboundRouting controls where inbound messages to a number are delivered.
+// Set at most one of WebhookID or WebhookURL; leave both empty to deliver
+// to the account's webhooks subscribed to message.received.
+type InboundRouting struct {
+	// WebhookID routes inbound messages to an existing webhook only.
+	WebhookID string `json:"webhook_id,omitempty"`
+	// WebhookURL routes inbound messages to a URL, signed with the account
+	// webhook secret.
+	WebhookURL string `json:"webhook_url,omitempty"`
+}
+
+// SenderID is an alphanumeric sender ID registered to the account.
+type SenderID struct {
+	ID string `json:"id"`
+	// Value is the sender ID shown to recipients, e.g. "ACME".
+	Value string `json:"value"`
+	// Countries lists where the sender ID is registered (ISO 3166-1 alpha-2).
+	Countries []string         `json:"countries"`
+	Status    RegulatoryStatus `json:"status"`
+	CreatedAt string           `json:"created_at"`
+}
+
+// AvailableNumber is a number that can be purchased.
+type AvailableNumber struct {
+	Number       string             `json:"number"`
+	Country      string             `json:"country"`
+	Region       string             `json:"region,omitempty"`
+	Type         NumberType         `json:"type"`
+	Capabilities []NumberCapability `json:"capabilities"`
+	MonthlyCost  int                `json:"monthly_cost"`
+	SetupCost    int                `json:"setup_cost"`
+}
+
+// NumberListOptions are options for listing owned numbers.
+type NumberListOptions struct {
+	// Limit is the maximum number of results per page (default: 50, max: 200).
+	Limit int
+	// Cursor continues from a previous page's NextCursor.
+	Cursor string
+	// Country filters by ISO 3166-1 alpha-2 country code.
+	Country string
+	// Capability filters to numbers with this capability.
+	Capability NumberCapability
+}
+
+// NumberListResponse is a page of owned numbers.
+type NumberListResponse struct {
+	Data       []PhoneNumber `json:"data"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+	HasMore    bool          `json:"has_more"`
+}
+
+// SenderIDListResponse is the list of registered sender IDs.
+type SenderIDListResponse struct {
+	Data []SenderID `json:"data"`
+}
+
+// SearchNumbersOptions are options for searching numbers to purchase.
+type SearchNumbersOptions struct {
+	// Country is the ISO 3166-1 alpha-2 country code (required).
+	Country string
+	Type    NumberType
+	// Capabilities restricts results to numbers with all of these.
+	Capabilities []NumberCapability
+	// Contains matches numbers containing this digit sequence.
+	Contains string
+	// Limit is the maximum number of results (default: 20, max: 50).
+	Limit int
+}
+
+// SearchNumbersResponse lists numbers available for purchase.
+type SearchNumbersResponse struct {
+	Data []AvailableNumber `json:"data"`
+}
+
+// PurchaseNumberRequest represents the parameters for purchasing a number.
+type PurchaseNumberRequest struct {
+	// Number is an AvailableNumber.Number from Search (required).
+	Number       string          `json:"number"`
+	FriendlyName string          `json:"friendly_name,omitempty"`
+	Routing      *InboundRouting `json:"routing,omitempty"`
+}
+
+// List retrieves a page of owned numbers.
+func (s *NumbersService) List(ctx context.Context, opts *NumberListOptions) (*NumberListResponse, error) {
+	params := make(map[string]string)
+	if opts != nil {
+		if opts.Limit > 0 {
+			params["limit"] = strconv.Itoa(opts.Limit)
+		}
+		params["cursor"] = opts.Cursor
+		params["country"] = opts.Country
+		params["capability"] = string(opts.Capability)
+	}
+
+	var resp NumberListResponse
+	if err := s.client.request(ctx, "GET", "/numbers"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAll returns every owned number matching opts as an Iter, fetching
+// pages as needed.
+func (s *NumbersService) ListAll(ctx context.Context, opts *NumberListOptions) Iter[PhoneNumber] {
+	var o NumberListOptions
+	if opts != nil {
+		o = *opts
+	}
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[PhoneNumber], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[PhoneNumber]{Items: resp.Data}
+		if resp.HasMore {
+			page.NextCursor = resp.NextCursor
+		}
+		return page, nil
+	}).Iter()
+}
+
+// Get retrieves an owned number by ID.
+func (s *NumbersService) Get(ctx context.Context, id string) (*PhoneNumber, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "number ID is required")
+	}
+
+	var resp PhoneNumber
+	if err := s.client.request(ctx, "GET", "/numbers/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListSenderIDs retrieves the account's alphanumeric sender IDs.
+func (s *NumbersService) ListSenderIDs(ctx context.Context) (*SenderIDListResponse, error) {
+	var resp SenderIDListResponse
+	if err := s.client.request(ctx, "GET", "/sender-ids", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Search finds numbers available for purchase.
+func (s *NumbersService) Search(ctx context.Context, opts *SearchNumbersOptions) (*SearchNumbersResponse, error) {
+	if opts == nil || opts.Country == "" {
+		return nil, invalidParamError("country", "country is required")
+	}
+
+	capabilities := make([]string, len(opts.Capabilities))
+	for i, c := range opts.Capabilities {
+		capabilities[i] = string(c)
+	}
+	params := map[string]string{
+		"country":      opts.Country,
+		"type":         string(opts.Type),
+		"capabilities": strings.Join(capabilities, ","),
+		"contains":     opts.Contains,
+	}
+	if opts.Limit > 0 {
+		params["limit"] = strconv.Itoa(opts.Limit)
+	}
+
+	var resp SearchNumbersResponse
+	if err := s.client.request(ctx, "GET", "/numbers/available"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Purchase buys an available number. Setup and monthly costs are charged
+// in credits.
+func (s *NumbersService) Purchase(ctx context.Context, req *PurchaseNumberRequest) (*PhoneNumber, error) {
+	if req == nil || req.Number == "" {
+		return nil, invalidParamError("number", "number is required")
+	}
+	if err := req.Routing.validate(); err != nil {
+		return nil, err
+	}
+
+	var resp PhoneNumber
+	if err := s.client.request(ctx, "POST", "/numbers", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Release releases an owned number. Released numbers stop receiving
+// messages immediately and may not be recoverable.
+func (s *NumbersService) Release(ctx context.Context, id string) error {
+	if id == "" {
+		return invalidParamError("id", "number ID is required")
+	}
+	return s.client.request(ctx, "DELETE", "/numbers/"+url.PathEscape(id), nil, nil)
+}
+
+// SetInboundRouting configures where inbound messages to a number are
+// delivered. Pass an empty InboundRouting to restore the account default.
+func (s *NumbersService) SetInboundRouting(ctx context.Context, id string, routing *InboundRouting) (*PhoneNumber, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "number ID is required")
+	}
+	if routing == nil {
+		routing = &InboundRouting{}
+	}
+	if err := routing.validate(); err != nil {
+		return nil, err
+	}
+
+	var resp PhoneNumber
+	if err := s.client.request(ctx, "PUT", "/numbers/"+url.PathEscape(id)+"/routing", routing, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (r *InboundRouting) validate() error {
+	if r == nil {
+		return nil
+	}
+	if r.WebhookID != "" && r.WebhookURL != "" {
+		return invalidParamError("routing", "webhook ID and webhook URL are mutually exclusive")
+	}
+	if r.WebhookID != "" && !strings.HasPrefix(r.WebhookID, "whk_") {
+		return invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+	return nil
+}
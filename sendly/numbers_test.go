@@ -0,0 +1,64 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNumbersService_Search(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("country") != "US" || q.Get("capabilities") != "sms,mms" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"number":"+15551230000","country":"US","type":"local","capabilities":["sms","mms"],"monthly_cost":100}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	resp, err := client.Numbers.Search(context.Background(), &SearchNumbersOptions{
+		Country:      "US",
+		Capabilities: []NumberCapability{NumberCapabilitySMS, NumberCapabilityMMS},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].MonthlyCost != 100 {
+		t.Errorf("unexpected results: %+v", resp.Data)
+	}
+}
+
+func TestNumbersService_SetInboundRouting(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/numbers/num_1/routing" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body InboundRouting
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.WebhookID != "whk_1" {
+			t.Errorf("expected webhook_id to be 'whk_1', got '%s'", body.WebhookID)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"num_1","number":"+15551230000","routing":{"webhook_id":"whk_1"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+	number, err := client.Numbers.SetInboundRouting(ctx, "num_1", &InboundRouting{WebhookID: "whk_1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if number.Routing == nil || number.Routing.WebhookID != "whk_1" {
+		t.Errorf("unexpected routing: %+v", number.Routing)
+	}
+
+	both := &InboundRouting{WebhookID: "whk_1", WebhookURL: "https://example.com/inbound"}
+	if _, err := client.Numbers.SetInboundRouting(ctx, "num_1", both); !IsValidationError(err) {
+		t.Errorf("expected validation error for conflicting routing, got %v", err)
+	}
+}
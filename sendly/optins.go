@@ -0,0 +1,123 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// OptInsService provides managed double opt-in flows.
+type OptInsService struct {
+	client *Client
+}
+
+// OptInStatus represents the state of a double opt-in flow.
+type OptInStatus string
+
+const (
+	// OptInStatusPending means the invitation was sent and no reply has been received.
+	OptInStatusPending OptInStatus = "pending"
+	// OptInStatusConfirmed means the recipient replied with a confirmation keyword.
+	OptInStatusConfirmed OptInStatus = "confirmed"
+	// OptInStatusDeclined means the recipient replied with a decline keyword.
+	OptInStatusDeclined OptInStatus = "declined"
+	// OptInStatusExpired means no reply was received before the flow expired.
+	OptInStatusExpired OptInStatus = "expired"
+	// OptInStatusCancelled means the flow was cancelled.
+	OptInStatusCancelled OptInStatus = "cancelled"
+)
+
+// IsTerminal reports whether the opt-in flow has ended.
+func (s OptInStatus) IsTerminal() bool {
+	switch s {
+	case OptInStatusConfirmed, OptInStatusDeclined, OptInStatusExpired, OptInStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// StartOptInRequest represents the parameters for starting a double opt-in flow.
+type StartOptInRequest struct {
+	// To is the recipient phone number in E.164 format (required).
+	To Phone `json:"to"`
+	// InvitationTemplateID is the template sent to request consent (required).
+	InvitationTemplateID string `json:"invitation_template_id"`
+	// ConfirmationTemplateID is the template sent after the recipient confirms (optional).
+	ConfirmationTemplateID string `json:"confirmation_template_id,omitempty"`
+	// Variables are substituted into both templates.
+	Variables map[string]string `json:"variables,omitempty"`
+	// ConfirmKeywords are the replies that grant consent (default: YES, Y).
+	ConfirmKeywords []string `json:"confirm_keywords,omitempty"`
+	// DeclineKeywords are the replies that refuse consent (default: NO, STOP).
+	DeclineKeywords []string `json:"decline_keywords,omitempty"`
+	// ExpiresIn is how long to wait for a reply (default: 72 hours). It is
+	// sent in whole seconds.
+	ExpiresIn time.Duration `json:"-"`
+	// Metadata is custom metadata echoed in contact.opted_in events.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// OptIn represents a double opt-in flow.
+type OptIn struct {
+	ID                     string                 `json:"id"`
+	Phone                  string                 `json:"phone"`
+	Status                 OptInStatus            `json:"status"`
+	InvitationTemplateID   string                 `json:"invitation_template_id"`
+	ConfirmationTemplateID string                 `json:"confirmation_template_id,omitempty"`
+	InvitationMessageID    string                 `json:"invitation_message_id,omitempty"`
+	ReplyKeyword           string                 `json:"reply_keyword,omitempty"`
+	ConsentRecordedAt      string                 `json:"consent_recorded_at,omitempty"`
+	Metadata               map[string]interface{} `json:"metadata,omitempty"`
+	ExpiresAt              string                 `json:"expires_at"`
+	CreatedAt              string                 `json:"created_at"`
+}
+
+// MetadataMap returns the opt-in's custom metadata.
+func (o OptIn) MetadataMap() map[string]interface{} { return o.Metadata }
+
+// Start sends the invitation and begins waiting for a keyword reply. When the
+// recipient confirms, consent is recorded and a contact.opted_in event is emitted.
+func (s *OptInsService) Start(ctx context.Context, req *StartOptInRequest) (*OptIn, error) {
+	if req == nil {
+		return nil, &ValidationError{APIError: APIError{Message: "request is required"}}
+	}
+	if req.To == "" {
+		return nil, &ValidationError{APIError: APIError{Message: "to is required"}}
+	}
+	if req.InvitationTemplateID == "" {
+		return nil, &ValidationError{APIError: APIError{Message: "invitation template ID is required"}}
+	}
+
+	var resp OptIn
+	if err := s.client.request(ctx, "POST", "/opt-ins", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get retrieves a double opt-in flow by ID.
+func (s *OptInsService) Get(ctx context.Context, id string) (*OptIn, error) {
+	if id == "" {
+		return nil, &ValidationError{APIError: APIError{Message: "opt-in ID is required"}}
+	}
+
+	var resp OptIn
+	if err := s.client.request(ctx, "GET", "/opt-ins/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Cancel cancels a pending double opt-in flow.
+func (s *OptInsService) Cancel(ctx context.Context, id string) (*OptIn, error) {
+	if id == "" {
+		return nil, &ValidationError{APIError: APIError{Message: "opt-in ID is required"}}
+	}
+
+	var resp OptIn
+	if err := s.client.request(ctx, "DELETE", "/opt-ins/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,111 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestOptInsService_Start(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/opt-ins" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["to"] != "+15551234567" || body["invitation_template_id"] != "tpl_invite" {
+			t.Errorf("unexpected body: %v", body)
+		}
+		if body["expires_in_secs"] != float64(86400) {
+			t.Errorf("expected expires_in_secs to be 86400, got %v", body["expires_in_secs"])
+		}
+		if _, ok := body["confirmation_template_id"]; ok {
+			t.Error("expected unset confirmation_template_id to be omitted")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"opt_1","phone":"+15551234567","status":"pending","invitation_template_id":"tpl_invite","invitation_message_id":"msg_1","metadata":{"source":"checkout"},"expires_at":"2025-01-02T00:00:00Z","created_at":"2025-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	optIn, err := client.OptIns.Start(context.Background(), &StartOptInRequest{
+		To:                   "+15551234567",
+		InvitationTemplateID: "tpl_invite",
+		ConfirmKeywords:      []string{"YES"},
+		ExpiresIn:            24 * time.Hour,
+		Metadata:             map[string]interface{}{"source": "checkout"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if optIn.ID != "opt_1" || optIn.Status != OptInStatusPending || optIn.InvitationMessageID != "msg_1" {
+		t.Errorf("unexpected opt-in: %+v", optIn)
+	}
+	if optIn.Status.IsTerminal() {
+		t.Error("expected pending opt-in not to be terminal")
+	}
+	if optIn.MetadataMap()["source"] != "checkout" {
+		t.Errorf("unexpected metadata: %v", optIn.MetadataMap())
+	}
+}
+
+func TestOptInsService_GetAndCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/opt-ins/opt_1" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			w.Write([]byte(`{"id":"opt_1","phone":"+15551234567","status":"confirmed","reply_keyword":"YES","consent_recorded_at":"2025-01-01T00:05:00Z"}`))
+		case "DELETE":
+			w.Write([]byte(`{"id":"opt_1","phone":"+15551234567","status":"cancelled"}`))
+		default:
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	optIn, err := client.OptIns.Get(ctx, "opt_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if optIn.Status != OptInStatusConfirmed || optIn.ReplyKeyword != "YES" || !optIn.Status.IsTerminal() {
+		t.Errorf("unexpected opt-in: %+v", optIn)
+	}
+
+	cancelled, err := client.OptIns.Cancel(ctx, "opt_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cancelled.Status != OptInStatusCancelled {
+		t.Errorf("expected status to be 'cancelled', got '%s'", cancelled.Status)
+	}
+}
+
+func TestOptInsService_Validation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	for _, req := range []*StartOptInRequest{
+		nil,
+		{InvitationTemplateID: "tpl_invite"},
+		{To: "+15551234567"},
+	} {
+		if _, err := client.OptIns.Start(ctx, req); !IsValidationError(err) {
+			t.Errorf("%+v: expected validation error, got %v", req, err)
+		}
+	}
+	if _, err := client.OptIns.Get(ctx, ""); !IsValidationError(err) {
+		t.Errorf("Get: expected validation error, got %v", err)
+	}
+	if _, err := client.OptIns.Cancel(ctx, ""); !IsValidationError(err) {
+		t.Errorf("Cancel: expected validation error, got %v", err)
+	}
+}
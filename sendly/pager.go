@@ -0,0 +1,132 @@
+package sendly
+
+import (
+	"context"
+	"strconv"
+)
+
+// Page is a single page of results from a paginated list endpoint.
+type Page[T any] struct {
+	// Items contains the results on this page.
+	Items []T
+	// NextCursor is passed to the next fetch. Empty on the last page.
+	NextCursor string
+}
+
+// PageFunc fetches the page that starts at cursor. The first call receives
+// an empty cursor.
+type PageFunc[T any] func(ctx context.Context, cursor string) (*Page[T], error)
+
+// Iter is a sequence of list results. It has the same shape as
+// iter.Seq2[T, error], so on Go 1.23+ it can be used directly with range:
+//
+//	for v, err := range client.Verify.ListAll(ctx, nil) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Println(v.ID)
+//	}
+//
+// On older Go versions call it with a yield function, or use Collect.
+// Iteration stops after the first error.
+type Iter[T any] func(yield func(T, error) bool)
+
+// Collect drains the iterator into a slice, returning the items read before
+// any error.
+func (it Iter[T]) Collect() ([]T, error) {
+	var items []T
+	var err error
+	it(func(v T, e error) bool {
+		if e != nil {
+			err = e
+			return false
+		}
+		items = append(items, v)
+		return true
+	})
+	return items, err
+}
+
+// Pager walks a paginated list endpoint one item at a time, fetching pages
+// as needed.
+//
+// Example:
+//
+//	p := sendly.NewPager(ctx, fetch)
+//	for p.Next() {
+//	    fmt.Println(p.Current())
+//	}
+//	if err := p.Err(); err != nil {
+//	    log.Fatal(err)
+//	}
+type Pager[T any] struct {
+	ctx     context.Context
+	fetch   PageFunc[T]
+	cursor  string
+	page    []T
+	index   int
+	current T
+	done    bool
+	err     error
+}
+
+// NewPager creates a Pager that reads pages from fetch.
+func NewPager[T any](ctx context.Context, fetch PageFunc[T]) *Pager[T] {
+	return &Pager[T]{ctx: ctx, fetch: fetch}
+}
+
+// Next advances to the next item. It returns false when there are no more
+// items or an error occurred.
+func (p *Pager[T]) Next() bool {
+	for p.index >= len(p.page) {
+		if p.done || p.err != nil {
+			return false
+		}
+		resp, err := p.fetch(p.ctx, p.cursor)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.page, p.index = resp.Items, 0
+		p.cursor = resp.NextCursor
+		p.done = resp.NextCursor == "" || len(resp.Items) == 0
+	}
+	p.current = p.page[p.index]
+	p.index++
+	return true
+}
+
+// Current returns the current item.
+func (p *Pager[T]) Current() T {
+	return p.current
+}
+
+// Err returns the error that stopped iteration, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Iter returns the remaining items as an Iter.
+func (p *Pager[T]) Iter() Iter[T] {
+	return func(yield func(T, error) bool) {
+		for p.Next() {
+			if !yield(p.Current(), nil) {
+				return
+			}
+		}
+		if p.err != nil {
+			var zero T
+			yield(zero, p.err)
+		}
+	}
+}
+
+// offsetPage builds a Page for offset-paginated endpoints that report a
+// total count, encoding the next offset as the cursor.
+func offsetPage[T any](items []T, offset, count int) *Page[T] {
+	page := &Page[T]{Items: items}
+	if next := offset + len(items); len(items) > 0 && next < count {
+		page.NextCursor = strconv.Itoa(next)
+	}
+	return page
+}
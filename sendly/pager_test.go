@@ -0,0 +1,108 @@
+package sendly
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func pagesOf(pages ...[]int) PageFunc[int] {
+	return func(ctx context.Context, cursor string) (*Page[int], error) {
+		i := 0
+		if cursor != "" {
+			i, _ = strconv.Atoi(cursor)
+		}
+		page := &Page[int]{Items: pages[i]}
+		if i+1 < len(pages) {
+			page.NextCursor = strconv.Itoa(i + 1)
+		}
+		return page, nil
+	}
+}
+
+func TestPager_WalksAllPages(t *testing.T) {
+	p := NewPager(context.Background(), pagesOf([]int{1, 2}, []int{3}, []int{4, 5}))
+
+	var got []int
+	for p.Next() {
+		got = append(got, p.Current())
+	}
+	if p.Err() != nil {
+		t.Fatalf("unexpected error: %v", p.Err())
+	}
+	if len(got) != 5 || got[4] != 5 {
+		t.Errorf("expected [1 2 3 4 5], got %v", got)
+	}
+}
+
+func TestIter_StopsEarly(t *testing.T) {
+	fetches := 0
+	fetch := pagesOf([]int{1, 2}, []int{3, 4})
+	it := NewPager(context.Background(), func(ctx context.Context, cursor string) (*Page[int], error) {
+		fetches++
+		return fetch(ctx, cursor)
+	}).Iter()
+
+	var got []int
+	it(func(v int, err error) bool {
+		got = append(got, v)
+		return len(got) < 2
+	})
+	if len(got) != 2 {
+		t.Errorf("expected 2 items, got %v", got)
+	}
+	if fetches != 1 {
+		t.Errorf("expected 1 fetch, got %d", fetches)
+	}
+}
+
+func TestIter_CollectReturnsError(t *testing.T) {
+	boom := errors.New("boom")
+	calls := 0
+	it := NewPager(context.Background(), func(ctx context.Context, cursor string) (*Page[int], error) {
+		calls++
+		if calls == 2 {
+			return nil, boom
+		}
+		return &Page[int]{Items: []int{1, 2}, NextCursor: "next"}, nil
+	}).Iter()
+
+	items, err := it.Collect()
+	if !errors.Is(err, boom) {
+		t.Errorf("expected boom error, got %v", err)
+	}
+	if len(items) != 2 {
+		t.Errorf("expected 2 items before error, got %v", items)
+	}
+}
+
+func TestMessagesService_ListAll(t *testing.T) {
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset := r.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+
+		w.Header().Set("Content-Type", "application/json")
+		if offset == "" {
+			w.Write([]byte(`{"data":[{"id":"msg_1"},{"id":"msg_2"}],"count":3}`))
+			return
+		}
+		w.Write([]byte(`{"data":[{"id":"msg_3"}],"count":3}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	messages, err := client.Messages.ListAll(context.Background(), &ListMessagesRequest{Limit: 2}).Collect()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(messages) != 3 || messages[2].ID != "msg_3" {
+		t.Errorf("expected 3 messages ending in msg_3, got %v", messages)
+	}
+	if len(offsets) != 2 || offsets[1] != "2" {
+		t.Errorf("expected second request at offset '2', got %v", offsets)
+	}
+}
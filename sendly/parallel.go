@@ -0,0 +1,96 @@
+package sendly
+
+import (
+	"context"
+	"strconv"
+	"sync"
+)
+
+// ParallelResult is the outcome of processing a single item with Parallel.
+type ParallelResult[R any] struct {
+	// Index is the position of the item in the input slice.
+	Index int
+	// Value is the value returned by the callback.
+	Value R
+	// Err is the error returned by the callback, or the context error if the
+	// item was never started.
+	Err error
+}
+
+// Parallel calls fn for each item using at most concurrency goroutines and
+// returns the results in input order. Calls made through a Client inside fn
+// share the client's rate limiter, so a concurrency of
+// client.RecommendedConcurrency() keeps fan-out within the account rate limit.
+// A concurrency below 1 is treated as 1.
+//
+// Once ctx is done, items that have not started are skipped and their Err is
+// set to ctx.Err().
+//
+// If ctx carries an idempotency key, each item's call gets its own key
+// derived from it and the item index ("<key>-<index>"), so fan-out never
+// sends one key with different request bodies, and rerunning the same
+// items with the same key is still idempotent.
+//
+// Example:
+//
+//	results := sendly.Parallel(ctx, client.RecommendedConcurrency(), reqs,
+//	    func(ctx context.Context, req *sendly.SendMessageRequest) (*sendly.Message, error) {
+//	        return client.Messages.Send(ctx, req)
+//	    })
+func Parallel[T, R any](ctx context.Context, concurrency int, items []T, fn func(context.Context, T) (R, error)) []ParallelResult[R] {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]ParallelResult[R], len(items))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, item := range items {
+		results[i].Index = i
+
+		if ctx.Err() != nil {
+			results[i].Err = ctx.Err()
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, item T) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i].Value, results[i].Err = fn(itemContext(ctx, i), item)
+		}(i, item)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// itemContext derives the per-item idempotency key for item i.
+func itemContext(ctx context.Context, i int) context.Context {
+	if key := idempotencyKeyFromContext(ctx); key != "" {
+		return WithIdempotencyKey(ctx, key+"-"+strconv.Itoa(i))
+	}
+	return ctx
+}
+
+// RecommendedConcurrency returns the number of concurrent calls that the
+// client's rate limiter can admit at once.
+func (c *Client) RecommendedConcurrency() int {
+	if burst := c.rateLimiter.Burst(); burst > 0 {
+		return burst
+	}
+	return 1
+}
+
+// SendAll sends messages concurrently within the client's rate limit and
+// returns one result per request, in input order.
+func (s *MessagesService) SendAll(ctx context.Context, reqs []*SendMessageRequest) []ParallelResult[*Message] {
+	return Parallel(ctx, s.client.RecommendedConcurrency(), reqs, s.Send)
+}
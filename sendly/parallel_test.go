@@ -0,0 +1,131 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallel_OrderAndConcurrency(t *testing.T) {
+	var active, peak int32
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	results := Parallel(context.Background(), 3, items, func(ctx context.Context, n int) (int, error) {
+		cur := atomic.AddInt32(&active, 1)
+		for {
+			old := atomic.LoadInt32(&peak)
+			if cur <= old || atomic.CompareAndSwapInt32(&peak, old, cur) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&active, -1)
+		if n == 4 {
+			return 0, errors.New("boom")
+		}
+		return n * n, nil
+	})
+
+	if peak > 3 {
+		t.Errorf("expected at most 3 concurrent calls, got %d", peak)
+	}
+	for i, r := range results {
+		if r.Index != i {
+			t.Errorf("expected Index %d, got %d", i, r.Index)
+		}
+		if items[i] == 4 {
+			if r.Err == nil {
+				t.Error("expected error for item 4")
+			}
+			continue
+		}
+		if r.Err != nil || r.Value != items[i]*items[i] {
+			t.Errorf("item %d: expected %d, got %d (%v)", i, items[i]*items[i], r.Value, r.Err)
+		}
+	}
+}
+
+func TestParallel_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := Parallel(ctx, 2, []string{"a", "b"}, func(ctx context.Context, s string) (string, error) {
+		t.Error("should not call fn after cancellation")
+		return s, nil
+	})
+
+	for _, r := range results {
+		if !errors.Is(r.Err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", r.Err)
+		}
+	}
+}
+
+func TestMessagesSendAll(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		var req SendMessageRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		json.NewEncoder(w).Encode(Message{ID: "msg_" + string(req.To), To: string(req.To), Status: MessageStatusQueued})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+
+	reqs := []*SendMessageRequest{
+		{To: "+1", Text: "a"},
+		{To: "+2", Text: "b"},
+		{To: "", Text: "invalid"},
+	}
+	results := client.Messages.SendAll(context.Background(), reqs)
+
+	if calls != 2 {
+		t.Errorf("expected 2 API calls, got %d", calls)
+	}
+	if results[0].Value.ID != "msg_+1" || results[1].Value.ID != "msg_+2" {
+		t.Errorf("unexpected results: %+v", results)
+	}
+	if !IsValidationError(results[2].Err) {
+		t.Errorf("expected ValidationError for invalid request, got %v", results[2].Err)
+	}
+}
+
+func TestMessagesSendAll_IdempotencyKeys(t *testing.T) {
+	var mu sync.Mutex
+	keys := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/messages" {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(APIError{Code: "NOT_FOUND", Message: "Not found"})
+			return
+		}
+		var req SendMessageRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		mu.Lock()
+		keys[string(req.To)] = r.Header.Get("Idempotency-Key")
+		mu.Unlock()
+		json.NewEncoder(w).Encode(Message{ID: "msg_" + string(req.To), To: string(req.To), Status: MessageStatusQueued})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := WithIdempotencyKey(context.Background(), "batch-7")
+	client.Messages.SendAll(ctx, []*SendMessageRequest{{To: "+1", Text: "a"}, {To: "+2", Text: "b"}})
+	if keys["+1"] != "batch-7-0" || keys["+2"] != "batch-7-1" {
+		t.Errorf("expected per-message keys, got %v", keys)
+	}
+
+	// The individual-send fallback of SendMany derives keys too.
+	keys = map[string]string{}
+	client.Messages.SendMany(ctx, []SendMessageRequest{{To: "+1", Text: "a", FailIfSuppressed: true}, {To: "+2", Text: "b", FailIfSuppressed: true}})
+	if keys["+1"] != "batch-7-0" || keys["+2"] != "batch-7-1" {
+		t.Errorf("expected per-message keys from SendMany, got %v", keys)
+	}
+}
@@ -0,0 +1,52 @@
+package sendly
+
+import "github.com/SendlyHQ/sendly-go/v3/sendly/phonenumber"
+
+// Phone is a recipient phone number in E.164 format. Build one from user
+// input with ParsePhone, which normalizes and validates it; E.164 string
+// constants such as TestNumberSuccess can be used directly.
+//
+// Converting a string with Phone(s) does not validate it. Such numbers are
+// only checked before sending when the client uses WithPhoneValidation, and
+// otherwise by the API.
+type Phone string
+
+// ParsePhone normalizes input to E.164 and returns it as a Phone, or a
+// *ValidationError if it cannot be a valid number. Numbers without a country
+// code are read as national numbers of defaultCountry (ISO 3166-1 alpha-2);
+// if it is empty they are rejected.
+//
+// Example:
+//
+//	to, err := sendly.ParsePhone(form.Get("phone"), "US")
+//	if err != nil {
+//	    return err
+//	}
+//	msg, err := client.Messages.Send(ctx, &sendly.SendMessageRequest{To: to, Text: "Hi"})
+func ParsePhone(input, defaultCountry string) (Phone, error) {
+	normalized, err := phonenumber.Normalize(input, defaultCountry)
+	if err != nil {
+		return "", invalidParamError("phone", err.Error())
+	}
+	return Phone(normalized), nil
+}
+
+// MustParsePhone is like ParsePhone but panics if input is invalid. It is
+// meant for constants and tests.
+func MustParsePhone(input, defaultCountry string) Phone {
+	p, err := ParsePhone(input, defaultCountry)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Valid reports whether p is a plausible E.164 number.
+func (p Phone) Valid() bool {
+	return phonenumber.IsValid(string(p))
+}
+
+// String returns the number in E.164 format.
+func (p Phone) String() string {
+	return string(p)
+}
@@ -0,0 +1,46 @@
+package sendly
+
+import (
+	"strconv"
+
+	"github.com/SendlyHQ/sendly-go/v3/sendly/phonenumber"
+)
+
+// normalizePhone returns phone in E.164 form when phone validation is
+// enabled, or phone unchanged when it isn't.
+func (c *Client) normalizePhone(param string, phone Phone) (Phone, error) {
+	if !c.ValidatePhones {
+		return phone, nil
+	}
+	normalized, err := phonenumber.Normalize(string(phone), c.DefaultCountry)
+	if err != nil {
+		return "", invalidParamError(param, err.Error())
+	}
+	return Phone(normalized), nil
+}
+
+// normalizeBatchPhones normalizes the recipient of every message in req.
+// The caller's request is left untouched; a copy is returned if any number
+// changed.
+func (c *Client) normalizeBatchPhones(req *SendBatchRequest) (*SendBatchRequest, error) {
+	var messages []BatchMessageItem
+	for i, msg := range req.Messages {
+		to, err := c.normalizePhone("messages["+strconv.Itoa(i)+"].to", msg.To)
+		if err != nil {
+			return nil, err
+		}
+		if to == msg.To {
+			continue
+		}
+		if messages == nil {
+			messages = append([]BatchMessageItem(nil), req.Messages...)
+		}
+		messages[i].To = to
+	}
+	if messages == nil {
+		return req, nil
+	}
+	normalized := *req
+	normalized.Messages = messages
+	return &normalized, nil
+}
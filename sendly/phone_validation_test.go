@@ -0,0 +1,150 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithPhoneValidation(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["to"] != "+15551234567" {
+			t.Errorf("expected to to be '+15551234567', got '%v'", body["to"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/messages":
+			w.Write([]byte(`{"id":"msg_1","to":"+15551234567","status":"queued"}`))
+		case "/verify":
+			w.Write([]byte(`{"id":"ver_1","status":"pending","phone":"+15551234567"}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithPhoneValidation("US"))
+	req := &SendMessageRequest{To: "+1 (555) 123-4567", Text: "Hello"}
+	if _, err := client.Messages.Send(context.Background(), req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.To != "+1 (555) 123-4567" {
+		t.Errorf("expected request not to be modified, got '%s'", req.To)
+	}
+	if _, err := client.Verify.Send(context.Background(), &SendVerificationRequest{To: "555.123.4567"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err := client.Messages.Send(context.Background(), &SendMessageRequest{To: "1-800-FLOWERS", Text: "Hello"})
+	if !IsValidationError(err) {
+		t.Errorf("expected validation error for invalid number, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 API calls, got %d", calls)
+	}
+}
+
+func TestParsePhone(t *testing.T) {
+	phone, err := ParsePhone("+1 (555) 123-4567", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if phone != "+15551234567" || !phone.Valid() {
+		t.Errorf("expected phone to be '+15551234567', got '%s'", phone)
+	}
+	if _, err := ParsePhone("not a number", "US"); !IsValidationError(err) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
+
+func TestWithPhoneValidation_SendMany(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		path string
+		reqs []SendMessageRequest
+	}{
+		{
+			name: "batch",
+			path: "/messages/batch",
+			reqs: []SendMessageRequest{{To: "+1 (555) 123-4567", Text: "a"}, {To: "555.123.4567", Text: "b"}},
+		},
+		{
+			name: "individual",
+			path: "/messages",
+			reqs: []SendMessageRequest{{To: "+1 (555) 123-4567", Text: "a"}, {To: "555.123.4567", Text: "b", DuplicateWindow: time.Minute}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var got []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					To       string `json:"to"`
+					Messages []struct {
+						To string `json:"to"`
+					} `json:"messages"`
+				}
+				json.NewDecoder(r.Body).Decode(&body)
+				mu.Lock()
+				if body.To != "" {
+					got = append(got, body.To)
+				}
+				for _, m := range body.Messages {
+					got = append(got, m.To)
+				}
+				mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/messages/batch":
+					w.Write([]byte(`{"batchId":"batch_1","messages":[{"messageId":"msg_1","to":"+15551234567","status":"queued"},{"messageId":"msg_2","to":"+15551234567","status":"queued"}]}`))
+				case "/messages":
+					w.Write([]byte(`{"id":"msg_1","to":"+15551234567","status":"queued"}`))
+				default:
+					t.Errorf("unexpected path for %s: %s", tc.path, r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			client := NewClient("test-api-key", WithBaseURL(server.URL), WithPhoneValidation("US"))
+			result, err := client.Messages.SendMany(context.Background(), tc.reqs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Failed != 0 {
+				t.Fatalf("expected no failures, got %+v", result.Results)
+			}
+			if len(got) != 2 {
+				t.Fatalf("expected 2 recipients to be sent, got %v", got)
+			}
+			for _, to := range got {
+				if to != "+15551234567" {
+					t.Errorf("expected to to be '+15551234567', got '%s'", to)
+				}
+			}
+			if tc.reqs[1].To != "555.123.4567" {
+				t.Errorf("expected request not to be modified, got '%s'", tc.reqs[1].To)
+			}
+		})
+	}
+}
+
+func TestWithPhoneValidation_SendBatchInvalid(t *testing.T) {
+	client := NewClient("test-api-key", WithPhoneValidation("US"))
+	_, err := client.Messages.SendBatch(context.Background(), &SendBatchRequest{Messages: []BatchMessageItem{
+		{To: "+15551234567", Text: "a"},
+		{To: "1-800-FLOWERS", Text: "b"},
+	}})
+	var ve *ValidationError
+	if !errors.As(err, &ve) || ve.Param != "messages[1].to" {
+		t.Errorf("expected validation error for messages[1].to, got %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+// Package phonenumber normalizes phone numbers to E.164 and rejects inputs
+// that cannot be dialable numbers. It is a cheap pre-flight check, not a
+// numbering plan database: a number that passes may still be unallocated.
+// Use the Lookup API for authoritative validation.
+//
+// Example:
+//
+//	phone, err := phonenumber.Normalize("+1 (555) 123-4567", "")
+//	// phone == "+15551234567"
+//
+//	phone, err = phonenumber.Normalize("020 7946 0958", "GB")
+//	// phone == "+442079460958"
+package phonenumber
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// MinDigits is the fewest digits, including the country code, accepted
+	// in a number.
+	MinDigits = 8
+	// MaxDigits is the most digits E.164 allows, including the country code.
+	MaxDigits = 15
+)
+
+var (
+	// ErrEmpty is returned for an empty input.
+	ErrEmpty = errors.New("phone number is empty")
+	// ErrInvalidCharacters is returned when the input contains letters or
+	// other characters that are not digits or formatting.
+	ErrInvalidCharacters = errors.New("phone number contains invalid characters")
+	// ErrMissingCountryCode is returned for a national number when no
+	// default country is given.
+	ErrMissingCountryCode = errors.New("phone number has no country code")
+	// ErrUnknownCountry is returned when the default country is not known.
+	ErrUnknownCountry = errors.New("unknown country")
+	// ErrTooShort is returned when the number has fewer than MinDigits digits.
+	ErrTooShort = errors.New("phone number is too short")
+	// ErrTooLong is returned when the number has more than MaxDigits digits.
+	ErrTooLong = errors.New("phone number is too long")
+	// ErrInvalidNumber is returned when the number breaks the rules of its
+	// numbering plan, for example a North American area code starting with 0.
+	ErrInvalidNumber = errors.New("phone number is not valid")
+)
+
+// callingCodes maps ISO 3166-1 alpha-2 countries to their calling codes.
+// Only countries whose national numbers can be given without a country
+// code need to be listed here.
+var callingCodes = map[string]string{
+	"US": "1", "CA": "1", "PR": "1",
+	"GB": "44", "IE": "353", "FR": "33", "DE": "49", "ES": "34", "IT": "39",
+	"PT": "351", "NL": "31", "BE": "32", "LU": "352", "CH": "41", "AT": "43",
+	"DK": "45", "SE": "46", "NO": "47", "FI": "358", "PL": "48", "CZ": "420",
+	"GR": "30", "RO": "40", "HU": "36", "TR": "90", "IL": "972", "AE": "971",
+	"SA": "966", "ZA": "27", "NG": "234", "KE": "254", "EG": "20", "IN": "91",
+	"PK": "92", "SG": "65", "MY": "60", "ID": "62", "PH": "63", "TH": "66",
+	"VN": "84", "CN": "86", "HK": "852", "JP": "81", "KR": "82", "AU": "61",
+	"NZ": "64", "BR": "55", "MX": "52", "AR": "54", "CL": "56", "CO": "57",
+	"PE": "51",
+}
+
+// trunkPrefixFree lists calling codes whose national numbers are not
+// written with a leading trunk "0".
+var trunkPrefixFree = map[string]bool{
+	"1": true, "34": true, "39": true, "351": true, "352": true, "45": true,
+	"47": true, "48": true, "420": true, "30": true, "65": true, "852": true,
+	"54": true, "56": true, "57": true, "52": true,
+}
+
+// Normalize converts a phone number to E.164 ("+" followed by digits).
+// Spaces, dashes, dots, slashes and parentheses are ignored, and a leading
+// "00" international prefix is treated like "+". A number without a country
+// code is interpreted as a national number of defaultCountry (an ISO 3166-1
+// alpha-2 code); its trunk prefix is dropped. If defaultCountry is empty such
+// numbers are rejected with ErrMissingCountryCode.
+//
+// Errors wrap one of the package's sentinel errors.
+func Normalize(input, defaultCountry string) (string, error) {
+	s := strings.TrimSpace(input)
+	if s == "" {
+		return "", ErrEmpty
+	}
+
+	international := false
+	var digits strings.Builder
+	for i, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '+' && i == 0:
+			international = true
+		case r == ' ' || r == '-' || r == '.' || r == '/' || r == '(' || r == ')':
+		default:
+			return "", fmt.Errorf("%w: %q", ErrInvalidCharacters, input)
+		}
+	}
+	number := digits.String()
+
+	if !international && strings.HasPrefix(number, "00") {
+		international = true
+		number = number[2:]
+	}
+
+	if !international {
+		if defaultCountry == "" {
+			return "", fmt.Errorf("%w: %q", ErrMissingCountryCode, input)
+		}
+		code, ok := callingCodes[strings.ToUpper(defaultCountry)]
+		if !ok {
+			return "", fmt.Errorf("%w: %q", ErrUnknownCountry, defaultCountry)
+		}
+		switch {
+		case code == "1" && len(number) == 11 && number[0] == '1':
+			number = number[1:]
+		case !trunkPrefixFree[code]:
+			number = strings.TrimPrefix(number, "0")
+		}
+		number = code + number
+	}
+
+	if err := check(number); err != nil {
+		return "", fmt.Errorf("%w: %q", err, input)
+	}
+	return "+" + number, nil
+}
+
+// IsValid reports whether input is already a plausible E.164 number.
+func IsValid(input string) bool {
+	if len(input) < 2 || input[0] != '+' {
+		return false
+	}
+	for _, r := range input[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return check(input[1:]) == nil
+}
+
+// check validates the digits of an E.164 number without the "+".
+func check(number string) error {
+	switch {
+	case len(number) < MinDigits:
+		return ErrTooShort
+	case len(number) > MaxDigits:
+		return ErrTooLong
+	case number[0] == '0':
+		return ErrInvalidNumber
+	}
+	// North American Numbering Plan: +1 followed by ten digits, with an
+	// area code starting with 2-9.
+	if number[0] == '1' {
+		if len(number) != 11 || number[1] < '2' {
+			return ErrInvalidNumber
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package phonenumber
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		input   string
+		country string
+		want    string
+		err     error
+	}{
+		{"+1 (555) 123-4567", "", "+15551234567", nil},
+		{"+15005550000", "", "+15005550000", nil},
+		{"0044 20 7946 0958", "", "+442079460958", nil},
+		{"(555) 123-4567", "US", "+15551234567", nil},
+		{"1-555-123-4567", "us", "+15551234567", nil},
+		{"020 7946 0958", "GB", "+442079460958", nil},
+		{"06 12 34 56 78", "FR", "+33612345678", nil},
+		{"", "", "", ErrEmpty},
+		{"555-123-4567", "", "", ErrMissingCountryCode},
+		{"555-123-4567", "XX", "", ErrUnknownCountry},
+		{"1-800-FLOWERS", "US", "", ErrInvalidCharacters},
+		{"+1 555 123 4567 ext 9", "", "", ErrInvalidCharacters},
+		{"+44 12", "", "", ErrTooShort},
+		{"+44 1234 5678 9012 345", "", "", ErrTooLong},
+		{"+1 055 123 4567", "", "", ErrInvalidNumber},
+		{"+1 555 123 456", "", "", ErrInvalidNumber},
+	}
+	for _, tt := range tests {
+		got, err := Normalize(tt.input, tt.country)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("Normalize(%q, %q): expected error %v, got %v", tt.input, tt.country, tt.err, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Normalize(%q, %q): expected '%s', got '%s'", tt.input, tt.country, tt.want, got)
+		}
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	if !IsValid("+15551234567") {
+		t.Error("expected +15551234567 to be valid")
+	}
+	for _, input := range []string{"15551234567", "+1 555 123 4567", "+0123456789", "+"} {
+		if IsValid(input) {
+			t.Errorf("expected %q to be invalid", input)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+)
+
+// QuotaName identifies an account limit.
+type QuotaName string
+
+const (
+	QuotaMessagesPerDay         QuotaName = "messages_per_day"
+	QuotaVerificationsPerMinute QuotaName = "verifications_per_minute"
+	QuotaWebhooks               QuotaName = "webhooks"
+)
+
+// Quota is one account limit and current usage against it.
+type Quota struct {
+	Name  QuotaName `json:"name"`
+	Limit int       `json:"limit"`
+	Used  int       `json:"used"`
+	// Window is the period the limit applies to ("day", "minute"), empty for
+	// count limits such as webhooks.
+	Window string `json:"window,omitempty"`
+	// ResetsAt is when Used next resets (ISO 8601), empty for count limits.
+	ResetsAt string `json:"resets_at,omitempty"`
+}
+
+// Remaining returns how much of the quota is left, never negative.
+func (q Quota) Remaining() int {
+	return max(q.Limit-q.Used, 0)
+}
+
+// QuotaList is the account's current quotas.
+type QuotaList struct {
+	Data []Quota `json:"data"`
+}
+
+// Get returns the quota with the given name, or nil if the account has none.
+func (l *QuotaList) Get(name QuotaName) *Quota {
+	for i := range l.Data {
+		if l.Data[i].Name == name {
+			return &l.Data[i]
+		}
+	}
+	return nil
+}
+
+// LimitIncreaseStatus is the review state of a limit increase request.
+type LimitIncreaseStatus string
+
+const (
+	LimitIncreasePending  LimitIncreaseStatus = "pending"
+	LimitIncreaseApproved LimitIncreaseStatus = "approved"
+	LimitIncreaseDenied   LimitIncreaseStatus = "denied"
+)
+
+// IsTerminal reports whether the request has been reviewed.
+func (s LimitIncreaseStatus) IsTerminal() bool {
+	switch s {
+	case LimitIncreaseApproved, LimitIncreaseDenied:
+		return true
+	default:
+		return false
+	}
+}
+
+// LimitIncreaseRequest represents the parameters for requesting a higher
+// quota.
+type LimitIncreaseRequest struct {
+	Quota QuotaName `json:"quota"`
+	// RequestedLimit is the new limit (required, above the current one).
+	RequestedLimit int `json:"requested_limit"`
+	// Justification explains the use case and expected volume (required).
+	Justification string `json:"justification"`
+}
+
+// LimitIncrease is a submitted limit increase request.
+type LimitIncrease struct {
+	ID             string              `json:"id"`
+	Quota          QuotaName           `json:"quota"`
+	CurrentLimit   int                 `json:"current_limit"`
+	RequestedLimit int                 `json:"requested_limit"`
+	Justification  string              `json:"justification"`
+	Status         LimitIncreaseStatus `json:"status"`
+	// GrantedLimit is set once approved; it may be lower than requested.
+	GrantedLimit int `json:"granted_limit,omitempty"`
+	// ReviewerNote explains a denial or partial approval.
+	ReviewerNote string `json:"reviewer_note,omitempty"`
+	CreatedAt    string `json:"created_at"`
+	ReviewedAt   string `json:"reviewed_at,omitempty"`
+}
+
+// LimitIncreaseListResponse is the list of limit increase requests.
+type LimitIncreaseListResponse struct {
+	Data []LimitIncrease `json:"data"`
+}
+
+// GetQuotas retrieves the account's limits and current usage.
+func (s *AccountService) GetQuotas(ctx context.Context) (*QuotaList, error) {
+	var resp QuotaList
+	if err := s.client.request(ctx, "GET", "/account/quotas", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RequestLimitIncrease submits a quota increase for review. Track it with
+// GetLimitIncrease using the returned ID.
+func (s *AccountService) RequestLimitIncrease(ctx context.Context, req *LimitIncreaseRequest) (*LimitIncrease, error) {
+	if req == nil || req.Quota == "" {
+		return nil, invalidParamError("quota", "quota is required")
+	}
+	if req.RequestedLimit <= 0 {
+		return nil, invalidParamError("requested_limit", "requested limit must be positive")
+	}
+	if req.Justification == "" {
+		return nil, invalidParamError("justification", "justification is required")
+	}
+
+	var resp LimitIncrease
+	if err := s.client.request(ctx, "POST", "/account/quotas/increase-requests", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetLimitIncrease retrieves a limit increase request by ID.
+func (s *AccountService) GetLimitIncrease(ctx context.Context, id string) (*LimitIncrease, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "request ID is required")
+	}
+
+	var resp LimitIncrease
+	if err := s.client.request(ctx, "GET", "/account/quotas/increase-requests/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListLimitIncreases retrieves the account's limit increase requests,
+// newest first.
+func (s *AccountService) ListLimitIncreases(ctx context.Context) (*LimitIncreaseListResponse, error) {
+	var resp LimitIncreaseListResponse
+	if err := s.client.request(ctx, "GET", "/account/quotas/increase-requests", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,57 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAccountService_Quotas(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/account/quotas":
+			w.Write([]byte(`{"data":[{"name":"messages_per_day","limit":1000,"used":1200,"window":"day"},{"name":"webhooks","limit":10,"used":3}]}`))
+		case r.Method == "POST" && r.URL.Path == "/account/quotas/increase-requests":
+			var req LimitIncreaseRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Quota != QuotaMessagesPerDay || req.RequestedLimit != 50000 {
+				t.Errorf("unexpected request: %+v", req)
+			}
+			w.Write([]byte(`{"id":"lir_1","quota":"messages_per_day","current_limit":1000,"requested_limit":50000,"status":"pending"}`))
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	quotas, err := client.Account.GetQuotas(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q := quotas.Get(QuotaMessagesPerDay); q == nil || q.Remaining() != 0 {
+		t.Errorf("expected exhausted messages quota, got %+v", q)
+	}
+	if q := quotas.Get(QuotaWebhooks); q == nil || q.Remaining() != 7 {
+		t.Errorf("expected 7 webhooks remaining, got %+v", q)
+	}
+
+	inc, err := client.Account.RequestLimitIncrease(context.Background(), &LimitIncreaseRequest{
+		Quota:          QuotaMessagesPerDay,
+		RequestedLimit: 50000,
+		Justification:  "Launching appointment reminders",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inc.ID != "lir_1" || inc.Status != LimitIncreasePending {
+		t.Errorf("unexpected increase request: %+v", inc)
+	}
+
+	if _, err := client.Account.RequestLimitIncrease(context.Background(), &LimitIncreaseRequest{Quota: QuotaWebhooks, RequestedLimit: 20}); !IsValidationError(err) {
+		t.Errorf("expected validation error for missing justification, got %v", err)
+	}
+}
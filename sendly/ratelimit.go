@@ -0,0 +1,87 @@
+package sendly
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimit describes the API rate limit window reported by the server in
+// X-RateLimit-Limit, X-RateLimit-Remaining and X-RateLimit-Reset headers.
+type RateLimit struct {
+	// Limit is the number of requests allowed per window.
+	Limit int
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+	// Reset is when the current window resets.
+	Reset time.Time
+}
+
+// ResetIn returns how long until the current window resets.
+func (r *RateLimit) ResetIn() time.Duration {
+	if d := time.Until(r.Reset); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// parseRateLimit reads rate limit headers from h. It returns nil if the
+// server did not report a limit.
+func parseRateLimit(h http.Header) *RateLimit {
+	limit, err := strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return nil
+	}
+	rl := &RateLimit{Limit: limit, Remaining: -1}
+	if n, err := strconv.Atoi(h.Get("X-RateLimit-Remaining")); err == nil {
+		rl.Remaining = n
+	}
+	if n, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		// Accept both a Unix timestamp and a number of seconds from now
+		if n > 1_000_000_000 {
+			rl.Reset = time.Unix(n, 0)
+		} else {
+			rl.Reset = time.Now().Add(time.Duration(n) * time.Second)
+		}
+	}
+	return rl
+}
+
+// rateLimitTracker remembers the most recent rate limit seen by a client.
+type rateLimitTracker struct {
+	mu   sync.Mutex
+	last *RateLimit
+}
+
+func (t *rateLimitTracker) get() *RateLimit {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.last == nil {
+		return nil
+	}
+	rl := *t.last
+	return &rl
+}
+
+func (t *rateLimitTracker) set(rl *RateLimit) {
+	if rl == nil {
+		return
+	}
+	t.mu.Lock()
+	t.last = rl
+	t.mu.Unlock()
+}
+
+// LastRateLimit returns the rate limit reported by the most recent response,
+// or nil if the server has not reported one yet. High-volume senders can use
+// it to slow down before hitting 429s.
+//
+// Example:
+//
+//	if rl := client.LastRateLimit(); rl != nil && rl.Remaining < 10 {
+//	    time.Sleep(rl.ResetIn())
+//	}
+func (c *Client) LastRateLimit() *RateLimit {
+	return c.rateLimit.get()
+}
@@ -0,0 +1,282 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ReportKind is the analytics report a subscription generates.
+type ReportKind string
+
+const (
+	ReportKindDeliverySummary ReportKind = "delivery_summary"
+	ReportKindDeliverySLA     ReportKind = "delivery_sla"
+	ReportKindNumberUsage     ReportKind = "number_usage"
+	ReportKindCosts           ReportKind = "costs"
+)
+
+// ReportFrequency is how often a subscription generates a report.
+type ReportFrequency string
+
+const (
+	ReportDaily   ReportFrequency = "daily"
+	ReportWeekly  ReportFrequency = "weekly"
+	ReportMonthly ReportFrequency = "monthly"
+)
+
+// ReportTargetType is where generated reports are delivered.
+type ReportTargetType string
+
+const (
+	ReportTargetEmail   ReportTargetType = "email"
+	ReportTargetS3      ReportTargetType = "s3"
+	ReportTargetGCS     ReportTargetType = "gcs"
+	ReportTargetWebhook ReportTargetType = "webhook"
+)
+
+// ReportTarget is the destination of a report subscription. Set the fields
+// matching Type.
+type ReportTarget struct {
+	Type ReportTargetType `json:"type"`
+	// Emails receive the report as an attachment (email targets).
+	Emails []string `json:"emails,omitempty"`
+	// Bucket, Prefix and Region locate the bucket (s3 and gcs targets).
+	Bucket string `json:"bucket,omitempty"`
+	Prefix string `json:"prefix,omitempty"`
+	Region string `json:"region,omitempty"`
+	// RoleARN, or AccessKeyID and SecretAccessKey, grant write access to
+	// an S3 bucket. See StorageDestination.
+	RoleARN         string `json:"role_arn,omitempty"`
+	AccessKeyID     string `json:"access_key_id,omitempty"`
+	SecretAccessKey string `json:"secret_access_key,omitempty"`
+	// ServiceAccountEmail or ServiceAccountKey grant write access to a GCS
+	// bucket.
+	ServiceAccountEmail string `json:"service_account_email,omitempty"`
+	ServiceAccountKey   string `json:"service_account_key,omitempty"`
+	// URL receives a signed report.generated event with a download URL
+	// (webhook targets).
+	URL string `json:"url,omitempty"`
+}
+
+func (t *ReportTarget) validate() error {
+	if t == nil {
+		return invalidParamError("target", "target is required")
+	}
+	switch t.Type {
+	case ReportTargetEmail:
+		if len(t.Emails) == 0 {
+			return invalidParamError("target.emails", "at least one email is required")
+		}
+	case ReportTargetS3, ReportTargetGCS:
+		// A presigned upload URL covers one object, so recurring reports
+		// need bucket credentials.
+		dest := StorageDestination{
+			Provider:            StorageProvider(t.Type),
+			Bucket:              t.Bucket,
+			RoleARN:             t.RoleARN,
+			AccessKeyID:         t.AccessKeyID,
+			SecretAccessKey:     t.SecretAccessKey,
+			ServiceAccountEmail: t.ServiceAccountEmail,
+			ServiceAccountKey:   t.ServiceAccountKey,
+		}
+		return dest.validate("target")
+	case ReportTargetWebhook:
+		if !strings.HasPrefix(t.URL, "https://") {
+			return invalidParamError("target.url", "webhook target URL must be HTTPS")
+		}
+	default:
+		return invalidParamError("target.type", "target type must be email, s3, gcs or webhook")
+	}
+	return nil
+}
+
+// ReportSubscription is a recurring analytics report.
+type ReportSubscription struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	Report    ReportKind      `json:"report"`
+	Frequency ReportFrequency `json:"frequency"`
+	// Format is the file format, currently always "csv".
+	Format string       `json:"format"`
+	Target ReportTarget `json:"target"`
+	// Timezone is the IANA time zone report periods are aligned to.
+	Timezone  string `json:"timezone"`
+	Paused    bool   `json:"paused"`
+	NextRunAt string `json:"next_run_at,omitempty"`
+	LastRunAt string `json:"last_run_at,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+// CreateReportSubscriptionRequest represents the parameters for scheduling
+// a recurring report.
+type CreateReportSubscriptionRequest struct {
+	Name      string          `json:"name,omitempty"`
+	Report    ReportKind      `json:"report"`
+	Frequency ReportFrequency `json:"frequency"`
+	Target    *ReportTarget   `json:"target"`
+	// Timezone aligns report periods to an IANA time zone (default: UTC).
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// UpdateReportSubscriptionRequest represents the parameters for updating a
+// report subscription. Nil fields are left unchanged.
+type UpdateReportSubscriptionRequest struct {
+	Name      *string          `json:"name,omitempty"`
+	Frequency *ReportFrequency `json:"frequency,omitempty"`
+	Target    *ReportTarget    `json:"target,omitempty"`
+	Timezone  *string          `json:"timezone,omitempty"`
+	Paused    *bool            `json:"paused,omitempty"`
+}
+
+// ReportSubscriptionListResponse is the list of report subscriptions.
+type ReportSubscriptionListResponse struct {
+	Data []ReportSubscription `json:"data"`
+}
+
+// ReportArtifact is a generated report file.
+type ReportArtifact struct {
+	ID             string     `json:"id"`
+	SubscriptionID string     `json:"subscription_id"`
+	Report         ReportKind `json:"report"`
+	PeriodStart    string     `json:"period_start"`
+	PeriodEnd      string     `json:"period_end"`
+	Format         string     `json:"format"`
+	SizeBytes      int64      `json:"size_bytes"`
+	// DownloadURL is a pre-signed URL valid until DownloadURLExpiresAt.
+	DownloadURL          string `json:"download_url"`
+	DownloadURLExpiresAt string `json:"download_url_expires_at"`
+	CreatedAt            string `json:"created_at"`
+}
+
+// ReportArtifactListOptions are options for listing generated reports.
+type ReportArtifactListOptions struct {
+	// Limit is the maximum number of artifacts per page (default: 50, max: 200).
+	Limit int
+	// Cursor continues from a previous page's NextCursor.
+	Cursor string
+	// SubscriptionID filters by subscription.
+	SubscriptionID string
+}
+
+// ReportArtifactListResponse is a page of generated reports.
+type ReportArtifactListResponse struct {
+	Data       []ReportArtifact `json:"data"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+	HasMore    bool             `json:"has_more"`
+}
+
+// CreateSubscription schedules a recurring report.
+func (s *ReportsService) CreateSubscription(ctx context.Context, req *CreateReportSubscriptionRequest) (*ReportSubscription, error) {
+	if req == nil || req.Report == "" {
+		return nil, invalidParamError("report", "report is required")
+	}
+	switch req.Frequency {
+	case ReportDaily, ReportWeekly, ReportMonthly:
+	default:
+		return nil, invalidParamError("frequency", "frequency must be daily, weekly or monthly")
+	}
+	if err := req.Target.validate(); err != nil {
+		return nil, err
+	}
+
+	var resp ReportSubscription
+	if err := s.client.request(ctx, "POST", "/reports/subscriptions", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListSubscriptions retrieves the account's report subscriptions.
+func (s *ReportsService) ListSubscriptions(ctx context.Context) (*ReportSubscriptionListResponse, error) {
+	var resp ReportSubscriptionListResponse
+	if err := s.client.request(ctx, "GET", "/reports/subscriptions", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetSubscription retrieves a report subscription by ID.
+func (s *ReportsService) GetSubscription(ctx context.Context, id string) (*ReportSubscription, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "subscription ID is required")
+	}
+
+	var resp ReportSubscription
+	if err := s.client.request(ctx, "GET", "/reports/subscriptions/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateSubscription changes a report subscription, including pausing it.
+func (s *ReportsService) UpdateSubscription(ctx context.Context, id string, req *UpdateReportSubscriptionRequest) (*ReportSubscription, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "subscription ID is required")
+	}
+	if req == nil {
+		return nil, invalidParamError("request", "request is required")
+	}
+	if req.Target != nil {
+		if err := req.Target.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	var resp ReportSubscription
+	if err := s.client.request(ctx, "PATCH", "/reports/subscriptions/"+url.PathEscape(id), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteSubscription stops a recurring report. Generated artifacts are kept
+// until they expire.
+func (s *ReportsService) DeleteSubscription(ctx context.Context, id string) error {
+	if id == "" {
+		return invalidParamError("id", "subscription ID is required")
+	}
+	return s.client.request(ctx, "DELETE", "/reports/subscriptions/"+url.PathEscape(id), nil, nil)
+}
+
+// ListArtifacts retrieves a page of generated reports, newest first.
+func (s *ReportsService) ListArtifacts(ctx context.Context, opts *ReportArtifactListOptions) (*ReportArtifactListResponse, error) {
+	params := make(map[string]string)
+	if opts != nil {
+		if opts.Limit > 0 {
+			params["limit"] = strconv.Itoa(opts.Limit)
+		}
+		params["cursor"] = opts.Cursor
+		params["subscription_id"] = opts.SubscriptionID
+	}
+
+	var resp ReportArtifactListResponse
+	if err := s.client.request(ctx, "GET", "/reports/artifacts"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAllArtifacts returns every generated report matching opts as an Iter,
+// fetching pages as needed.
+func (s *ReportsService) ListAllArtifacts(ctx context.Context, opts *ReportArtifactListOptions) Iter[ReportArtifact] {
+	var o ReportArtifactListOptions
+	if opts != nil {
+		o = *opts
+	}
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[ReportArtifact], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.ListArtifacts(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[ReportArtifact]{Items: resp.Data}
+		if resp.HasMore {
+			page.NextCursor = resp.NextCursor
+		}
+		return page, nil
+	}).Iter()
+}
@@ -0,0 +1,66 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReportsService_Subscriptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/reports/subscriptions":
+			var req CreateReportSubscriptionRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Target == nil || req.Target.Type != ReportTargetS3 || req.Target.Bucket != "acme-reports" {
+				t.Errorf("unexpected target: %+v", req.Target)
+			}
+			w.Write([]byte(`{"id":"rsub_1","report":"delivery_summary","frequency":"daily","format":"csv","target":{"type":"s3","bucket":"acme-reports"}}`))
+		case r.Method == "GET" && r.URL.Path == "/reports/artifacts":
+			if r.URL.Query().Get("subscription_id") != "rsub_1" {
+				t.Errorf("expected subscription_id to be 'rsub_1', got '%s'", r.URL.Query().Get("subscription_id"))
+			}
+			if r.URL.Query().Get("cursor") == "" {
+				w.Write([]byte(`{"data":[{"id":"rart_2","download_url":"https://dl.example.com/2"}],"next_cursor":"c1","has_more":true}`))
+				return
+			}
+			w.Write([]byte(`{"data":[{"id":"rart_1","download_url":"https://dl.example.com/1"}],"has_more":false}`))
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	sub, err := client.Reports.CreateSubscription(context.Background(), &CreateReportSubscriptionRequest{
+		Report:    ReportKindDeliverySummary,
+		Frequency: ReportDaily,
+		Target:    &ReportTarget{Type: ReportTargetS3, Bucket: "acme-reports", RoleARN: "arn:aws:iam::123:role/sendly"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sub.ID != "rsub_1" {
+		t.Errorf("expected ID to be 'rsub_1', got '%s'", sub.ID)
+	}
+
+	artifacts, err := client.Reports.ListAllArtifacts(context.Background(), &ReportArtifactListOptions{SubscriptionID: "rsub_1"}).Collect()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(artifacts) != 2 || artifacts[1].DownloadURL != "https://dl.example.com/1" {
+		t.Errorf("unexpected artifacts: %+v", artifacts)
+	}
+
+	_, err = client.Reports.CreateSubscription(context.Background(), &CreateReportSubscriptionRequest{
+		Report:    ReportKindDeliverySummary,
+		Frequency: ReportWeekly,
+		Target:    &ReportTarget{Type: ReportTargetWebhook, URL: "http://example.com/reports"},
+	})
+	if !IsValidationError(err) {
+		t.Errorf("expected validation error for non-HTTPS webhook target, got %v", err)
+	}
+}
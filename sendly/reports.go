@@ -0,0 +1,189 @@
+package sendly
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// ReportsService provides delivery and usage reporting operations.
+type ReportsService struct {
+	client *Client
+}
+
+// SLAGroupBy is the dimension used to group delivery SLA rows.
+type SLAGroupBy string
+
+const (
+	// SLAGroupByCountry groups rows by destination country.
+	SLAGroupByCountry SLAGroupBy = "country"
+	// SLAGroupByCarrier groups rows by destination country and carrier.
+	SLAGroupByCarrier SLAGroupBy = "carrier"
+)
+
+// DeliverySLAOptions are options for the delivery SLA report.
+type DeliverySLAOptions struct {
+	// Start is the beginning of the period in ISO 8601 format (required).
+	Start string
+	// End is the end of the period in ISO 8601 format (default: now).
+	End string
+	// GroupBy is the grouping dimension (default: country).
+	GroupBy SLAGroupBy
+	// Country restricts the report to one ISO 3166-1 alpha-2 country code.
+	Country string
+	// MessageType restricts the report to marketing or transactional traffic.
+	MessageType MessageType
+}
+
+// LatencyDistribution summarizes send-to-delivery latency in milliseconds.
+type LatencyDistribution struct {
+	Mean int `json:"mean"`
+	P50  int `json:"p50"`
+	P90  int `json:"p90"`
+	P95  int `json:"p95"`
+	P99  int `json:"p99"`
+	Max  int `json:"max"`
+}
+
+// DeliverySLARow contains delivery metrics for one destination.
+type DeliverySLARow struct {
+	Country     string              `json:"country"`
+	Carrier     string              `json:"carrier,omitempty"`
+	Total       int                 `json:"total"`
+	Delivered   int                 `json:"delivered"`
+	Failed      int                 `json:"failed"`
+	Pending     int                 `json:"pending"`
+	SuccessRate float64             `json:"success_rate"`
+	LatencyMs   LatencyDistribution `json:"latency_ms"`
+}
+
+// DeliverySLAReport contains measured delivery performance per destination.
+type DeliverySLAReport struct {
+	PeriodStart string           `json:"period_start"`
+	PeriodEnd   string           `json:"period_end"`
+	GroupBy     SLAGroupBy       `json:"group_by"`
+	Rows        []DeliverySLARow `json:"rows"`
+}
+
+// DeliverySLA returns delivery latency distributions and success rates per
+// destination country or carrier for the account's traffic over a period.
+func (s *ReportsService) DeliverySLA(ctx context.Context, opts *DeliverySLAOptions) (*DeliverySLAReport, error) {
+	if opts == nil || opts.Start == "" {
+		return nil, &ValidationError{APIError: APIError{Message: "start is required"}}
+	}
+
+	params := map[string]string{
+		"start":        opts.Start,
+		"end":          opts.End,
+		"group_by":     string(opts.GroupBy),
+		"country":      opts.Country,
+		"message_type": string(opts.MessageType),
+	}
+
+	var resp DeliverySLAReport
+	if err := s.client.request(ctx, "GET", "/reports/delivery-sla"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RegulatoryStatus is the registration state of a number with carriers and
+// regulators (for example 10DLC or toll-free verification).
+type RegulatoryStatus string
+
+const (
+	RegulatoryStatusApproved     RegulatoryStatus = "approved"
+	RegulatoryStatusPending      RegulatoryStatus = "pending"
+	RegulatoryStatusRejected     RegulatoryStatus = "rejected"
+	RegulatoryStatusNotRequired  RegulatoryStatus = "not_required"
+	RegulatoryStatusUnregistered RegulatoryStatus = "unregistered"
+)
+
+// NumberUsageOptions are options for the number usage report.
+type NumberUsageOptions struct {
+	// Limit is the maximum number of rows per page (default: 50, max: 200).
+	Limit int
+	// Cursor continues from a previous page's NextCursor.
+	Cursor string
+	// UnusedSince restricts the report to numbers not used since this time
+	// (ISO 8601).
+	UnusedSince string
+	// Country restricts the report to one ISO 3166-1 alpha-2 country code.
+	Country string
+}
+
+// NumberUsageRow describes usage and assignment of one owned number.
+type NumberUsageRow struct {
+	Number  string `json:"number"`
+	Country string `json:"country"`
+	// LastUsedAt is the time of the last inbound or outbound message, empty
+	// if the number has never been used.
+	LastUsedAt string `json:"last_used_at,omitempty"`
+	// MonthlyVolume is the number of messages in the last 30 days.
+	MonthlyVolume    int              `json:"monthly_volume"`
+	ProfileID        string           `json:"profile_id,omitempty"`
+	CampaignID       string           `json:"campaign_id,omitempty"`
+	RegulatoryStatus RegulatoryStatus `json:"regulatory_status"`
+	// MonthlyCost is the recurring rental cost in credits.
+	MonthlyCost int `json:"monthly_cost"`
+}
+
+// IdleSince reports whether the number has not been used since t.
+func (r NumberUsageRow) IdleSince(t time.Time) bool {
+	if r.LastUsedAt == "" {
+		return true
+	}
+	last, err := time.Parse(time.RFC3339, r.LastUsedAt)
+	return err == nil && last.Before(t)
+}
+
+// NumberUsageReport is a page of the number usage report.
+type NumberUsageReport struct {
+	Data       []NumberUsageRow `json:"data"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+	HasMore    bool             `json:"has_more"`
+}
+
+// NumberUsage returns last-used time, monthly volume, assignment and
+// regulatory status for each owned number, to help find numbers that can
+// be released.
+func (s *ReportsService) NumberUsage(ctx context.Context, opts *NumberUsageOptions) (*NumberUsageReport, error) {
+	params := make(map[string]string)
+	if opts != nil {
+		if opts.Limit > 0 {
+			params["limit"] = strconv.Itoa(opts.Limit)
+		}
+		params["cursor"] = opts.Cursor
+		params["unused_since"] = opts.UnusedSince
+		params["country"] = opts.Country
+	}
+
+	var resp NumberUsageReport
+	if err := s.client.request(ctx, "GET", "/reports/number-usage"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// NumberUsageAll returns every row of the number usage report as an Iter,
+// fetching pages as needed.
+func (s *ReportsService) NumberUsageAll(ctx context.Context, opts *NumberUsageOptions) Iter[NumberUsageRow] {
+	var o NumberUsageOptions
+	if opts != nil {
+		o = *opts
+	}
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[NumberUsageRow], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.NumberUsage(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[NumberUsageRow]{Items: resp.Data}
+		if resp.HasMore {
+			page.NextCursor = resp.NextCursor
+		}
+		return page, nil
+	}).Iter()
+}
@@ -0,0 +1,41 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestReportsService_NumberUsage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u := r.URL.Query().Get("unused_since"); u != "2025-01-01T00:00:00Z" {
+			t.Errorf("expected unused_since to be '2025-01-01T00:00:00Z', got '%s'", u)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[
+			{"number":"+15550000001","last_used_at":"2024-11-02T10:00:00Z","monthly_volume":0,"regulatory_status":"approved","monthly_cost":100},
+			{"number":"+15550000002","monthly_volume":0,"regulatory_status":"unregistered"}
+		],"has_more":false}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	rows, err := client.Reports.NumberUsageAll(context.Background(), &NumberUsageOptions{UnusedSince: "2025-01-01T00:00:00Z"}).Collect()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, row := range rows {
+		if !row.IdleSince(cutoff) {
+			t.Errorf("expected %s to be idle since %s", row.Number, cutoff)
+		}
+	}
+	if rows[0].IdleSince(time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected %s to have been used after 2024-11-01", rows[0].Number)
+	}
+}
@@ -0,0 +1,84 @@
+package sendly
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClientRequest_Signing(t *testing.T) {
+	const secret = "sig_secret"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkRequestSignature(t, r, secret)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"msg_1","to":"+15551234567","text":"hi","status":"queued"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithRequestSigning("sk_sig_1", secret))
+	if _, err := client.Messages.Send(context.Background(), &SendMessageRequest{To: "+15551234567", Text: "hi"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.Messages.List(context.Background(), &ListMessagesRequest{Limit: 5}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := New("test-api-key", WithRequestSigning("", secret)); !IsValidationError(err) {
+		t.Errorf("expected validation error for missing key ID, got %v", err)
+	}
+}
+
+func TestClientRequest_SigningAfterInterceptors(t *testing.T) {
+	const secret = "sig_secret"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := checkRequestSignature(t, r, secret)
+		if r.URL.Query().Get("trace") != "1" || !strings.Contains(string(body), `"traced":true`) {
+			t.Errorf("expected the interceptor's changes, got %s %s", r.URL.RequestURI(), body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"msg_1","to":"+15551234567","text":"hi","status":"queued"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key",
+		WithBaseURL(server.URL),
+		WithRequestSigning("sk_sig_1", secret),
+		WithRequestInterceptor(func(req *http.Request) error {
+			req.URL.RawQuery = "trace=1"
+			req.Body = io.NopCloser(strings.NewReader(`{"to":"+15551234567","text":"hi","traced":true}`))
+			req.ContentLength = -1
+			return nil
+		}),
+	)
+	if _, err := client.Messages.Send(context.Background(), &SendMessageRequest{To: "+15551234567", Text: "hi"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// checkRequestSignature verifies r's signature header and returns its body.
+func checkRequestSignature(t *testing.T, r *http.Request, secret string) []byte {
+	t.Helper()
+	body, _ := io.ReadAll(r.Body)
+	fields := map[string]string{}
+	for _, part := range strings.Split(r.Header.Get(RequestSignatureHeader), ",") {
+		k, v, _ := strings.Cut(part, "=")
+		fields[k] = v
+	}
+	if fields["keyId"] != "sk_sig_1" {
+		t.Errorf("expected keyId to be 'sk_sig_1', got '%s'", fields["keyId"])
+	}
+	ts, err := strconv.ParseInt(fields["t"], 10, 64)
+	if err != nil || time.Since(time.Unix(ts, 0)) > time.Minute {
+		t.Errorf("unexpected timestamp %q", fields["t"])
+	}
+	want := SignRequest(secret, time.Unix(ts, 0), r.Method, r.URL.RequestURI(), body)
+	if fields["v1"] != want {
+		t.Errorf("expected signature %s, got %s", want, fields["v1"])
+	}
+	return body
+}
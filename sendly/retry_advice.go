@@ -0,0 +1,138 @@
+package sendly
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// RetryClass groups error codes by how a sender should react to them.
+type RetryClass string
+
+const (
+	// RetryClassTransient errors are expected to clear on their own; retry
+	// after RetryAfter.
+	RetryClassTransient RetryClass = "transient"
+	// RetryClassRateLimited errors clear once the rate limit window resets.
+	RetryClassRateLimited RetryClass = "rate_limited"
+	// RetryClassPermanent errors will fail again; do not retry as-is.
+	RetryClassPermanent RetryClass = "permanent"
+	// RetryClassAccount errors need action on the account (credits, keys,
+	// verification) before any retry can succeed.
+	RetryClassAccount RetryClass = "account"
+)
+
+// FallbackChannel is an alternative channel to reach a recipient when SMS
+// cannot.
+type FallbackChannel string
+
+const (
+	FallbackNone  FallbackChannel = ""
+	FallbackVoice FallbackChannel = "voice"
+	FallbackEmail FallbackChannel = "email"
+)
+
+// RetryAdvice describes how to handle a Sendly or carrier error code.
+type RetryAdvice struct {
+	Code  string
+	Class RetryClass
+	// RetryAfter is the recommended minimum delay before retrying.
+	RetryAfter time.Duration
+	// MaxRetries is the recommended retry budget for this code.
+	MaxRetries int
+	// Fallback is the recommended alternative channel, if any.
+	Fallback    FallbackChannel
+	Description string
+}
+
+// Retryable reports whether the advice recommends retrying.
+func (a RetryAdvice) Retryable() bool {
+	return a.Class == RetryClassTransient || a.Class == RetryClassRateLimited
+}
+
+// RetryAdviceTable maps Sendly API and carrier error codes to retry advice.
+// It is maintained alongside the API; treat it as read-only.
+var RetryAdviceTable = map[string]RetryAdvice{
+	// API errors
+	"RATE_LIMIT_EXCEEDED":        {Class: RetryClassRateLimited, RetryAfter: time.Second, MaxRetries: 5, Description: "Too many requests; wait for the rate limit window to reset."},
+	"INTERNAL_ERROR":             {Class: RetryClassTransient, RetryAfter: 2 * time.Second, MaxRetries: 3, Description: "Unexpected server error."},
+	"SERVICE_UNAVAILABLE":        {Class: RetryClassTransient, RetryAfter: 5 * time.Second, MaxRetries: 3, Description: "The API is temporarily unavailable."},
+	"INSUFFICIENT_CREDITS":       {Class: RetryClassAccount, Description: "Add credits before retrying."},
+	"UNAUTHORIZED":               {Class: RetryClassAccount, Description: "The API key is invalid or revoked."},
+	"VERIFICATION_REQUIRED":      {Class: RetryClassAccount, Description: "Business verification is required for live sends."},
+	"INVALID_PHONE_NUMBER":       {Class: RetryClassPermanent, Fallback: FallbackEmail, Description: "The number is not a valid E.164 phone number."},
+	"INVALID_PARAMETER":          {Class: RetryClassPermanent, Description: "A request parameter is invalid."},
+	"VALIDATION_ERROR":           {Class: RetryClassPermanent, Description: "The request failed validation."},
+	"INVALID_TEMPLATE_VARIABLES": {Class: RetryClassPermanent, Description: "Template variables are missing, unknown or mistyped."},
+	"NOT_FOUND":                  {Class: RetryClassPermanent, Description: "The resource does not exist."},
+	"RECIPIENT_SUPPRESSED":       {Class: RetryClassPermanent, Description: "The recipient is on the suppression list."},
+	"MESSAGE_CLASS_UNSUPPORTED":  {Class: RetryClassPermanent, Description: "The destination does not support the message class; resend without it."},
+
+	// Carrier delivery errors
+	"CARRIER_UNREACHABLE": {Class: RetryClassTransient, RetryAfter: 5 * time.Minute, MaxRetries: 3, Description: "The carrier network could not be reached."},
+	"CARRIER_TIMEOUT":     {Class: RetryClassTransient, RetryAfter: time.Minute, MaxRetries: 3, Description: "The carrier did not acknowledge the message in time."},
+	"CARRIER_CONGESTION":  {Class: RetryClassTransient, RetryAfter: 2 * time.Minute, MaxRetries: 3, Description: "The carrier is throttling traffic."},
+	"HANDSET_UNAVAILABLE": {Class: RetryClassTransient, RetryAfter: 30 * time.Minute, MaxRetries: 2, Fallback: FallbackVoice, Description: "The handset is off or out of coverage."},
+	"HANDSET_MEMORY_FULL": {Class: RetryClassTransient, RetryAfter: time.Hour, MaxRetries: 2, Fallback: FallbackEmail, Description: "The handset cannot store more messages."},
+	"MESSAGE_EXPIRED":     {Class: RetryClassTransient, MaxRetries: 1, Fallback: FallbackVoice, Description: "The message validity period elapsed before delivery."},
+	"NUMBER_UNALLOCATED":  {Class: RetryClassPermanent, Fallback: FallbackEmail, Description: "The number is not assigned to a subscriber."},
+	"LANDLINE":            {Class: RetryClassPermanent, Fallback: FallbackVoice, Description: "The number is a landline that cannot receive SMS."},
+	"RECIPIENT_OPTED_OUT": {Class: RetryClassPermanent, Description: "The recipient has opted out; do not contact them by SMS."},
+	"CARRIER_BLOCKED":     {Class: RetryClassPermanent, Fallback: FallbackEmail, Description: "The carrier filtered the message as spam or policy-violating."},
+	"SENDER_NOT_ALLOWED":  {Class: RetryClassAccount, Description: "The sender ID is not registered for this destination."},
+}
+
+func init() {
+	for code, advice := range RetryAdviceTable {
+		advice.Code = code
+		RetryAdviceTable[code] = advice
+	}
+}
+
+// AdviceForCode returns the retry advice for an error code.
+func AdviceForCode(code string) (RetryAdvice, bool) {
+	advice, ok := RetryAdviceTable[code]
+	return advice, ok
+}
+
+// AdviceForError returns retry advice for an error returned by the client.
+// Known codes use RetryAdviceTable; otherwise advice is derived from the
+// HTTP status. A server-provided Retry-After always wins.
+func AdviceForError(err error) RetryAdvice {
+	if err == nil {
+		return RetryAdvice{}
+	}
+
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		advice := RetryAdviceTable["RATE_LIMIT_EXCEEDED"]
+		if rateLimitErr.RetryAfter > 0 {
+			advice.RetryAfter = time.Duration(rateLimitErr.RetryAfter) * time.Second
+		}
+		return advice
+	}
+
+	var networkErr *NetworkError
+	if errors.As(err, &networkErr) {
+		return RetryAdvice{Class: RetryClassTransient, RetryAfter: time.Second, MaxRetries: 3, Description: "Network error."}
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return RetryAdvice{Class: RetryClassPermanent}
+	}
+	if advice, ok := RetryAdviceTable[apiErr.Code]; ok {
+		return advice
+	}
+
+	advice := RetryAdvice{Code: apiErr.Code, Description: apiErr.Message}
+	switch {
+	case apiErr.StatusCode >= 500:
+		advice.Class, advice.RetryAfter, advice.MaxRetries = RetryClassTransient, 2*time.Second, 3
+	case apiErr.StatusCode == http.StatusUnauthorized, apiErr.StatusCode == http.StatusPaymentRequired, apiErr.StatusCode == http.StatusForbidden:
+		advice.Class = RetryClassAccount
+	default:
+		advice.Class = RetryClassPermanent
+	}
+	return advice
+}
@@ -0,0 +1,51 @@
+package sendly
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestAdviceForError(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		wantClass     RetryClass
+		wantRetryable bool
+		wantAfter     time.Duration
+	}{
+		{name: "rate limit honours Retry-After", err: &RateLimitError{RetryAfter: 7}, wantClass: RetryClassRateLimited, wantRetryable: true, wantAfter: 7 * time.Second},
+		{name: "known code", err: &ValidationError{APIError: APIError{Code: "INVALID_PHONE_NUMBER"}}, wantClass: RetryClassPermanent},
+		{name: "wrapped credits error", err: fmt.Errorf("send: %w", &InsufficientCreditsError{APIError: APIError{Code: "INSUFFICIENT_CREDITS"}}), wantClass: RetryClassAccount},
+		{name: "unknown 5xx", err: &SendlyError{APIError: APIError{Code: "SOMETHING_NEW"}, StatusCode: 503}, wantClass: RetryClassTransient, wantRetryable: true, wantAfter: 2 * time.Second},
+		{name: "network", err: &NetworkError{Message: "request failed"}, wantClass: RetryClassTransient, wantRetryable: true, wantAfter: time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			advice := AdviceForError(tt.err)
+			if advice.Class != tt.wantClass {
+				t.Errorf("expected class '%s', got '%s'", tt.wantClass, advice.Class)
+			}
+			if advice.Retryable() != tt.wantRetryable {
+				t.Errorf("expected Retryable() to be %v", tt.wantRetryable)
+			}
+			if advice.RetryAfter != tt.wantAfter {
+				t.Errorf("expected RetryAfter %v, got %v", tt.wantAfter, advice.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestAdviceForCode(t *testing.T) {
+	advice, ok := AdviceForCode("LANDLINE")
+	if !ok {
+		t.Fatal("expected LANDLINE to be known")
+	}
+	if advice.Code != "LANDLINE" || advice.Fallback != FallbackVoice {
+		t.Errorf("unexpected advice: %+v", advice)
+	}
+	if _, ok := AdviceForCode("NOPE"); ok {
+		t.Error("expected unknown code to be reported as unknown")
+	}
+}
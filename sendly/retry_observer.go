@@ -0,0 +1,73 @@
+package sendly
+
+import (
+	"errors"
+	"time"
+)
+
+// RetryDecision describes what the client decided after a failed attempt.
+type RetryDecision struct {
+	// Method and Route identify the call. Routes are templated like
+	// metrics routes ("/messages/{id}").
+	Method string
+	Route  string
+	// Attempt is the number of the attempt that failed, starting at 1.
+	Attempt int
+	// Err is the error of the failed attempt.
+	Err error
+	// Class is the classification of Err. See AdviceForError.
+	Class RetryClass
+	// Retry reports whether the call will be retried after Backoff.
+	Retry bool
+	// Exhausted is set when Err was retryable but MaxRetries was reached.
+	Exhausted bool
+	// Backoff is the delay before the next attempt. It is zero if Retry is
+	// false.
+	Backoff time.Duration
+	// FromRetryAfter is set when Backoff came from the server's
+	// Retry-After header rather than the exponential backoff.
+	FromRetryAfter bool
+	// RateLimit is the most recent rate limit state reported by the API,
+	// or nil if none has been seen.
+	RateLimit *RateLimit
+}
+
+// RetryObserver is called after every failed attempt with the client's
+// retry decision, before any backoff. It is called synchronously from the
+// calling goroutine, so it must be fast and safe for concurrent use.
+type RetryObserver func(RetryDecision)
+
+// WithRetryObserver reports every retry decision to fn, so retry behavior
+// can be logged or measured in production.
+//
+// Example:
+//
+//	client := sendly.NewClient(apiKey, sendly.WithRetryObserver(func(d sendly.RetryDecision) {
+//	    slog.Info("sendly retry", "route", d.Route, "attempt", d.Attempt,
+//	        "class", d.Class, "retry", d.Retry, "backoff", d.Backoff)
+//	}))
+func WithRetryObserver(fn RetryObserver) ClientOption {
+	return func(c *Client) {
+		c.RetryObserver = fn
+	}
+}
+
+// notifyRetry reports a retry decision to the RetryObserver, if any.
+func (c *Client) notifyRetry(method, path string, attempt int, err error, retry bool, backoff time.Duration) {
+	if c.RetryObserver == nil {
+		return
+	}
+	var rateLimitErr *RateLimitError
+	c.RetryObserver(RetryDecision{
+		Method:         method,
+		Route:          metricsRoute(path),
+		Attempt:        attempt + 1,
+		Err:            err,
+		Class:          AdviceForError(err).Class,
+		Retry:          retry,
+		Exhausted:      !retry && isRetryable(err),
+		Backoff:        backoff,
+		FromRetryAfter: retry && errors.As(err, &rateLimitErr) && rateLimitErr.RetryAfter > 0,
+		RateLimit:      c.LastRateLimit(),
+	})
+}
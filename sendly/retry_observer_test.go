@@ -0,0 +1,78 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithRetryObserver(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(APIError{Code: "INTERNAL_ERROR", Message: "Internal server error"})
+	}))
+	defer server.Close()
+
+	var decisions []RetryDecision
+	client := NewClient("test-api-key",
+		WithBaseURL(server.URL),
+		WithRetry(3, time.Millisecond),
+		WithRetryObserver(func(d RetryDecision) { decisions = append(decisions, d) }),
+	)
+
+	err := client.request(context.Background(), "GET", "/messages/msg_123", nil, nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if len(decisions) != 3 {
+		t.Fatalf("expected 3 decisions, got %d", len(decisions))
+	}
+	for i, d := range decisions {
+		if d.Attempt != i+1 {
+			t.Errorf("expected attempt %d, got %d", i+1, d.Attempt)
+		}
+		if d.Route != "/messages/{id}" {
+			t.Errorf("expected route to be '/messages/{id}', got '%s'", d.Route)
+		}
+		if d.Class != RetryClassTransient {
+			t.Errorf("expected class to be 'transient', got '%s'", d.Class)
+		}
+		if d.RateLimit == nil || d.RateLimit.Remaining != 0 {
+			t.Errorf("expected rate limit state, got %+v", d.RateLimit)
+		}
+	}
+	if !decisions[0].Retry || decisions[0].Backoff <= 0 || decisions[0].FromRetryAfter {
+		t.Errorf("unexpected first decision: %+v", decisions[0])
+	}
+	if last := decisions[2]; last.Retry || !last.Exhausted || last.Backoff != 0 {
+		t.Errorf("unexpected last decision: %+v", last)
+	}
+}
+
+func TestWithRetryObserver_NotRetryable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(APIError{Code: "UNAUTHORIZED", Message: "Invalid API key"})
+	}))
+	defer server.Close()
+
+	var decisions []RetryDecision
+	client := NewClient("test-api-key",
+		WithBaseURL(server.URL),
+		WithRetryObserver(func(d RetryDecision) { decisions = append(decisions, d) }),
+	)
+
+	client.request(context.Background(), "GET", "/test", nil, nil)
+	if len(decisions) != 1 {
+		t.Fatalf("expected 1 decision, got %d", len(decisions))
+	}
+	if d := decisions[0]; d.Retry || d.Exhausted || d.Class != RetryClassAccount {
+		t.Errorf("unexpected decision: %+v", d)
+	}
+}
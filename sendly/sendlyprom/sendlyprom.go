@@ -0,0 +1,98 @@
+// Package sendlyprom exports Sendly SDK call metrics to Prometheus.
+//
+// Example:
+//
+//	metrics := sendlyprom.NewCollector("")
+//	prometheus.MustRegister(metrics)
+//	client := sendly.NewClient(apiKey, sendly.WithMetrics(metrics))
+package sendlyprom
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/SendlyHQ/sendly-go/v3/sendly"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultNamespace prefixes metric names when NewCollector is given none.
+const DefaultNamespace = "sendly"
+
+// Collector is a sendly.MetricsCollector backed by Prometheus metrics. It is
+// also a prometheus.Collector; register it once with a registry. It exports:
+//
+//   - <ns>_requests_total{method,route,status}
+//   - <ns>_request_duration_seconds{method,route}
+//   - <ns>_errors_total{method,route,code}
+//   - <ns>_retries_total{method,route}
+type Collector struct {
+	requests *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+	retries  *prometheus.CounterVec
+}
+
+var _ sendly.MetricsCollector = (*Collector)(nil)
+
+// NewCollector creates a Collector with metric names prefixed by namespace
+// (default: "sendly").
+func NewCollector(namespace string) *Collector {
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Sendly API calls by method, route and final HTTP status (0 if no response).",
+		}, []string{"method", "route", "status"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Sendly API call latency, including retries and backoff.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "errors_total",
+			Help:      "Failed Sendly API calls by error code.",
+		}, []string{"method", "route", "code"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "retries_total",
+			Help:      "Retried Sendly API attempts.",
+		}, []string{"method", "route"}),
+	}
+}
+
+// ObserveRequest implements sendly.MetricsCollector.
+func (c *Collector) ObserveRequest(method, route string, status int, duration time.Duration) {
+	c.requests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	c.latency.WithLabelValues(method, route).Observe(duration.Seconds())
+}
+
+// ObserveError implements sendly.MetricsCollector.
+func (c *Collector) ObserveError(method, route, code string) {
+	c.errors.WithLabelValues(method, route, code).Inc()
+}
+
+// ObserveRetry implements sendly.MetricsCollector.
+func (c *Collector) ObserveRetry(method, route string) {
+	c.retries.WithLabelValues(method, route).Inc()
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.latency.Describe(ch)
+	c.errors.Describe(ch)
+	c.retries.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.latency.Collect(ch)
+	c.errors.Collect(ch)
+	c.retries.Collect(ch)
+}
@@ -0,0 +1,55 @@
+package sendlyprom
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SendlyHQ/sendly-go/v3/sendly"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCollector(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error":"unavailable","message":"try again"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"not_found","message":"no such message"}`))
+	}))
+	defer server.Close()
+
+	metrics := NewCollector("")
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(metrics)
+
+	client := sendly.NewClient("test-api-key",
+		sendly.WithBaseURL(server.URL),
+		sendly.WithRetry(2, time.Millisecond),
+		sendly.WithMetrics(metrics),
+	)
+	if _, err := client.Messages.Get(context.Background(), "msg_abc123"); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got := testutil.ToFloat64(metrics.requests.WithLabelValues("GET", "/messages/{id}", "404")); got != 1 {
+		t.Errorf("expected 1 request, got %v", got)
+	}
+	if got := testutil.ToFloat64(metrics.retries.WithLabelValues("GET", "/messages/{id}")); got != 1 {
+		t.Errorf("expected 1 retry, got %v", got)
+	}
+	if got := testutil.CollectAndCount(metrics.errors); got != 1 {
+		t.Errorf("expected 1 error series, got %d", got)
+	}
+	if got := testutil.CollectAndCount(reg, "sendly_request_duration_seconds"); got != 1 {
+		t.Errorf("expected 1 latency series, got %d", got)
+	}
+}
@@ -0,0 +1,53 @@
+// Package sendlyredis provides Redis-backed stores for the Sendly SDK, so
+// webhook receivers running on several replicas share state.
+//
+// Example:
+//
+//	rdb := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+//	handler := sendly.Webhooks{}.HandlerWithOptions(secret, handle, sendly.WebhookHandlerOptions{
+//	    NonceStore: sendlyredis.NewNonceStore(rdb, ""),
+//	})
+package sendlyredis
+
+import (
+	"context"
+	"time"
+
+	"github.com/SendlyHQ/sendly-go/v3/sendly"
+	"github.com/redis/go-redis/v9"
+)
+
+// DefaultKeyPrefix namespaces nonce keys when NewNonceStore is given none.
+const DefaultKeyPrefix = "sendly:webhook-nonce:"
+
+// NonceStore is a sendly.NonceStore backed by Redis SET NX with an expiry.
+type NonceStore struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+var _ sendly.NonceStore = (*NonceStore)(nil)
+
+// NewNonceStore creates a nonce store using client. Keys are prefixed with
+// prefix (default: DefaultKeyPrefix).
+func NewNonceStore(client redis.UniversalClient, prefix string) *NonceStore {
+	if prefix == "" {
+		prefix = DefaultKeyPrefix
+	}
+	return &NonceStore{client: client, prefix: prefix}
+}
+
+// Add implements sendly.NonceStore. Nonces that are already expired are
+// reported as new without being stored.
+func (s *NonceStore) Add(ctx context.Context, nonce string, expiresAt time.Time) (bool, error) {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return true, nil
+	}
+	return s.client.SetNX(ctx, s.prefix+nonce, 1, ttl).Result()
+}
+
+// Remove implements sendly.NonceStore.
+func (s *NonceStore) Remove(ctx context.Context, nonce string) error {
+	return s.client.Del(ctx, s.prefix+nonce).Err()
+}
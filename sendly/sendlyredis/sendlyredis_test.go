@@ -0,0 +1,34 @@
+package sendlyredis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestNonceStore(t *testing.T) {
+	mr := miniredis.RunT(t)
+	store := NewNonceStore(redis.NewClient(&redis.Options{Addr: mr.Addr()}), "")
+	ctx := context.Background()
+	exp := time.Now().Add(time.Minute)
+
+	if ok, err := store.Add(ctx, "evt_1", exp); err != nil || !ok {
+		t.Fatalf("expected first add to succeed, got %v, %v", ok, err)
+	}
+	if ok, _ := store.Add(ctx, "evt_1", exp); ok {
+		t.Error("expected duplicate add to fail")
+	}
+	if ttl := mr.TTL(DefaultKeyPrefix + "evt_1"); ttl <= 0 || ttl > time.Minute {
+		t.Errorf("expected TTL up to a minute, got %s", ttl)
+	}
+
+	if err := store.Remove(ctx, "evt_1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ok, _ := store.Add(ctx, "evt_1", exp); !ok {
+		t.Error("expected removed nonce to be accepted again")
+	}
+}
@@ -0,0 +1,49 @@
+package sendlytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/SendlyHQ/sendly-go/v3/sendly"
+)
+
+// ErrNotSandbox is returned by CompleteVerification for a live verification.
+var ErrNotSandbox = errors.New("sendlytest: verification is not a sandbox verification")
+
+// CompleteVerification checks a sandbox verification with its issued code,
+// so signup flows can be tested end to end in one call. It works against
+// the Sendly sandbox (test API keys) and against Server. It fails if the
+// verification is live, no longer pending, or not verified by the check.
+//
+// Example:
+//
+//	v, err := client.Verify.Send(ctx, &sendly.SendVerificationRequest{To: sendly.TestNumberSuccess})
+//	...
+//	if _, err := sendlytest.CompleteVerification(ctx, client, v.ID); err != nil {
+//	    t.Fatal(err)
+//	}
+func CompleteVerification(ctx context.Context, client *sendly.Client, id sendly.VerificationID) (*sendly.CheckVerificationResponse, error) {
+	v, err := client.Verify.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !v.Sandbox {
+		return nil, ErrNotSandbox
+	}
+	if v.Status != sendly.VerificationStatusPending {
+		return nil, fmt.Errorf("sendlytest: verification %s is %s", id, v.Status)
+	}
+	if v.SandboxCode == "" {
+		return nil, fmt.Errorf("sendlytest: verification %s has no sandbox code", id)
+	}
+
+	res, err := client.Verify.Check(ctx, id, &sendly.CheckVerificationRequest{Code: v.SandboxCode})
+	if err != nil {
+		return nil, err
+	}
+	if res.Status != sendly.VerificationStatusVerified {
+		return res, fmt.Errorf("sendlytest: verification %s is %s after check", id, res.Status)
+	}
+	return res, nil
+}
@@ -0,0 +1,35 @@
+package sendlytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SendlyHQ/sendly-go/v3/sendly"
+)
+
+func TestCompleteVerification(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	v, err := client.Verify.Send(ctx, &sendly.SendVerificationRequest{To: "+15551234567"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	res, err := CompleteVerification(ctx, client, v.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Status != sendly.VerificationStatusVerified {
+		t.Errorf("expected status to be 'verified', got '%s'", res.Status)
+	}
+
+	if _, err := CompleteVerification(ctx, client, v.ID); err == nil {
+		t.Error("expected error for an already verified verification")
+	}
+	if _, err := CompleteVerification(ctx, client, "ver_missing"); !sendly.IsNotFoundError(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,172 @@
+package sendlytest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/SendlyHQ/sendly-go/v3/sendly"
+)
+
+func (s *Server) serveMessages(w http.ResponseWriter, r *http.Request, parts []string) {
+	switch {
+	case len(parts) == 0 && r.Method == "POST":
+		s.sendMessage(w, r)
+	case len(parts) == 0 && r.Method == "GET":
+		s.listMessages(w, r)
+	case len(parts) == 1 && r.Method == "GET":
+		s.mu.Lock()
+		msg := s.findMessage(parts[0])
+		s.mu.Unlock()
+		if msg == nil {
+			notFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, msg)
+	case len(parts) == 2 && parts[1] == "simulate" && r.Method == "POST":
+		s.simulateStatus(w, r, parts[0])
+	case len(parts) <= 2:
+		methodNotAllowed(w)
+	default:
+		notFound(w)
+	}
+}
+
+// findMessage returns the stored message with id. Callers hold s.mu.
+func (s *Server) findMessage(id string) *sendly.Message {
+	for _, msg := range s.messages {
+		if msg.ID == id {
+			return msg
+		}
+	}
+	return nil
+}
+
+func (s *Server) sendMessage(w http.ResponseWriter, r *http.Request) {
+	var req sendly.SendMessageRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if !strings.HasPrefix(string(req.To), "+") {
+		writeError(w, http.StatusBadRequest, "invalid_phone_number", "to must be in E.164 format")
+		return
+	}
+
+	s.mu.Lock()
+	text := req.Text
+	if req.TemplateID != "" {
+		tpl, ok := s.templates[req.TemplateID]
+		if !ok {
+			s.mu.Unlock()
+			writeError(w, http.StatusNotFound, "template_not_found", "template not found")
+			return
+		}
+		text = renderTemplate(tpl, req.Variables)
+	}
+	segments := len(text)/160 + 1
+	msg := &sendly.Message{
+		ID:          s.nextID("msg"),
+		To:          string(req.To),
+		From:        req.From,
+		Text:        text,
+		Status:      sendly.MessageStatusQueued,
+		Direction:   "outbound",
+		Segments:    segments,
+		CreditsUsed: segments,
+		IsSandbox:   true,
+		SenderType:  "sandbox",
+		CreatedAt:   now(),
+		Metadata:    req.Metadata,
+	}
+	if req.TemplateID != "" {
+		msg.TemplateID = &req.TemplateID
+	}
+	if req.StatusCallbackURL != "" {
+		msg.StatusCallbackURL = &req.StatusCallbackURL
+	}
+	s.messages = append(s.messages, msg)
+	resp := *msg
+	s.mu.Unlock()
+
+	s.emitMessage(sendly.WebhookEventMessageQueued, &resp)
+	writeJSON(w, http.StatusOK, &resp)
+}
+
+func (s *Server) listMessages(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	if limit <= 0 {
+		limit = 50
+	}
+	offset, _ := strconv.Atoi(q.Get("offset"))
+
+	s.mu.Lock()
+	var matched []sendly.Message
+	for i := len(s.messages) - 1; i >= 0; i-- {
+		msg := s.messages[i]
+		if status := q.Get("status"); status != "" && string(msg.Status) != status {
+			continue
+		}
+		if to := q.Get("to"); to != "" && msg.To != to {
+			continue
+		}
+		matched = append(matched, *msg)
+	}
+	s.mu.Unlock()
+
+	page := []sendly.Message{}
+	if offset < len(matched) {
+		page = matched[offset:min(offset+limit, len(matched))]
+	}
+	writeJSON(w, http.StatusOK, sendly.ListMessagesResponse{Data: page, Count: len(matched)})
+}
+
+func (s *Server) simulateStatus(w http.ResponseWriter, r *http.Request, id string) {
+	var req sendly.SimulateStatusRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	var eventType sendly.WebhookEventType
+	switch req.Status {
+	case sendly.MessageStatusDelivered:
+		eventType = sendly.WebhookEventMessageDelivered
+	case sendly.MessageStatusFailed, sendly.MessageStatusExpired:
+		eventType = sendly.WebhookEventMessageFailed
+	default:
+		writeError(w, http.StatusBadRequest, "invalid_status", "status must be delivered, failed, or expired")
+		return
+	}
+
+	s.mu.Lock()
+	msg := s.findMessage(id)
+	if msg == nil {
+		s.mu.Unlock()
+		notFound(w)
+		return
+	}
+	msg.Status = req.Status
+	if req.Status == sendly.MessageStatusDelivered {
+		at := now()
+		msg.DeliveredAt = &at
+	}
+	resp := *msg
+	s.mu.Unlock()
+
+	s.emitMessage(eventType, &resp)
+	writeJSON(w, http.StatusOK, &resp)
+}
+
+func messageEventData(msg *sendly.Message) *sendly.WebhookMessageData {
+	data := &sendly.WebhookMessageData{
+		MessageID:   msg.ID,
+		Status:      sendly.WebhookMessageStatus(msg.Status),
+		To:          msg.To,
+		From:        msg.From,
+		Segments:    msg.Segments,
+		CreditsUsed: msg.CreditsUsed,
+	}
+	if msg.DeliveredAt != nil {
+		data.DeliveredAt = *msg.DeliveredAt
+	}
+	return data
+}
@@ -0,0 +1,228 @@
+// Package sendlytest provides an in-memory fake of the Sendly API for
+// integration tests that should not depend on the real sandbox.
+//
+// The fake implements the messages, verify, templates and webhooks
+// endpoints. OTP codes are deterministic and webhook events are delivered,
+// signed with each webhook's secret, to the registered URLs synchronously
+// before the triggering API call returns. Events for a message sent with a
+// StatusCallbackURL go only to that URL, signed with CallbackSecret.
+//
+// Example:
+//
+//	srv := sendlytest.NewServer()
+//	defer srv.Close()
+//	client := srv.Client()
+//
+//	v, _ := client.Verify.Send(ctx, &sendly.SendVerificationRequest{To: "+15551234567"})
+//	res, _ := client.Verify.Check(ctx, v.ID, &sendly.CheckVerificationRequest{Code: srv.Code(v.ID)})
+package sendlytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/SendlyHQ/sendly-go/v3/sendly"
+)
+
+// DefaultOTPCode is the code every verification is issued, truncated or
+// zero-padded to the requested code length.
+const DefaultOTPCode = "123456"
+
+// Server is an in-memory fake of the Sendly API. It is safe for concurrent
+// use.
+type Server struct {
+	*httptest.Server
+
+	// OTPCode overrides DefaultOTPCode for new verifications.
+	OTPCode string
+	// WebhookClient delivers webhook events. Set it to an httptest TLS
+	// server's Client() to deliver to https test endpoints.
+	WebhookClient *http.Client
+	// CallbackSecret stands in for the account webhook secret and signs
+	// events delivered to per-message status callbacks.
+	CallbackSecret string
+
+	mu            sync.Mutex
+	seq           int
+	messages      []*sendly.Message
+	verifications map[sendly.VerificationID]*verification
+	templates     map[string]*sendly.Template
+	templateOrder []string
+	webhooks      map[string]*webhook
+	webhookOrder  []string
+	deliveries    []Delivery
+}
+
+// Delivery records one webhook delivery attempt made by the fake.
+type Delivery struct {
+	// WebhookID is the receiving webhook, or "" for a message's status
+	// callback.
+	WebhookID string
+	Event     sendly.Event
+	// StatusCode is the endpoint's response status, 0 if the request failed.
+	StatusCode int
+	// Err is the transport error, if any.
+	Err error
+}
+
+// NewServer starts a fake Sendly API. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		WebhookClient:  http.DefaultClient,
+		CallbackSecret: newWebhookSecret(),
+		verifications:  make(map[sendly.VerificationID]*verification),
+		templates:      make(map[string]*sendly.Template),
+		webhooks:       make(map[string]*webhook),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns a sendly.Client pointed at the fake. Retries are disabled
+// so failures surface immediately.
+func (s *Server) Client(opts ...sendly.Option) *sendly.Client {
+	opts = append([]sendly.Option{
+		sendly.WithBaseURL(s.URL),
+		sendly.WithMaxRetries(0),
+	}, opts...)
+	return sendly.NewClient("sk_test_v1_sendlytest", opts...)
+}
+
+// Deliveries returns the webhook deliveries made so far, oldest first.
+func (s *Server) Deliveries() []Delivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Delivery(nil), s.deliveries...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "unauthorized", "missing API key")
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch parts[0] {
+	case "messages":
+		s.serveMessages(w, r, parts[1:])
+	case "verify":
+		s.serveVerify(w, r, parts[1:])
+	case "templates":
+		s.serveTemplates(w, r, parts[1:])
+	case "webhooks":
+		s.serveWebhooks(w, r, parts[1:])
+	default:
+		notFound(w)
+	}
+}
+
+// nextID returns a new ID with the given prefix. Callers hold s.mu.
+func (s *Server) nextID(prefix string) string {
+	s.seq++
+	return fmt.Sprintf("%s_%06d", prefix, s.seq)
+}
+
+// Emit delivers an event with the given data to every active webhook
+// subscribed to its type, as the API does after state changes. Use it to
+// simulate events the fake does not raise itself, such as message.received.
+func (s *Server) Emit(eventType sendly.WebhookEventType, data interface{}) {
+	// s.mu is released before delivering, since endpoints may call back
+	// into the fake.
+	s.mu.Lock()
+	event := s.newEvent(eventType, data)
+	var targets []webhook
+	for _, id := range s.webhookOrder {
+		if wh := s.webhooks[id]; wh.IsActive && wh.subscribed(eventType) {
+			targets = append(targets, *wh)
+		}
+	}
+	s.mu.Unlock()
+
+	s.deliverAll(event, targets)
+}
+
+// emitMessage delivers a message event to the message's status callback
+// if it has one, and to the subscribed webhooks otherwise.
+func (s *Server) emitMessage(eventType sendly.WebhookEventType, msg *sendly.Message) {
+	if msg.StatusCallbackURL == nil {
+		s.Emit(eventType, messageEventData(msg))
+		return
+	}
+	s.mu.Lock()
+	event := s.newEvent(eventType, messageEventData(msg))
+	s.mu.Unlock()
+	s.deliverAll(event, []webhook{{URL: *msg.StatusCallbackURL, secret: s.CallbackSecret}})
+}
+
+// newEvent wraps data in an event envelope. Callers hold s.mu.
+func (s *Server) newEvent(eventType sendly.WebhookEventType, data interface{}) sendly.Event {
+	raw, _ := json.Marshal(data)
+	return sendly.Event{
+		ID:         s.nextID("evt"),
+		Type:       eventType,
+		Data:       raw,
+		CreatedAt:  now(),
+		APIVersion: "v1",
+	}
+}
+
+func (s *Server) deliverAll(event sendly.Event, targets []webhook) {
+	body, _ := json.Marshal(event)
+	for _, wh := range targets {
+		d := Delivery{WebhookID: wh.ID, Event: event}
+		d.StatusCode, d.Err = s.deliver(wh, body)
+		s.mu.Lock()
+		s.deliveries = append(s.deliveries, d)
+		s.mu.Unlock()
+	}
+}
+
+func (s *Server) deliver(wh webhook, body []byte) (int, error) {
+	req, err := http.NewRequest("POST", wh.URL, strings.NewReader(string(body)))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(sendly.SignatureHeader, sendly.Webhooks{}.GenerateSignature(string(body), wh.secret))
+	resp, err := s.WebhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", "invalid JSON body")
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, sendly.APIError{Code: code, Message: message})
+}
+
+func notFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "not_found", "resource not found")
+}
+
+func methodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "method not allowed")
+}
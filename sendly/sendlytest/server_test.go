@@ -0,0 +1,189 @@
+package sendlytest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/SendlyHQ/sendly-go/v3/sendly"
+)
+
+func TestServer_EndToEnd(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var events []*sendly.Event
+	var secret string
+	endpoint := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		s := secret
+		mu.Unlock()
+		sendly.Webhooks{}.Handler(s, func(e *sendly.Event) error {
+			mu.Lock()
+			events = append(events, e)
+			mu.Unlock()
+			return nil
+		}).ServeHTTP(w, r)
+	}))
+	defer endpoint.Close()
+	srv.WebhookClient = endpoint.Client()
+
+	created, err := client.WebhooksService.Create(ctx, sendly.CreateWebhookRequest{
+		URL:    endpoint.URL,
+		Events: []string{"message.*", "verify.completed"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	mu.Lock()
+	secret = created.Secret
+	mu.Unlock()
+
+	tpl, err := client.Templates.Create(ctx, &sendly.CreateTemplateRequest{Name: "welcome", Text: "Hi {{name}}!"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tpl.Variables) != 1 || tpl.Variables[0].Key != "name" {
+		t.Errorf("expected variable 'name', got %+v", tpl.Variables)
+	}
+
+	msg, err := client.Messages.Send(ctx, &sendly.SendMessageRequest{
+		To:         "+15551234567",
+		TemplateID: tpl.ID,
+		Variables:  map[string]string{"name": "Ada"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.Text != "Hi Ada!" {
+		t.Errorf("expected text to be 'Hi Ada!', got '%s'", msg.Text)
+	}
+	if _, err := client.Messages.SimulateStatus(ctx, msg.ID, sendly.MessageStatusDelivered); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := client.Messages.Get(ctx, msg.ID)
+	if err != nil || got.Status != sendly.MessageStatusDelivered {
+		t.Errorf("expected delivered message, got %+v, %v", got, err)
+	}
+
+	v, err := client.Verify.Send(ctx, &sendly.SendVerificationRequest{To: "+15551234567", CodeLength: 4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if code := srv.Code(v.ID); code != "1234" || v.SandboxCode != code {
+		t.Errorf("expected deterministic code '1234', got '%s'", code)
+	}
+	bad, _ := client.Verify.Check(ctx, v.ID, &sendly.CheckVerificationRequest{Code: "0000"})
+	if bad.Status != sendly.VerificationStatusInvalid || bad.RemainingAttempts != 2 {
+		t.Errorf("unexpected check result for wrong code: %+v", bad)
+	}
+	ok, err := client.Verify.Check(ctx, v.ID, &sendly.CheckVerificationRequest{Code: "1234"})
+	if err != nil || ok.Status != sendly.VerificationStatusVerified {
+		t.Errorf("expected verified, got %+v, %v", ok, err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	var types []sendly.WebhookEventType
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	want := []sendly.WebhookEventType{
+		sendly.WebhookEventMessageQueued,
+		sendly.WebhookEventMessageDelivered,
+		sendly.WebhookEventVerifyCompleted,
+	}
+	if len(types) != len(want) {
+		t.Fatalf("expected events %v, got %v", want, types)
+	}
+	for i := range want {
+		if types[i] != want[i] {
+			t.Errorf("expected event %d to be %s, got %s", i, want[i], types[i])
+		}
+	}
+	for _, d := range srv.Deliveries() {
+		if d.StatusCode != http.StatusOK {
+			t.Errorf("expected delivery of %s to succeed, got %d (%v)", d.Event.Type, d.StatusCode, d.Err)
+		}
+	}
+
+	if _, err := client.Messages.Get(ctx, "msg_missing"); !sendly.IsNotFoundError(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestServer_StatusCallbackOverridesWebhooks(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	received := map[string][]sendly.WebhookEventType{}
+	receiver := func(name, secret string) http.Handler {
+		return sendly.Webhooks{}.Handler(secret, func(e *sendly.Event) error {
+			mu.Lock()
+			received[name] = append(received[name], e.Type)
+			mu.Unlock()
+			return nil
+		})
+	}
+	var webhookSecret string
+	endpoint := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/callback" {
+			receiver("callback", srv.CallbackSecret).ServeHTTP(w, r)
+			return
+		}
+		mu.Lock()
+		s := webhookSecret
+		mu.Unlock()
+		receiver("webhook", s).ServeHTTP(w, r)
+	}))
+	defer endpoint.Close()
+	srv.WebhookClient = endpoint.Client()
+
+	created, err := client.WebhooksService.Create(ctx, sendly.CreateWebhookRequest{URL: endpoint.URL, Events: []string{"message.*"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	mu.Lock()
+	webhookSecret = created.Secret
+	mu.Unlock()
+
+	msg, err := client.Messages.Send(ctx, &sendly.SendMessageRequest{
+		To:                "+15551234567",
+		Text:              "Your table is ready",
+		StatusCallbackURL: endpoint.URL + "/callback",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.StatusCallbackURL == nil || *msg.StatusCallbackURL != endpoint.URL+"/callback" {
+		t.Errorf("expected StatusCallbackURL on the message, got %v", msg.StatusCallbackURL)
+	}
+	if _, err := client.Messages.SimulateStatus(ctx, msg.ID, sendly.MessageStatusDelivered); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.Messages.Send(ctx, &sendly.SendMessageRequest{To: "+15551234567", Text: "No callback"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if got := received["callback"]; len(got) != 2 || got[0] != sendly.WebhookEventMessageQueued || got[1] != sendly.WebhookEventMessageDelivered {
+		t.Errorf("expected queued and delivered at the callback, got %v", got)
+	}
+	if got := received["webhook"]; len(got) != 1 || got[0] != sendly.WebhookEventMessageQueued {
+		t.Errorf("expected only the second message's event at the webhook, got %v", got)
+	}
+	for _, d := range srv.Deliveries() {
+		if d.StatusCode != http.StatusOK {
+			t.Errorf("unexpected delivery: %+v", d)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package sendlytest
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/SendlyHQ/sendly-go/v3/sendly"
+)
+
+var templateVarPattern = regexp.MustCompile(`\{\{\s*(\w+)\s*\}\}`)
+
+// templateVariables returns the variables referenced by text, in order of
+// first use.
+func templateVariables(text string) []sendly.TemplateVariable {
+	vars := []sendly.TemplateVariable{}
+	seen := make(map[string]bool)
+	for _, m := range templateVarPattern.FindAllStringSubmatch(text, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			vars = append(vars, sendly.TemplateVariable{Key: m[1], Type: "string"})
+		}
+	}
+	return vars
+}
+
+// renderTemplate substitutes vars into tpl, falling back to each variable's
+// fallback value.
+func renderTemplate(tpl *sendly.Template, vars map[string]string) string {
+	fallbacks := make(map[string]string)
+	for _, v := range tpl.Variables {
+		fallbacks[v.Key] = v.Fallback
+	}
+	return templateVarPattern.ReplaceAllStringFunc(tpl.Text, func(m string) string {
+		key := templateVarPattern.FindStringSubmatch(m)[1]
+		if v, ok := vars[key]; ok {
+			return v
+		}
+		return fallbacks[key]
+	})
+}
+
+func (s *Server) serveTemplates(w http.ResponseWriter, r *http.Request, parts []string) {
+	switch {
+	case len(parts) == 0 && r.Method == "GET":
+		s.mu.Lock()
+		resp := sendly.TemplateListResponse{Templates: []sendly.Template{}}
+		for _, id := range s.templateOrder {
+			resp.Templates = append(resp.Templates, *s.templates[id])
+		}
+		s.mu.Unlock()
+		writeJSON(w, http.StatusOK, &resp)
+	case len(parts) == 0 && r.Method == "POST":
+		s.createTemplate(w, r)
+	case len(parts) == 1 && r.Method == "GET":
+		s.mu.Lock()
+		tpl, ok := s.templates[parts[0]]
+		var resp sendly.Template
+		if ok {
+			resp = *tpl
+		}
+		s.mu.Unlock()
+		if !ok {
+			notFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, &resp)
+	case len(parts) == 1 && r.Method == "PATCH":
+		s.updateTemplate(w, r, parts[0])
+	case len(parts) == 1 && r.Method == "DELETE":
+		s.deleteTemplate(w, parts[0])
+	case len(parts) == 2 && parts[1] == "publish" && r.Method == "POST":
+		s.publishTemplate(w, parts[0])
+	case len(parts) == 2 && parts[1] == "preview" && r.Method == "POST":
+		s.previewTemplate(w, r, parts[0])
+	default:
+		notFound(w)
+	}
+}
+
+func (s *Server) createTemplate(w http.ResponseWriter, r *http.Request) {
+	var req sendly.CreateTemplateRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name == "" || strings.TrimSpace(req.Text) == "" {
+		writeError(w, http.StatusBadRequest, "invalid_parameter", "name and text are required")
+		return
+	}
+
+	s.mu.Lock()
+	at := time.Now().UTC()
+	tpl := &sendly.Template{
+		ID:        s.nextID("tpl"),
+		Name:      req.Name,
+		Text:      req.Text,
+		Variables: templateVariables(req.Text),
+		Status:    sendly.TemplateStatusDraft,
+		Version:   1,
+		CreatedAt: at,
+		UpdatedAt: at,
+	}
+	s.templates[tpl.ID] = tpl
+	s.templateOrder = append(s.templateOrder, tpl.ID)
+	resp := *tpl
+	s.mu.Unlock()
+
+	s.Emit(sendly.WebhookEventTemplateCreated, templateEventData(&resp, "created", nil))
+	writeJSON(w, http.StatusOK, &resp)
+}
+
+func (s *Server) updateTemplate(w http.ResponseWriter, r *http.Request, id string) {
+	var req sendly.UpdateTemplateRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	tpl, ok := s.templates[id]
+	if !ok {
+		s.mu.Unlock()
+		notFound(w)
+		return
+	}
+	var changed []string
+	if req.Name != "" {
+		tpl.Name = req.Name
+		changed = append(changed, "name")
+	}
+	if req.Text != "" {
+		tpl.Text = req.Text
+		tpl.Variables = templateVariables(req.Text)
+		changed = append(changed, "text")
+	}
+	tpl.Version++
+	tpl.UpdatedAt = time.Now().UTC()
+	resp := *tpl
+	s.mu.Unlock()
+
+	s.Emit(sendly.WebhookEventTemplateUpdated, templateEventData(&resp, "updated", changed))
+	writeJSON(w, http.StatusOK, &resp)
+}
+
+func (s *Server) publishTemplate(w http.ResponseWriter, id string) {
+	s.mu.Lock()
+	tpl, ok := s.templates[id]
+	if !ok {
+		s.mu.Unlock()
+		notFound(w)
+		return
+	}
+	tpl.Status = sendly.TemplateStatusPublished
+	at := time.Now().UTC()
+	tpl.PublishedAt = &at
+	resp := *tpl
+	s.mu.Unlock()
+
+	s.Emit(sendly.WebhookEventTemplatePublished, templateEventData(&resp, "published", []string{"status"}))
+	writeJSON(w, http.StatusOK, &resp)
+}
+
+func (s *Server) deleteTemplate(w http.ResponseWriter, id string) {
+	s.mu.Lock()
+	tpl, ok := s.templates[id]
+	if !ok {
+		s.mu.Unlock()
+		notFound(w)
+		return
+	}
+	delete(s.templates, id)
+	for i, tid := range s.templateOrder {
+		if tid == id {
+			s.templateOrder = append(s.templateOrder[:i], s.templateOrder[i+1:]...)
+			break
+		}
+	}
+	resp := *tpl
+	s.mu.Unlock()
+
+	s.Emit(sendly.WebhookEventTemplateDeleted, templateEventData(&resp, "deleted", nil))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) previewTemplate(w http.ResponseWriter, r *http.Request, id string) {
+	var req struct {
+		Variables map[string]string `json:"variables"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	tpl, ok := s.templates[id]
+	var resp sendly.TemplatePreview
+	if ok {
+		resp = sendly.TemplatePreview{
+			ID:           tpl.ID,
+			Name:         tpl.Name,
+			OriginalText: tpl.Text,
+			PreviewText:  renderTemplate(tpl, req.Variables),
+			Variables:    tpl.Variables,
+		}
+	}
+	s.mu.Unlock()
+	if !ok {
+		notFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, &resp)
+}
+
+func templateEventData(tpl *sendly.Template, action string, changed []string) *sendly.ResourceEventData {
+	return &sendly.ResourceEventData{
+		ResourceType:  "template",
+		ResourceID:    tpl.ID,
+		Action:        action,
+		ChangedFields: changed,
+		Current: map[string]interface{}{
+			"name":    tpl.Name,
+			"text":    tpl.Text,
+			"status":  tpl.Status,
+			"version": tpl.Version,
+		},
+		ActorType: "api_key",
+	}
+}
@@ -0,0 +1,225 @@
+package sendlytest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/SendlyHQ/sendly-go/v3/sendly"
+)
+
+const (
+	defaultMaxAttempts = 3
+	defaultOTPTimeout  = 10 * time.Minute
+)
+
+type verification struct {
+	sendly.Verification
+	code string
+}
+
+// Code returns the OTP code issued for a verification, or "" if the ID is
+// unknown.
+func (s *Server) Code(verificationID sendly.VerificationID) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.verifications[verificationID]; ok {
+		return v.code
+	}
+	return ""
+}
+
+// otpCode returns the code for a verification of the given length. Callers
+// hold s.mu.
+func (s *Server) otpCode(length int) string {
+	code := s.OTPCode
+	if code == "" {
+		code = DefaultOTPCode
+	}
+	if length <= 0 {
+		return code
+	}
+	if len(code) >= length {
+		return code[:length]
+	}
+	return strings.Repeat("0", length-len(code)) + code
+}
+
+func (s *Server) serveVerify(w http.ResponseWriter, r *http.Request, parts []string) {
+	switch {
+	case len(parts) == 0 && r.Method == "POST":
+		s.sendVerification(w, r)
+	case len(parts) == 1 && r.Method == "GET":
+		s.mu.Lock()
+		v, ok := s.verifications[sendly.VerificationID(parts[0])]
+		var resp sendly.Verification
+		if ok {
+			s.expireVerification(v)
+			resp = v.Verification
+			if resp.Status == sendly.VerificationStatusPending {
+				resp.SandboxCode = v.code
+			}
+		}
+		s.mu.Unlock()
+		if !ok {
+			notFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, &resp)
+	case len(parts) == 2 && parts[1] == "check" && r.Method == "POST":
+		s.checkVerification(w, r, sendly.VerificationID(parts[0]))
+	case len(parts) == 2 && parts[1] == "resend" && r.Method == "POST":
+		s.resendVerification(w, sendly.VerificationID(parts[0]))
+	default:
+		notFound(w)
+	}
+}
+
+func (s *Server) sendVerification(w http.ResponseWriter, r *http.Request) {
+	var req sendly.SendVerificationRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if !strings.HasPrefix(string(req.To), "+") {
+		writeError(w, http.StatusBadRequest, "invalid_phone_number", "to must be in E.164 format")
+		return
+	}
+	channel := req.Channel
+	if channel == "" {
+		channel = sendly.VerifyChannelSMS
+	}
+	timeout := defaultOTPTimeout
+	if req.Timeout > 0 {
+		timeout = req.Timeout
+	}
+
+	s.mu.Lock()
+	v := &verification{
+		Verification: sendly.Verification{
+			ID:             sendly.VerificationID(s.nextID("ver")),
+			Status:         sendly.VerificationStatusPending,
+			Phone:          string(req.To),
+			DeliveryStatus: sendly.MessageStatusDelivered,
+			Channel:        channel,
+			MaxAttempts:    defaultMaxAttempts,
+			ExpiresAt:      time.Now().UTC().Add(timeout),
+			CreatedAt:      time.Now().UTC(),
+			Sandbox:        true,
+			AppName:        req.AppName,
+			TemplateID:     req.TemplateID,
+			ProfileID:      req.ProfileID,
+		},
+		code: s.otpCode(req.CodeLength),
+	}
+	s.verifications[v.ID] = v
+	resp := sendly.SendVerificationResponse{
+		ID:          v.ID,
+		Status:      v.Status,
+		Phone:       v.Phone,
+		Channel:     v.Channel,
+		ExpiresAt:   v.ExpiresAt,
+		Sandbox:     true,
+		SandboxCode: v.code,
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, &resp)
+}
+
+func (s *Server) resendVerification(w http.ResponseWriter, id sendly.VerificationID) {
+	s.mu.Lock()
+	v, ok := s.verifications[id]
+	if !ok {
+		s.mu.Unlock()
+		notFound(w)
+		return
+	}
+	s.expireVerification(v)
+	if v.Status != sendly.VerificationStatusPending {
+		s.mu.Unlock()
+		writeError(w, http.StatusBadRequest, "verification_not_pending", "verification is "+string(v.Status))
+		return
+	}
+	resp := sendly.SendVerificationResponse{
+		ID:          v.ID,
+		Status:      v.Status,
+		Phone:       v.Phone,
+		Channel:     v.Channel,
+		ExpiresAt:   v.ExpiresAt,
+		Sandbox:     true,
+		SandboxCode: v.code,
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, &resp)
+}
+
+func (s *Server) checkVerification(w http.ResponseWriter, r *http.Request, id sendly.VerificationID) {
+	var req sendly.CheckVerificationRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	v, ok := s.verifications[id]
+	if !ok {
+		s.mu.Unlock()
+		notFound(w)
+		return
+	}
+	s.expireVerification(v)
+
+	var eventType sendly.WebhookEventType
+	resp := sendly.CheckVerificationResponse{ID: v.ID, Phone: v.Phone}
+	switch {
+	case v.Status != sendly.VerificationStatusPending:
+		resp.Status = v.Status
+	case req.Code == v.code:
+		v.Attempts++
+		v.Status = sendly.VerificationStatusVerified
+		at := time.Now().UTC()
+		v.VerifiedAt = &at
+		resp.Status = v.Status
+		resp.VerifiedAt = &at
+		eventType = sendly.WebhookEventVerifyCompleted
+	default:
+		v.Attempts++
+		resp.Status = sendly.VerificationStatusInvalid
+		resp.RemainingAttempts = v.MaxAttempts - v.Attempts
+		if resp.RemainingAttempts <= 0 {
+			v.Status = sendly.VerificationStatusFailed
+			resp.Status = v.Status
+			eventType = sendly.WebhookEventVerifyFailed
+		}
+	}
+	data := verifyEventData(&v.Verification)
+	s.mu.Unlock()
+
+	if eventType != "" {
+		s.Emit(eventType, data)
+	}
+	writeJSON(w, http.StatusOK, &resp)
+}
+
+// expireVerification marks a pending verification expired once its
+// deadline passes. Callers hold s.mu.
+func (s *Server) expireVerification(v *verification) {
+	if v.Status == sendly.VerificationStatusPending && time.Now().After(v.ExpiresAt) {
+		v.Status = sendly.VerificationStatusExpired
+	}
+}
+
+func verifyEventData(v *sendly.Verification) *sendly.VerifyEventData {
+	data := &sendly.VerifyEventData{
+		VerificationID: v.ID,
+		Status:         v.Status,
+		Phone:          v.Phone,
+		Attempts:       v.Attempts,
+		ExpiresAt:      v.ExpiresAt.Format(time.RFC3339),
+		Sandbox:        true,
+	}
+	if v.VerifiedAt != nil {
+		data.VerifiedAt = v.VerifiedAt.Format(time.RFC3339)
+	}
+	return data
+}
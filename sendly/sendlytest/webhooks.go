@@ -0,0 +1,253 @@
+package sendlytest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/SendlyHQ/sendly-go/v3/sendly"
+)
+
+// webhook mirrors the API's snake_case webhook representation.
+type webhook struct {
+	ID           string                 `json:"id"`
+	URL          string                 `json:"url"`
+	Events       []string               `json:"events"`
+	Description  *string                `json:"description,omitempty"`
+	Mode         sendly.WebhookMode     `json:"mode"`
+	IsActive     bool                   `json:"is_active"`
+	CircuitState sendly.CircuitState    `json:"circuit_state"`
+	APIVersion   string                 `json:"api_version"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt    string                 `json:"created_at"`
+	UpdatedAt    string                 `json:"updated_at"`
+	// Secret is only serialized in create responses.
+	Secret string `json:"secret,omitempty"`
+
+	secret string
+}
+
+// subscribed reports whether the webhook receives events of eventType,
+// either exactly, through a "category.*" wildcard, or through "*".
+func (wh *webhook) subscribed(eventType sendly.WebhookEventType) bool {
+	category, _, _ := strings.Cut(string(eventType), ".")
+	for _, e := range wh.Events {
+		if e == "*" || e == string(eventType) || e == category+".*" {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookSecret returns the signing secret of a webhook, or "" if the ID is
+// unknown.
+func (s *Server) WebhookSecret(webhookID string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if wh, ok := s.webhooks[webhookID]; ok {
+		return wh.secret
+	}
+	return ""
+}
+
+func newWebhookSecret() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return "whsec_" + hex.EncodeToString(b)
+}
+
+func (s *Server) serveWebhooks(w http.ResponseWriter, r *http.Request, parts []string) {
+	switch {
+	case len(parts) == 0 && r.Method == "GET":
+		s.mu.Lock()
+		resp := []webhook{}
+		for _, id := range s.webhookOrder {
+			resp = append(resp, *s.webhooks[id])
+		}
+		s.mu.Unlock()
+		writeJSON(w, http.StatusOK, resp)
+	case len(parts) == 0 && r.Method == "POST":
+		s.createWebhook(w, r)
+	case len(parts) == 1 && r.Method == "GET":
+		s.mu.Lock()
+		wh, ok := s.webhooks[parts[0]]
+		var resp webhook
+		if ok {
+			resp = *wh
+		}
+		s.mu.Unlock()
+		if !ok {
+			notFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, &resp)
+	case len(parts) == 1 && r.Method == "PATCH":
+		s.updateWebhook(w, r, parts[0])
+	case len(parts) == 1 && r.Method == "DELETE":
+		s.deleteWebhook(w, parts[0])
+	case len(parts) == 2 && parts[1] == "test" && r.Method == "POST":
+		s.testWebhook(w, parts[0])
+	case len(parts) == 2 && parts[1] == "rotate-secret" && r.Method == "POST":
+		s.rotateWebhookSecret(w, parts[0])
+	default:
+		notFound(w)
+	}
+}
+
+func (s *Server) createWebhook(w http.ResponseWriter, r *http.Request) {
+	var req sendly.CreateWebhookRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.URL == "" || len(req.Events) == 0 {
+		writeError(w, http.StatusBadRequest, "invalid_parameter", "url and events are required")
+		return
+	}
+	mode := req.Mode
+	if mode == "" {
+		mode = sendly.WebhookModeAll
+	}
+
+	s.mu.Lock()
+	at := now()
+	wh := &webhook{
+		ID:           s.nextID("whk"),
+		URL:          req.URL,
+		Events:       req.Events,
+		Mode:         mode,
+		IsActive:     true,
+		CircuitState: sendly.CircuitStateClosed,
+		APIVersion:   "v1",
+		Metadata:     req.Metadata,
+		CreatedAt:    at,
+		UpdatedAt:    at,
+		secret:       newWebhookSecret(),
+	}
+	if req.Description != "" {
+		wh.Description = &req.Description
+	}
+	s.webhooks[wh.ID] = wh
+	s.webhookOrder = append(s.webhookOrder, wh.ID)
+	resp := *wh
+	resp.Secret = wh.secret
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, &resp)
+}
+
+func (s *Server) updateWebhook(w http.ResponseWriter, r *http.Request, id string) {
+	var req sendly.UpdateWebhookRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	wh, ok := s.webhooks[id]
+	if !ok {
+		s.mu.Unlock()
+		notFound(w)
+		return
+	}
+	if req.URL != nil {
+		wh.URL = *req.URL
+	}
+	if req.Events != nil {
+		wh.Events = req.Events
+	}
+	if req.Description != nil {
+		wh.Description = req.Description
+	}
+	if req.IsActive != nil {
+		wh.IsActive = *req.IsActive
+	}
+	if req.Mode != nil {
+		wh.Mode = *req.Mode
+	}
+	if req.Metadata != nil {
+		wh.Metadata = req.Metadata
+	}
+	wh.UpdatedAt = now()
+	resp := *wh
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, &resp)
+}
+
+func (s *Server) deleteWebhook(w http.ResponseWriter, id string) {
+	s.mu.Lock()
+	_, ok := s.webhooks[id]
+	if ok {
+		delete(s.webhooks, id)
+		for i, wid := range s.webhookOrder {
+			if wid == id {
+				s.webhookOrder = append(s.webhookOrder[:i], s.webhookOrder[i+1:]...)
+				break
+			}
+		}
+	}
+	s.mu.Unlock()
+	if !ok {
+		notFound(w)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) testWebhook(w http.ResponseWriter, id string) {
+	s.mu.Lock()
+	wh, ok := s.webhooks[id]
+	var target webhook
+	if ok {
+		target = *wh
+	}
+	s.mu.Unlock()
+	if !ok {
+		notFound(w)
+		return
+	}
+
+	body := `{"id":"evt_test","type":"webhook.test","created_at":"` + now() + `","api_version":"v1","data":{}}`
+	start := time.Now()
+	status, err := s.deliver(target, []byte(body))
+	elapsed := int(time.Since(start).Milliseconds())
+
+	result := sendly.WebhookTestResult{
+		Success:        err == nil && status < 300,
+		ResponseTimeMs: &elapsed,
+	}
+	if err != nil {
+		msg := err.Error()
+		result.Error = &msg
+	} else {
+		result.StatusCode = &status
+	}
+	writeJSON(w, http.StatusOK, &result)
+}
+
+func (s *Server) rotateWebhookSecret(w http.ResponseWriter, id string) {
+	s.mu.Lock()
+	wh, ok := s.webhooks[id]
+	if !ok {
+		s.mu.Unlock()
+		notFound(w)
+		return
+	}
+	wh.secret = newWebhookSecret()
+	wh.UpdatedAt = now()
+	resp := struct {
+		Webhook            webhook `json:"webhook"`
+		NewSecret          string  `json:"new_secret"`
+		OldSecretExpiresAt string  `json:"old_secret_expires_at"`
+		Message            string  `json:"message"`
+	}{
+		Webhook:            *wh,
+		NewSecret:          wh.secret,
+		OldSecretExpiresAt: now(),
+		Message:            "secret rotated",
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, &resp)
+}
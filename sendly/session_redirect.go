@@ -0,0 +1,132 @@
+package sendly
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// SessionRedirectTolerance is how old a signed session redirect may be
+// before ValidateRedirect rejects it.
+const SessionRedirectTolerance = 5 * time.Minute
+
+var (
+	// ErrInvalidRedirectSignature is returned when a session redirect is
+	// unsigned or its signature does not match.
+	ErrInvalidRedirectSignature = errors.New("invalid session redirect signature")
+	// ErrRedirectExpired is returned when a session redirect's timestamp is
+	// older than SessionRedirectTolerance.
+	ErrRedirectExpired = errors.New("session redirect has expired")
+	// ErrRedirectReplayed is returned by ValidateRedirectOnce when the
+	// session's redirect has already been accepted.
+	ErrRedirectReplayed = errors.New("session redirect has already been used")
+)
+
+// SessionRedirect holds the verified parameters Sendly appended to a
+// session's SuccessURL.
+type SessionRedirect struct {
+	SessionID VerifySessionID
+	Token     string
+	Phone     string
+	Timestamp time.Time
+	// Params contains every query parameter on the redirect URL, including
+	// any the application put on SuccessURL itself.
+	Params url.Values
+}
+
+// ValidateRedirect verifies the signature Sendly appends to a session's
+// SuccessURL and returns its parameters. The signature is an HMAC-SHA256,
+// keyed with signingKey, over "<timestamp>.<query>" where query is every
+// parameter except signature, sorted by key and URL-encoded.
+//
+// ValidateRedirect only proves that Sendly issued the URL within
+// SessionRedirectTolerance. It does not stop the same URL, leaked through
+// browser history, a Referer header or proxy logs, from being replayed
+// within that window; use ValidateRedirectOnce before logging a user in.
+func (s *SessionsService) ValidateRedirect(rawURL, signingKey string) (*SessionRedirect, error) {
+	return validateSessionRedirect(rawURL, signingKey, time.Now())
+}
+
+// ValidateRedirectOnce is ValidateRedirect plus single-use enforcement: the
+// session ID is recorded in store until the redirect expires, and a second
+// redirect for the same session fails with ErrRedirectReplayed. A redirect
+// accepted this way can be trusted without calling Validate. The store must
+// be shared by every replica that serves the SuccessURL.
+//
+// Example:
+//
+//	redirect, err := client.Verify.Sessions.ValidateRedirectOnce(ctx, r.URL.String(), signingKey, nonces)
+//	if err != nil {
+//	    http.Error(w, "invalid redirect", http.StatusUnauthorized)
+//	    return
+//	}
+//	loginUser(redirect.Phone)
+func (s *SessionsService) ValidateRedirectOnce(ctx context.Context, rawURL, signingKey string, store NonceStore) (*SessionRedirect, error) {
+	if store == nil {
+		return nil, invalidParamError("store", "nonce store is required")
+	}
+	redirect, err := validateSessionRedirect(rawURL, signingKey, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	added, err := store.Add(ctx, string(redirect.SessionID), redirect.Timestamp.Add(SessionRedirectTolerance))
+	if err != nil {
+		return nil, err
+	}
+	if !added {
+		return nil, ErrRedirectReplayed
+	}
+	return redirect, nil
+}
+
+func validateSessionRedirect(rawURL, signingKey string, now time.Time) (*SessionRedirect, error) {
+	if signingKey == "" {
+		return nil, invalidParamError("signing_key", "signing key is required")
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, invalidParamError("url", "invalid redirect URL")
+	}
+
+	params := u.Query()
+	signature := params.Get("signature")
+	timestamp := params.Get("timestamp")
+	if signature == "" || timestamp == "" || params.Get("session_id") == "" {
+		return nil, ErrInvalidRedirectSignature
+	}
+
+	signed := make(url.Values, len(params))
+	for k, v := range params {
+		if k != "signature" {
+			signed[k] = v
+		}
+	}
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	mac.Write([]byte(timestamp + "." + signed.Encode()))
+	expected := hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return nil, ErrInvalidRedirectSignature
+	}
+
+	secs, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidRedirectSignature
+	}
+	ts := time.Unix(secs, 0)
+	if age := now.Sub(ts); age > SessionRedirectTolerance || age < -SessionRedirectTolerance {
+		return nil, ErrRedirectExpired
+	}
+
+	return &SessionRedirect{
+		SessionID: VerifySessionID(params.Get("session_id")),
+		Token:     params.Get("token"),
+		Phone:     params.Get("phone"),
+		Timestamp: ts,
+		Params:    params,
+	}, nil
+}
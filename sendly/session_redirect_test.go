@@ -0,0 +1,88 @@
+package sendly
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func signRedirect(base string, params url.Values, key string, ts time.Time) string {
+	params.Set("timestamp", strconv.FormatInt(ts.Unix(), 10))
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(params.Get("timestamp") + "." + params.Encode()))
+	params.Set("signature", hex.EncodeToString(mac.Sum(nil)))
+	return base + "?" + params.Encode()
+}
+
+func TestValidateSessionRedirect(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	params := func() url.Values {
+		return url.Values{"session_id": {"vs_123"}, "token": {"tok_abc"}, "phone": {"+15551234567"}, "next": {"/dashboard"}}
+	}
+	valid := signRedirect("https://app.example.com/verified", params(), "key", now)
+
+	tests := []struct {
+		name    string
+		url     string
+		key     string
+		wantErr error
+	}{
+		{name: "valid", url: valid, key: "key"},
+		{name: "wrong key", url: valid, key: "other", wantErr: ErrInvalidRedirectSignature},
+		{name: "tampered", url: valid + "&phone=%2B15550000000", key: "key", wantErr: ErrInvalidRedirectSignature},
+		{name: "unsigned", url: "https://app.example.com/verified?session_id=vs_123", key: "key", wantErr: ErrInvalidRedirectSignature},
+		{name: "expired", url: signRedirect("https://app.example.com/verified", params(), "key", now.Add(-10*time.Minute)), key: "key", wantErr: ErrRedirectExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			redirect, err := validateSessionRedirect(tt.url, tt.key, now)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if redirect.SessionID != "vs_123" || redirect.Token != "tok_abc" || redirect.Phone != "+15551234567" {
+				t.Errorf("unexpected redirect: %+v", redirect)
+			}
+			if redirect.Params.Get("next") != "/dashboard" {
+				t.Errorf("expected next to be '/dashboard', got '%s'", redirect.Params.Get("next"))
+			}
+		})
+	}
+}
+
+func TestSessionsService_ValidateRedirectOnce(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+	store := NewMemoryNonceStore(0)
+	params := url.Values{"session_id": {"vs_123"}, "token": {"tok_abc"}, "phone": {"+15551234567"}}
+	redirectURL := signRedirect("https://app.example.com/verified", params, "key", time.Now())
+
+	redirect, err := client.Verify.Sessions.ValidateRedirectOnce(ctx, redirectURL, "key", store)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if redirect.SessionID != "vs_123" {
+		t.Errorf("expected session vs_123, got %s", redirect.SessionID)
+	}
+	if _, err := client.Verify.Sessions.ValidateRedirectOnce(ctx, redirectURL, "key", store); !errors.Is(err, ErrRedirectReplayed) {
+		t.Errorf("expected ErrRedirectReplayed on replay, got %v", err)
+	}
+	if _, err := client.Verify.Sessions.ValidateRedirectOnce(ctx, redirectURL+"x", "key", store); !errors.Is(err, ErrInvalidRedirectSignature) {
+		t.Errorf("expected a bad signature to fail before the store, got %v", err)
+	}
+	if _, err := client.Verify.Sessions.ValidateRedirectOnce(ctx, redirectURL, "key", nil); !IsValidationError(err) {
+		t.Errorf("expected validation error for a nil store, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package sendly
+
+import (
+	"context"
+	"time"
+)
+
+// SettingsService manages account-wide defaults.
+type SettingsService struct {
+	client *Client
+}
+
+// AccountSettings are defaults applied when a request does not specify its
+// own value.
+type AccountSettings struct {
+	// DefaultSender is the sender ID or number used when From is empty.
+	DefaultSender string `json:"default_sender,omitempty"`
+	// OTPLength is the default verification code length (4-10).
+	OTPLength int `json:"otp_length"`
+	// OTPTTL is the default verification code lifetime. It is sent in
+	// whole seconds.
+	OTPTTL time.Duration `json:"-"`
+	// WebhookAPIVersion is the payload version used by new webhooks.
+	WebhookAPIVersion string `json:"webhook_api_version"`
+	// Locale is the default BCP 47 locale for verification messages and
+	// templates.
+	Locale    string `json:"locale"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// UpdateSettingsRequest represents the parameters for updating account
+// settings. Nil fields are left unchanged, so applying the same request
+// twice has no further effect.
+type UpdateSettingsRequest struct {
+	DefaultSender *string `json:"default_sender,omitempty"`
+	OTPLength     *int    `json:"otp_length,omitempty"`
+	// OTPTTL is sent in whole seconds and must be at least a second.
+	OTPTTL            *time.Duration `json:"-"`
+	WebhookAPIVersion *string        `json:"webhook_api_version,omitempty"`
+	Locale            *string        `json:"locale,omitempty"`
+}
+
+// Get retrieves the account settings.
+func (s *SettingsService) Get(ctx context.Context) (*AccountSettings, error) {
+	var resp AccountSettings
+	if err := s.client.request(ctx, "GET", "/settings", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Update changes the account settings and returns the result.
+func (s *SettingsService) Update(ctx context.Context, req *UpdateSettingsRequest) (*AccountSettings, error) {
+	if req == nil {
+		return nil, &ValidationError{APIError: APIError{Message: "request is required"}}
+	}
+	if req.OTPLength != nil && (*req.OTPLength < 4 || *req.OTPLength > 10) {
+		return nil, invalidParamError("otp_length", "OTP length must be between 4 and 10")
+	}
+	if req.OTPTTL != nil && *req.OTPTTL < time.Second {
+		return nil, invalidParamError("otp_ttl_seconds", "OTP TTL must be at least one second")
+	}
+
+	var resp AccountSettings
+	if err := s.client.request(ctx, "PATCH", "/settings", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
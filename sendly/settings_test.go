@@ -0,0 +1,51 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSettingsService_Update(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/settings" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if _, ok := body["locale"]; ok {
+			t.Error("expected unset locale to be omitted")
+		}
+		if body["otp_length"] != float64(8) {
+			t.Errorf("expected otp_length to be 8, got %v", body["otp_length"])
+		}
+		if body["otp_ttl_seconds"] != float64(300) {
+			t.Errorf("expected otp_ttl_seconds to be 300, got %v", body["otp_ttl_seconds"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"default_sender":"ACME","otp_length":8,"otp_ttl_seconds":300,"locale":"en"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	length, ttl := 8, 5*time.Minute
+	settings, err := client.Settings.Update(context.Background(), &UpdateSettingsRequest{OTPLength: &length, OTPTTL: &ttl})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if settings.OTPLength != 8 || settings.OTPTTL != 5*time.Minute || settings.DefaultSender != "ACME" {
+		t.Errorf("unexpected settings: %+v", settings)
+	}
+
+	tooShort := 3
+	if _, err := client.Settings.Update(context.Background(), &UpdateSettingsRequest{OTPLength: &tooShort}); !IsValidationError(err) {
+		t.Errorf("expected validation error for short OTP, got %v", err)
+	}
+	subSecond := 500 * time.Millisecond
+	if _, err := client.Settings.Update(context.Background(), &UpdateSettingsRequest{OTPTTL: &subSecond}); !IsValidationError(err) {
+		t.Errorf("expected validation error for sub-second TTL, got %v", err)
+	}
+}
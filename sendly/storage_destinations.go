@@ -0,0 +1,89 @@
+package sendly
+
+import "strings"
+
+// StorageProvider is a cloud object store that exports and reports can be
+// written to.
+type StorageProvider string
+
+const (
+	StorageS3  StorageProvider = "s3"
+	StorageGCS StorageProvider = "gcs"
+)
+
+// StorageDestination is a customer-owned bucket that Sendly writes a file to
+// directly, so large exports never pass through the application. Grant
+// access in one of three ways:
+//
+//   - S3: RoleARN (preferred; Sendly assumes the role) or an
+//     AccessKeyID/SecretAccessKey pair.
+//   - GCS: ServiceAccountEmail (preferred; Sendly impersonates it) or a
+//     ServiceAccountKey JSON document.
+//   - Either provider: UploadURL, a presigned S3 PUT URL or GCS signed URL
+//     for a single object. Bucket and credentials are then not needed.
+//
+// Credentials are write-only: they are never returned by the API.
+type StorageDestination struct {
+	Provider StorageProvider `json:"provider"`
+	Bucket   string          `json:"bucket,omitempty"`
+	// Prefix is prepended to object names.
+	Prefix string `json:"prefix,omitempty"`
+	// Region is the S3 bucket region.
+	Region string `json:"region,omitempty"`
+
+	RoleARN         string `json:"role_arn,omitempty"`
+	AccessKeyID     string `json:"access_key_id,omitempty"`
+	SecretAccessKey string `json:"secret_access_key,omitempty"`
+
+	ServiceAccountEmail string `json:"service_account_email,omitempty"`
+	ServiceAccountKey   string `json:"service_account_key,omitempty"`
+
+	UploadURL string `json:"upload_url,omitempty"`
+}
+
+// validate checks that d names a provider and exactly one way to write to
+// it. param prefixes the names in returned errors.
+func (d *StorageDestination) validate(param string) error {
+	switch d.Provider {
+	case StorageS3, StorageGCS:
+	default:
+		return invalidParamError(param+".provider", "provider must be s3 or gcs")
+	}
+
+	if d.UploadURL != "" {
+		if !strings.HasPrefix(d.UploadURL, "https://") {
+			return invalidParamError(param+".upload_url", "upload URL must be HTTPS")
+		}
+		return nil
+	}
+	if d.Bucket == "" {
+		return invalidParamError(param+".bucket", "bucket is required unless upload_url is set")
+	}
+
+	if d.Provider == StorageS3 {
+		if d.ServiceAccountEmail != "" || d.ServiceAccountKey != "" {
+			return invalidParamError(param, "service account credentials are only valid for GCS")
+		}
+		if (d.AccessKeyID == "") != (d.SecretAccessKey == "") {
+			return invalidParamError(param+".access_key_id", "access_key_id and secret_access_key must be set together")
+		}
+		if d.RoleARN == "" && d.AccessKeyID == "" {
+			return invalidParamError(param+".role_arn", "role_arn or access keys are required for S3")
+		}
+		if d.RoleARN != "" && d.AccessKeyID != "" {
+			return invalidParamError(param+".role_arn", "role_arn and access keys are mutually exclusive")
+		}
+		return nil
+	}
+
+	if d.RoleARN != "" || d.AccessKeyID != "" || d.SecretAccessKey != "" {
+		return invalidParamError(param, "role_arn and access keys are only valid for S3")
+	}
+	if d.ServiceAccountEmail == "" && d.ServiceAccountKey == "" {
+		return invalidParamError(param+".service_account_email", "service_account_email or service_account_key is required for GCS")
+	}
+	if d.ServiceAccountEmail != "" && d.ServiceAccountKey != "" {
+		return invalidParamError(param+".service_account_email", "service_account_email and service_account_key are mutually exclusive")
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package sendly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// SuppressionsService manages the suppression list: numbers that have opted
+// out (for example by replying STOP) or were blocked manually. Sends to
+// suppressed numbers are rejected by the API.
+type SuppressionsService struct {
+	client *Client
+}
+
+// SuppressionReason records why a number is suppressed.
+type SuppressionReason string
+
+const (
+	// SuppressionOptOut is set when the recipient replied with an opt-out
+	// keyword such as STOP.
+	SuppressionOptOut SuppressionReason = "opt_out"
+	// SuppressionManual is set for numbers added through the API or dashboard.
+	SuppressionManual SuppressionReason = "manual"
+	// SuppressionComplaint is set when a carrier reported a spam complaint.
+	SuppressionComplaint SuppressionReason = "complaint"
+)
+
+// Suppression is a suppressed phone number.
+type Suppression struct {
+	// Phone is the suppressed number in E.164 format.
+	Phone  string            `json:"phone"`
+	Reason SuppressionReason `json:"reason"`
+	// Keyword is the opt-out keyword the recipient sent, for opt_out entries.
+	Keyword   string `json:"keyword,omitempty"`
+	Note      string `json:"note,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+// AddSuppressionRequest represents the parameters for suppressing a number.
+type AddSuppressionRequest struct {
+	// Phone is the number to suppress in E.164 format (required).
+	Phone string `json:"phone"`
+	// Reason defaults to SuppressionManual.
+	Reason SuppressionReason `json:"reason,omitempty"`
+	Note   string            `json:"note,omitempty"`
+}
+
+// SuppressionListOptions are options for listing suppressions.
+type SuppressionListOptions struct {
+	// Limit is the maximum number of entries per page (default: 50, max: 200).
+	Limit int
+	// Cursor continues from a previous page's NextCursor.
+	Cursor string
+	// Reason filters by suppression reason.
+	Reason SuppressionReason
+	// Since filters to entries created at or after this time (ISO 8601).
+	Since string
+}
+
+// SuppressionListResponse is a page of suppressions.
+type SuppressionListResponse struct {
+	Data       []Suppression `json:"data"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+	HasMore    bool          `json:"has_more"`
+}
+
+// List retrieves a page of suppressed numbers, newest first.
+func (s *SuppressionsService) List(ctx context.Context, opts *SuppressionListOptions) (*SuppressionListResponse, error) {
+	params := make(map[string]string)
+	if opts != nil {
+		if opts.Limit > 0 {
+			params["limit"] = strconv.Itoa(opts.Limit)
+		}
+		params["cursor"] = opts.Cursor
+		params["reason"] = string(opts.Reason)
+		params["since"] = opts.Since
+	}
+
+	var resp SuppressionListResponse
+	if err := s.client.request(ctx, "GET", "/suppressions"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAll returns every suppression matching opts as an Iter, fetching
+// pages as needed.
+func (s *SuppressionsService) ListAll(ctx context.Context, opts *SuppressionListOptions) Iter[Suppression] {
+	var o SuppressionListOptions
+	if opts != nil {
+		o = *opts
+	}
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[Suppression], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[Suppression]{Items: resp.Data}
+		if resp.HasMore {
+			page.NextCursor = resp.NextCursor
+		}
+		return page, nil
+	}).Iter()
+}
+
+// Get retrieves the suppression entry for a number. It returns a
+// NotFoundError if the number is not suppressed.
+func (s *SuppressionsService) Get(ctx context.Context, phone string) (*Suppression, error) {
+	if phone == "" {
+		return nil, invalidParamError("phone", "phone number is required")
+	}
+
+	var resp Suppression
+	if err := s.client.request(ctx, "GET", "/suppressions/"+url.PathEscape(phone), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Check reports whether a number is suppressed, returning its entry if so.
+func (s *SuppressionsService) Check(ctx context.Context, phone string) (*Suppression, bool, error) {
+	suppression, err := s.Get(ctx, phone)
+	if IsNotFound(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return suppression, true, nil
+}
+
+// Add suppresses a number. Adding a number that is already suppressed
+// returns the existing entry.
+func (s *SuppressionsService) Add(ctx context.Context, req *AddSuppressionRequest) (*Suppression, error) {
+	if req == nil || req.Phone == "" {
+		return nil, invalidParamError("phone", "phone number is required")
+	}
+
+	var resp Suppression
+	if err := s.client.request(ctx, "POST", "/suppressions", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Remove lifts the suppression on a number. Only remove opt_out entries
+// when the recipient has opted back in.
+func (s *SuppressionsService) Remove(ctx context.Context, phone string) error {
+	if phone == "" {
+		return invalidParamError("phone", "phone number is required")
+	}
+	return s.client.request(ctx, "DELETE", "/suppressions/"+url.PathEscape(phone), nil, nil)
+}
+
+// SuppressedError is returned by Messages.Send when FailIfSuppressed is set
+// and the recipient is on the suppression list.
+type SuppressedError struct {
+	APIError
+	Suppression *Suppression
+}
+
+func (e *SuppressedError) Error() string {
+	return fmt.Sprintf("sendly: recipient is suppressed: %s", e.Message)
+}
+
+func (e *SuppressedError) Unwrap() error {
+	return e.APIError.toError(0)
+}
+
+// IsSuppressedError checks if the error is a SuppressedError.
+func IsSuppressedError(err error) bool {
+	var target *SuppressedError
+	return errors.As(err, &target)
+}
+
+// checkSuppressed returns a SuppressedError if to is on the suppression list.
+func (c *Client) checkSuppressed(ctx context.Context, to string) error {
+	suppression, suppressed, err := c.Suppressions.Check(ctx, to)
+	if err != nil || !suppressed {
+		return err
+	}
+	return &SuppressedError{
+		APIError: APIError{
+			Code:    "RECIPIENT_SUPPRESSED",
+			Message: fmt.Sprintf("%s is suppressed (%s)", to, suppression.Reason),
+			Param:   "to",
+		},
+		Suppression: suppression,
+	}
+}
@@ -0,0 +1,71 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMessagesService_SendFailIfSuppressed(t *testing.T) {
+	sent := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/suppressions/+15550000001":
+			w.Write([]byte(`{"phone":"+15550000001","reason":"opt_out","keyword":"STOP"}`))
+		case r.Method == "GET":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":"NOT_FOUND","message":"not suppressed"}`))
+		case r.Method == "POST" && r.URL.Path == "/messages":
+			sent = true
+			w.Write([]byte(`{"id":"msg_1","status":"queued"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	_, err := client.Messages.Send(ctx, &SendMessageRequest{To: "+15550000001", Text: "Hi", FailIfSuppressed: true})
+	if !IsSuppressedError(err) || !HasErrorCode(err, "RECIPIENT_SUPPRESSED") {
+		t.Fatalf("expected suppressed error, got %v", err)
+	}
+	if sent {
+		t.Error("expected suppressed message not to be sent")
+	}
+
+	msg, err := client.Messages.Send(ctx, &SendMessageRequest{To: "+15550000002", Text: "Hi", FailIfSuppressed: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !sent || msg.ID != "msg_1" {
+		t.Errorf("expected message to be sent, got %+v", msg)
+	}
+}
+
+func TestMessagesService_SendFailIfSuppressedIdempotencyKey(t *testing.T) {
+	keys := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys[r.Method] = r.Header.Get("Idempotency-Key")
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":"NOT_FOUND","message":"not suppressed"}`))
+			return
+		}
+		w.Write([]byte(`{"id":"msg_1","status":"queued"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := WithIdempotencyKey(context.Background(), "send-1")
+	if _, err := client.Messages.Send(ctx, &SendMessageRequest{To: "+15550000002", Text: "Hi", FailIfSuppressed: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keys["GET"] != "" || keys["POST"] != "send-1" {
+		t.Errorf("expected the key on the POST only, got %v", keys)
+	}
+}
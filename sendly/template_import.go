@@ -0,0 +1,155 @@
+package sendly
+
+import "context"
+
+// TemplateExport is the portable form of a template, used to copy templates
+// between accounts. Templates are matched by Name, since IDs differ between
+// accounts.
+type TemplateExport struct {
+	Name      string             `json:"name"`
+	Text      string             `json:"text"`
+	Locale    string             `json:"locale,omitempty"`
+	Variables []TemplateVariable `json:"variables,omitempty"`
+	// Translations are the template's other locales.
+	Translations []TemplateTranslationExport `json:"translations,omitempty"`
+	// Status is the state of the template in the source account.
+	Status TemplateStatus `json:"status,omitempty"`
+}
+
+// TemplateTranslationExport is the text of an exported template in one
+// locale.
+type TemplateTranslationExport struct {
+	Locale string `json:"locale"`
+	Text   string `json:"text"`
+}
+
+// ImportConflictStrategy decides what Import does with a template whose
+// name already exists in the account.
+type ImportConflictStrategy string
+
+const (
+	// ImportConflictFail aborts the whole import without changing anything.
+	ImportConflictFail ImportConflictStrategy = "fail"
+	// ImportConflictSkip leaves the existing template unchanged.
+	ImportConflictSkip ImportConflictStrategy = "skip"
+	// ImportConflictOverwrite replaces the existing template's text,
+	// variables and translations, creating a new version.
+	ImportConflictOverwrite ImportConflictStrategy = "overwrite"
+)
+
+// ImportOptions are options for importing templates.
+type ImportOptions struct {
+	// DryRun reports what would change without changing anything.
+	DryRun bool `json:"dry_run,omitempty"`
+	// OnConflict defaults to ImportConflictFail.
+	OnConflict ImportConflictStrategy `json:"on_conflict,omitempty"`
+	// Publish publishes created and updated templates. Otherwise they are
+	// left as drafts.
+	Publish bool `json:"publish,omitempty"`
+}
+
+// TemplateImportAction is what Import did, or would do, with one template.
+type TemplateImportAction string
+
+const (
+	TemplateImportCreated   TemplateImportAction = "created"
+	TemplateImportUpdated   TemplateImportAction = "updated"
+	TemplateImportUnchanged TemplateImportAction = "unchanged"
+	TemplateImportSkipped   TemplateImportAction = "skipped"
+	TemplateImportConflict  TemplateImportAction = "conflict"
+	TemplateImportFailed    TemplateImportAction = "failed"
+)
+
+// TemplateImportItem is the outcome for one imported template.
+type TemplateImportItem struct {
+	Name   string               `json:"name"`
+	Action TemplateImportAction `json:"action"`
+	// TemplateID is the template in the destination account. It is empty
+	// for templates a dry run would create.
+	TemplateID string `json:"template_id,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// TemplateImportResult summarizes an import.
+type TemplateImportResult struct {
+	DryRun    bool                 `json:"dry_run"`
+	Created   int                  `json:"created"`
+	Updated   int                  `json:"updated"`
+	Unchanged int                  `json:"unchanged"`
+	Skipped   int                  `json:"skipped"`
+	Failed    int                  `json:"failed"`
+	Items     []TemplateImportItem `json:"items"`
+}
+
+// Changed reports whether the import created or updated any template.
+func (r *TemplateImportResult) Changed() bool {
+	return r.Created > 0 || r.Updated > 0
+}
+
+// Export returns every template in the account, excluding presets, in
+// portable form.
+func (s *TemplatesService) Export(ctx context.Context) ([]TemplateExport, error) {
+	var resp struct {
+		Templates []TemplateExport `json:"templates"`
+	}
+	if err := s.client.request(ctx, "GET", "/templates/export", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Templates, nil
+}
+
+// Import creates or updates templates from an Export of another account,
+// matching them by name. With ImportConflictFail (the default) an import
+// that would overwrite a template fails with a conflict error and changes
+// nothing.
+//
+// Example (promoting staging templates in CI):
+//
+//	templates, err := staging.Templates.Export(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//	plan, err := production.Templates.Import(ctx, templates, sendly.ImportOptions{
+//	    DryRun:     true,
+//	    OnConflict: sendly.ImportConflictOverwrite,
+//	})
+func (s *TemplatesService) Import(ctx context.Context, templates []TemplateExport, opts ImportOptions) (*TemplateImportResult, error) {
+	if len(templates) == 0 {
+		return nil, invalidParamError("templates", "at least one template is required")
+	}
+	switch opts.OnConflict {
+	case "", ImportConflictFail, ImportConflictSkip, ImportConflictOverwrite:
+	default:
+		return nil, invalidParamError("on_conflict", "on_conflict must be fail, skip or overwrite")
+	}
+	seen := make(map[string]bool, len(templates))
+	for _, t := range templates {
+		if t.Name == "" {
+			return nil, invalidParamError("templates", "every template needs a name")
+		}
+		if t.Text == "" {
+			return nil, invalidParamError("templates", "template "+t.Name+" has no text")
+		}
+		if seen[t.Name] {
+			return nil, invalidParamError("templates", "duplicate template name "+t.Name)
+		}
+		seen[t.Name] = true
+	}
+
+	body := struct {
+		Templates []TemplateExport `json:"templates"`
+		ImportOptions
+	}{templates, opts}
+	var resp TemplateImportResult
+	if err := s.client.request(ctx, "POST", "/templates/import", body, &resp); err != nil {
+		return nil, err
+	}
+	if !resp.DryRun {
+		for _, item := range resp.Items {
+			if item.Action == TemplateImportUpdated {
+				s.client.templates.invalidate(item.TemplateID)
+			}
+		}
+	}
+	return &resp, nil
+}
@@ -0,0 +1,75 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTemplatesService_ExportImport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/templates/export":
+			w.Write([]byte(`{"templates":[{"name":"otp","text":"Your code is {{code}}","locale":"en-US","translations":[{"locale":"de-DE","text":"Ihr Code lautet {{code}}"}],"status":"published"}]}`))
+		case r.Method == "POST" && r.URL.Path == "/templates/import":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["dry_run"] != true {
+				t.Errorf("expected dry_run to be true, got %v", body["dry_run"])
+			}
+			if body["on_conflict"] != "overwrite" {
+				t.Errorf("expected on_conflict to be 'overwrite', got '%v'", body["on_conflict"])
+			}
+			if templates, _ := body["templates"].([]interface{}); len(templates) != 1 {
+				t.Errorf("expected 1 template, got %v", body["templates"])
+			}
+			w.Write([]byte(`{"dry_run":true,"updated":1,"items":[{"name":"otp","action":"updated","template_id":"tpl_9"}]}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	templates, err := client.Templates.Export(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(templates) != 1 || templates[0].Name != "otp" || len(templates[0].Translations) != 1 {
+		t.Fatalf("unexpected export: %+v", templates)
+	}
+
+	result, err := client.Templates.Import(ctx, templates, ImportOptions{DryRun: true, OnConflict: ImportConflictOverwrite})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Changed() || result.Items[0].Action != TemplateImportUpdated {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestTemplatesService_ImportValidation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	tests := []struct {
+		templates []TemplateExport
+		opts      ImportOptions
+	}{
+		{nil, ImportOptions{}},
+		{[]TemplateExport{{Name: "a", Text: "x"}}, ImportOptions{OnConflict: "merge"}},
+		{[]TemplateExport{{Text: "x"}}, ImportOptions{}},
+		{[]TemplateExport{{Name: "a"}}, ImportOptions{}},
+		{[]TemplateExport{{Name: "a", Text: "x"}, {Name: "a", Text: "y"}}, ImportOptions{}},
+	}
+	for i, tt := range tests {
+		if _, err := client.Templates.Import(ctx, tt.templates, tt.opts); !IsValidationError(err) {
+			t.Errorf("case %d: expected validation error, got %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// TemplateTranslation is the text of a template in one locale.
+type TemplateTranslation struct {
+	// Locale is a BCP 47 locale tag, e.g. "fr-CA".
+	Locale string `json:"locale"`
+	Text   string `json:"text"`
+	// Default is true for the template's own locale, whose text is used for
+	// sends in locales without a translation.
+	Default   bool      `json:"default"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TemplateLocalesResponse lists the locales a template is available in.
+type TemplateLocalesResponse struct {
+	DefaultLocale string                `json:"default_locale"`
+	Translations  []TemplateTranslation `json:"translations"`
+}
+
+// Locales returns the locale tags of every translation.
+func (r *TemplateLocalesResponse) Locales() []string {
+	locales := make([]string, len(r.Translations))
+	for i, t := range r.Translations {
+		locales[i] = t.Locale
+	}
+	return locales
+}
+
+// ListLocales lists the translations of a template, including its default
+// locale.
+func (s *TemplatesService) ListLocales(ctx context.Context, id string) (*TemplateLocalesResponse, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "template ID is required")
+	}
+
+	var resp TemplateLocalesResponse
+	if err := s.client.request(ctx, "GET", "/templates/"+url.PathEscape(id)+"/translations", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpsertTranslation creates or replaces the text of a template in locale.
+// The translation must use the same variables as the template.
+//
+// Example:
+//
+//	_, err := client.Templates.UpsertTranslation(ctx, "tpl_xxx", "de-DE", "Ihr Code lautet {{code}}")
+//	msg, err := client.Messages.Send(ctx, &sendly.SendMessageRequest{
+//	    To:         "+4915112345678",
+//	    TemplateID: "tpl_xxx",
+//	    Variables:  map[string]string{"code": "123456"},
+//	    Locale:     "de-DE",
+//	})
+func (s *TemplatesService) UpsertTranslation(ctx context.Context, id, locale, text string) (*TemplateTranslation, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "template ID is required")
+	}
+	if locale == "" {
+		return nil, invalidParamError("locale", "locale is required")
+	}
+	if text == "" {
+		return nil, invalidParamError("text", "text is required")
+	}
+
+	body := map[string]string{"text": text}
+	var resp TemplateTranslation
+	path := "/templates/" + url.PathEscape(id) + "/translations/" + url.PathEscape(locale)
+	if err := s.client.request(ctx, "PUT", path, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteTranslation removes a translation. The template's default locale
+// cannot be deleted.
+func (s *TemplatesService) DeleteTranslation(ctx context.Context, id, locale string) error {
+	if id == "" {
+		return invalidParamError("id", "template ID is required")
+	}
+	if locale == "" {
+		return invalidParamError("locale", "locale is required")
+	}
+
+	path := "/templates/" + url.PathEscape(id) + "/translations/" + url.PathEscape(locale)
+	return s.client.request(ctx, "DELETE", path, nil, nil)
+}
@@ -0,0 +1,84 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTemplatesService_Translations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/templates/tpl_1/translations":
+			w.Write([]byte(`{"default_locale":"en-US","translations":[{"locale":"en-US","text":"Your code is {{code}}","default":true},{"locale":"de-DE","text":"Ihr Code lautet {{code}}"}]}`))
+		case r.Method == "PUT" && r.URL.Path == "/templates/tpl_1/translations/fr-FR":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["text"] != "Votre code est {{code}}" {
+				t.Errorf("expected text to be 'Votre code est {{code}}', got '%s'", body["text"])
+			}
+			w.Write([]byte(`{"locale":"fr-FR","text":"Votre code est {{code}}","updated_at":"2025-01-01T00:00:00Z"}`))
+		case r.Method == "DELETE" && r.URL.Path == "/templates/tpl_1/translations/de-DE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	locales, err := client.Templates.ListLocales(ctx, "tpl_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := locales.Locales(); len(got) != 2 || got[1] != "de-DE" || !locales.Translations[0].Default {
+		t.Errorf("unexpected locales: %+v", locales)
+	}
+
+	tr, err := client.Templates.UpsertTranslation(ctx, "tpl_1", "fr-FR", "Votre code est {{code}}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tr.Locale != "fr-FR" {
+		t.Errorf("expected locale to be 'fr-FR', got '%s'", tr.Locale)
+	}
+
+	if err := client.Templates.DeleteTranslation(ctx, "tpl_1", "de-DE"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := client.Templates.UpsertTranslation(ctx, "tpl_1", "", "text"); !IsValidationError(err) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
+
+func TestMessagesSend_Locale(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["locale"] != "de-DE" {
+			t.Errorf("expected locale to be 'de-DE', got '%v'", body["locale"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"msg_1","to":"+4915112345678","status":"queued"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	_, err := client.Messages.Send(ctx, &SendMessageRequest{To: "+4915112345678", TemplateID: "tpl_1", Locale: "de-DE"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = client.Messages.Send(ctx, &SendMessageRequest{To: "+4915112345678", Text: "Hallo", Locale: "de-DE"})
+	if !IsValidationError(err) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
@@ -0,0 +1,214 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTemplateCacheTTL is how long template variable definitions are
+// cached for send-time validation.
+const DefaultTemplateCacheTTL = 5 * time.Minute
+
+// ValidateTemplateVariables checks vars against a template's variable
+// definitions. Variables without a fallback are required, keys not defined
+// by the template are rejected, and values must match the declared type
+// (number, boolean, date, url). It returns a *ValidationError whose Details
+// hold "missing", "unknown" and "invalid" entries.
+func ValidateTemplateVariables(defs []TemplateVariable, vars map[string]string) error {
+	defined := make(map[string]TemplateVariable, len(defs))
+	for _, d := range defs {
+		defined[d.Key] = d
+	}
+
+	var missing, unknown []string
+	invalid := make(map[string]string)
+	for _, d := range defs {
+		value, ok := vars[d.Key]
+		if !ok {
+			if d.Fallback == "" {
+				missing = append(missing, d.Key)
+			}
+			continue
+		}
+		if !validTemplateValue(d.Type, value) {
+			invalid[d.Key] = d.Type
+		}
+	}
+	for key := range vars {
+		if _, ok := defined[key]; !ok {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(missing) == 0 && len(unknown) == 0 && len(invalid) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+	sort.Strings(unknown)
+
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, "missing required: "+strings.Join(missing, ", "))
+	}
+	if len(unknown) > 0 {
+		problems = append(problems, "unknown: "+strings.Join(unknown, ", "))
+	}
+	if len(invalid) > 0 {
+		keys := make([]string, 0, len(invalid))
+		for k := range invalid {
+			keys = append(keys, k+" (expected "+invalid[k]+")")
+		}
+		sort.Strings(keys)
+		problems = append(problems, "invalid: "+strings.Join(keys, ", "))
+	}
+
+	return &ValidationError{APIError: APIError{
+		Code:    "INVALID_TEMPLATE_VARIABLES",
+		Message: "template variables are invalid: " + strings.Join(problems, "; "),
+		Param:   "variables",
+		Details: map[string]interface{}{
+			"missing": missing,
+			"unknown": unknown,
+			"invalid": invalid,
+		},
+	}}
+}
+
+// templatePlaceholder matches a {{variable}} placeholder in template text.
+var templatePlaceholder = regexp.MustCompile(`\{\{\s*(\w+)\s*\}\}`)
+
+// ValidateVariables checks vars against the template locally, without an
+// API call. Placeholders in Text that have no variable definition, as in a
+// draft being edited, are treated as required string variables. See
+// ValidateTemplateVariables.
+func (t *Template) ValidateVariables(vars map[string]string) error {
+	return ValidateTemplateVariables(t.variableDefs(), vars)
+}
+
+// Render substitutes vars into the template text locally, using each
+// variable's fallback when no value is given. It returns the same
+// *ValidationError as ValidateVariables if vars are missing, unknown or of
+// the wrong type. The result should match Preview, but carrier-specific
+// processing such as link shortening is only applied by the API.
+func (t *Template) Render(vars map[string]string) (string, error) {
+	defs := t.variableDefs()
+	if err := ValidateTemplateVariables(defs, vars); err != nil {
+		return "", err
+	}
+	fallbacks := make(map[string]string, len(defs))
+	for _, d := range defs {
+		fallbacks[d.Key] = d.Fallback
+	}
+	return templatePlaceholder.ReplaceAllStringFunc(t.Text, func(m string) string {
+		key := templatePlaceholder.FindStringSubmatch(m)[1]
+		if v, ok := vars[key]; ok {
+			return v
+		}
+		return fallbacks[key]
+	}), nil
+}
+
+// variableDefs returns t.Variables plus a required string definition for
+// every placeholder in t.Text that isn't defined.
+func (t *Template) variableDefs() []TemplateVariable {
+	defs := append([]TemplateVariable(nil), t.Variables...)
+	defined := make(map[string]bool, len(defs))
+	for _, d := range defs {
+		defined[d.Key] = true
+	}
+	for _, m := range templatePlaceholder.FindAllStringSubmatch(t.Text, -1) {
+		if !defined[m[1]] {
+			defined[m[1]] = true
+			defs = append(defs, TemplateVariable{Key: m[1], Type: "string"})
+		}
+	}
+	return defs
+}
+
+// validTemplateValue reports whether value is acceptable for a variable of
+// the given type. Unrecognised types accept any value.
+func validTemplateValue(typ, value string) bool {
+	switch typ {
+	case "number":
+		_, err := strconv.ParseFloat(value, 64)
+		return err == nil
+	case "boolean":
+		_, err := strconv.ParseBool(value)
+		return err == nil
+	case "date":
+		if _, err := time.Parse("2006-01-02", value); err == nil {
+			return true
+		}
+		_, err := time.Parse(time.RFC3339, value)
+		return err == nil
+	case "url":
+		u, err := url.ParseRequestURI(value)
+		return err == nil && u.Scheme != "" && u.Host != ""
+	default:
+		return true
+	}
+}
+
+// templateCache caches template variable definitions by template ID.
+type templateCache struct {
+	mu      sync.Mutex
+	entries map[string]templateCacheEntry
+}
+
+type templateCacheEntry struct {
+	variables []TemplateVariable
+	expires   time.Time
+}
+
+func (c *templateCache) get(id string) ([]TemplateVariable, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[id]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.variables, true
+}
+
+func (c *templateCache) set(id string, variables []TemplateVariable, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]templateCacheEntry)
+	}
+	c.entries[id] = templateCacheEntry{variables: variables, expires: time.Now().Add(ttl)}
+}
+
+func (c *templateCache) invalidate(id string) {
+	c.mu.Lock()
+	delete(c.entries, id)
+	c.mu.Unlock()
+}
+
+// validateTemplateSend validates vars against the cached definition of
+// templateID when template validation is enabled.
+func (c *Client) validateTemplateSend(ctx context.Context, templateID string, vars map[string]string) error {
+	if !c.ValidateTemplates || templateID == "" {
+		return nil
+	}
+
+	defs, ok := c.templates.get(templateID)
+	if !ok {
+		tpl, err := c.Templates.Get(ctx, templateID)
+		if err != nil {
+			return err
+		}
+		defs = tpl.Variables
+		ttl := c.TemplateCacheTTL
+		if ttl <= 0 {
+			ttl = DefaultTemplateCacheTTL
+		}
+		c.templates.set(templateID, defs, ttl)
+	}
+	return ValidateTemplateVariables(defs, vars)
+}
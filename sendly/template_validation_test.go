@@ -0,0 +1,116 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidateTemplateVariables(t *testing.T) {
+	defs := []TemplateVariable{
+		{Key: "name", Type: "string"},
+		{Key: "amount", Type: "number"},
+		{Key: "link", Type: "url", Fallback: "https://example.com"},
+	}
+
+	tests := []struct {
+		name        string
+		vars        map[string]string
+		expectedErr string
+	}{
+		{name: "valid", vars: map[string]string{"name": "Ada", "amount": "12.50"}},
+		{name: "fallback may be omitted", vars: map[string]string{"name": "Ada", "amount": "1", "link": "https://x.io/a"}},
+		{name: "missing required", vars: map[string]string{"name": "Ada"}, expectedErr: "missing required: amount"},
+		{name: "unknown key", vars: map[string]string{"name": "Ada", "amount": "1", "extra": "x"}, expectedErr: "unknown: extra"},
+		{name: "type mismatch", vars: map[string]string{"name": "Ada", "amount": "lots"}, expectedErr: "invalid: amount (expected number)"},
+		{name: "bad url", vars: map[string]string{"name": "Ada", "amount": "1", "link": "not a url"}, expectedErr: "invalid: link (expected url)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTemplateVariables(defs, tt.vars)
+			if tt.expectedErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !IsValidationError(err) {
+				t.Fatalf("expected ValidationError, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.expectedErr) {
+				t.Errorf("expected error to contain '%s', got '%s'", tt.expectedErr, err.Error())
+			}
+			if !HasErrorCode(err, "INVALID_TEMPLATE_VARIABLES") {
+				t.Errorf("expected code INVALID_TEMPLATE_VARIABLES, got %v", err)
+			}
+		})
+	}
+}
+
+func TestMessagesService_Send_TemplateValidation(t *testing.T) {
+	templateFetches, sends := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/templates/tpl_123":
+			templateFetches++
+			json.NewEncoder(w).Encode(Template{ID: "tpl_123", Variables: []TemplateVariable{{Key: "code", Type: "number"}}})
+		case "/messages":
+			sends++
+			json.NewEncoder(w).Encode(Message{ID: "msg_1"})
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithTemplateValidation(0))
+	ctx := context.Background()
+
+	_, err := client.Messages.Send(ctx, &SendMessageRequest{To: "+15551234567", TemplateID: "tpl_123", Variables: map[string]string{"code": "abc"}})
+	if !IsValidationError(err) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	if _, err := client.Messages.Send(ctx, &SendMessageRequest{To: "+15551234567", TemplateID: "tpl_123", Variables: map[string]string{"code": "123456"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if templateFetches != 1 {
+		t.Errorf("expected template to be fetched once, got %d", templateFetches)
+	}
+	if sends != 1 {
+		t.Errorf("expected 1 send, got %d", sends)
+	}
+}
+
+func TestTemplate_Render(t *testing.T) {
+	tpl := &Template{
+		Text: "Hi {{name}}, your total is {{ amount }}. Track at {{link}}",
+		Variables: []TemplateVariable{
+			{Key: "amount", Type: "number"},
+			{Key: "link", Type: "url", Fallback: "https://example.com"},
+		},
+	}
+
+	text, err := tpl.Render(map[string]string{"name": "Ada", "amount": "12.50"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "Hi Ada, your total is 12.50. Track at https://example.com"
+	if text != expected {
+		t.Errorf("expected text to be '%s', got '%s'", expected, text)
+	}
+
+	if _, err := tpl.Render(map[string]string{"amount": "12.50"}); err == nil || !strings.Contains(err.Error(), "missing required: name") {
+		t.Errorf("expected missing name error, got %v", err)
+	}
+	if err := tpl.ValidateVariables(map[string]string{"name": "Ada", "amount": "lots"}); !HasErrorCode(err, "INVALID_TEMPLATE_VARIABLES") {
+		t.Errorf("expected INVALID_TEMPLATE_VARIABLES, got %v", err)
+	}
+	if len(tpl.Variables) != 2 {
+		t.Errorf("expected Variables not to be modified, got %d", len(tpl.Variables))
+	}
+}
@@ -0,0 +1,116 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// TemplateVersion is a snapshot of a template's text and variables. A new
+// version is recorded every time a template is published or rolled back.
+type TemplateVersion struct {
+	TemplateID  string             `json:"template_id"`
+	Version     int                `json:"version"`
+	Text        string             `json:"text"`
+	Variables   []TemplateVariable `json:"variables"`
+	Status      TemplateStatus     `json:"status"`
+	PublishedAt *time.Time         `json:"published_at,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+	// RolledBackFrom is the version this one restored, if it was created by
+	// Rollback.
+	RolledBackFrom int `json:"rolled_back_from,omitempty"`
+}
+
+// TemplateVersionListOptions are options for listing template versions.
+type TemplateVersionListOptions struct {
+	// Limit is the maximum number of versions per page (default: 20, max: 100).
+	Limit int
+	// Cursor continues from a previous page's NextCursor.
+	Cursor string
+}
+
+// TemplateVersionListResponse is a page of template versions, newest first.
+type TemplateVersionListResponse struct {
+	Data       []TemplateVersion `json:"data"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+	HasMore    bool              `json:"has_more"`
+}
+
+// ListVersions retrieves a page of a template's version history, newest
+// first.
+func (s *TemplatesService) ListVersions(ctx context.Context, id string, opts *TemplateVersionListOptions) (*TemplateVersionListResponse, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "template ID is required")
+	}
+
+	params := make(map[string]string)
+	if opts != nil {
+		if opts.Limit > 0 {
+			params["limit"] = strconv.Itoa(opts.Limit)
+		}
+		params["cursor"] = opts.Cursor
+	}
+
+	var resp TemplateVersionListResponse
+	if err := s.client.request(ctx, "GET", "/templates/"+url.PathEscape(id)+"/versions"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAllVersions returns a template's entire version history as an Iter,
+// fetching pages as needed.
+func (s *TemplatesService) ListAllVersions(ctx context.Context, id string) Iter[TemplateVersion] {
+	var o TemplateVersionListOptions
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[TemplateVersion], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.ListVersions(ctx, id, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[TemplateVersion]{Items: resp.Data}
+		if resp.HasMore {
+			page.NextCursor = resp.NextCursor
+		}
+		return page, nil
+	}).Iter()
+}
+
+// GetVersion retrieves one version of a template.
+func (s *TemplatesService) GetVersion(ctx context.Context, id string, version int) (*TemplateVersion, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "template ID is required")
+	}
+	if version <= 0 {
+		return nil, invalidParamError("version", "version must be positive")
+	}
+
+	var resp TemplateVersion
+	if err := s.client.request(ctx, "GET", "/templates/"+url.PathEscape(id)+"/versions/"+strconv.Itoa(version), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Rollback restores the text and variables of an earlier version. History
+// is kept: the restored content is published as a new version, which is
+// returned as the template's current state.
+func (s *TemplatesService) Rollback(ctx context.Context, id string, version int) (*Template, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "template ID is required")
+	}
+	if version <= 0 {
+		return nil, invalidParamError("version", "version must be positive")
+	}
+
+	body := map[string]int{"version": version}
+	var resp Template
+	if err := s.client.request(ctx, "POST", "/templates/"+url.PathEscape(id)+"/rollback", body, &resp); err != nil {
+		return nil, err
+	}
+	s.client.templates.invalidate(id)
+	return &resp, nil
+}
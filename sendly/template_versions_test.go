@@ -0,0 +1,117 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTemplatesService_Versions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/templates/tpl_1/versions":
+			if r.URL.Query().Get("limit") != "1" {
+				t.Errorf("expected limit to be '1', got '%s'", r.URL.Query().Get("limit"))
+			}
+			w.Write([]byte(`{"data":[{"template_id":"tpl_1","version":3,"text":"Hi {{name}}","status":"published","created_at":"2025-01-03T00:00:00Z"}],"next_cursor":"c2","has_more":true}`))
+		case r.Method == "GET" && r.URL.Path == "/templates/tpl_1/versions/2":
+			w.Write([]byte(`{"template_id":"tpl_1","version":2,"text":"Hello {{name}}","variables":[{"key":"name","type":"string"}],"status":"published","created_at":"2025-01-02T00:00:00Z"}`))
+		case r.Method == "POST" && r.URL.Path == "/templates/tpl_1/rollback":
+			var body map[string]int
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["version"] != 2 {
+				t.Errorf("expected version to be 2, got %d", body["version"])
+			}
+			w.Write([]byte(`{"id":"tpl_1","text":"Hello {{name}}","status":"published","version":4,"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-04T00:00:00Z"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	list, err := client.Templates.ListVersions(ctx, "tpl_1", &TemplateVersionListOptions{Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Data) != 1 || list.Data[0].Version != 3 || !list.HasMore || list.Data[0].CreatedAt.Day() != 3 {
+		t.Errorf("unexpected versions: %+v", list)
+	}
+
+	v, err := client.Templates.GetVersion(ctx, "tpl_1", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.Text != "Hello {{name}}" || len(v.Variables) != 1 {
+		t.Errorf("unexpected version: %+v", v)
+	}
+
+	tpl, err := client.Templates.Rollback(ctx, "tpl_1", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tpl.Version != 4 {
+		t.Errorf("expected version to be 4, got %d", tpl.Version)
+	}
+
+	if _, err := client.Templates.GetVersion(ctx, "tpl_1", 0); !IsValidationError(err) {
+		t.Errorf("expected validation error for version 0, got %v", err)
+	}
+}
+
+func TestTemplatesService_Diff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/templates/tpl_1/diff" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if q := r.URL.Query(); q.Get("from") != "1" || q.Get("to") != "3" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"template_id":"tpl_1","version_a":1,"version_b":3,
+			"segments":[{"op":"equal","text":"Hi "},{"op":"delete","text":"there"},{"op":"insert","text":"{{name}}"},{"op":"equal","text":"!"}],
+			"added_variables":[{"key":"name"}],"removed_variables":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	diff, err := client.Templates.Diff(context.Background(), "tpl_1", 1, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff.VersionA != 1 || diff.VersionB != 3 || len(diff.Segments) != 4 {
+		t.Errorf("unexpected diff: %+v", diff)
+	}
+	if diff.Segments[2].Op != TemplateDiffInsert || diff.Segments[2].Text != "{{name}}" {
+		t.Errorf("unexpected segment: %+v", diff.Segments[2])
+	}
+	if len(diff.AddedVariables) != 1 || diff.AddedVariables[0].Key != "name" {
+		t.Errorf("unexpected added variables: %+v", diff.AddedVariables)
+	}
+	if !diff.Changed() {
+		t.Error("expected diff to report a change")
+	}
+}
+
+func TestTemplateDiff_Changed(t *testing.T) {
+	tests := []struct {
+		name string
+		diff TemplateDiff
+		want bool
+	}{
+		{"identical", TemplateDiff{Segments: []TemplateDiffSegment{{Op: TemplateDiffEqual, Text: "Hi"}}}, false},
+		{"empty", TemplateDiff{}, false},
+		{"text deleted", TemplateDiff{Segments: []TemplateDiffSegment{{Op: TemplateDiffDelete, Text: "Hi"}}}, true},
+		{"variable removed", TemplateDiff{RemovedVariables: []TemplateVariable{{Key: "name"}}}, true},
+	}
+	for _, tt := range tests {
+		if got := tt.diff.Changed(); got != tt.want {
+			t.Errorf("%s: expected Changed() to be %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
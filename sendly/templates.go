@@ -3,6 +3,8 @@ package sendly
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"time"
 )
 
 // TemplatesService provides template management operations.
@@ -17,19 +19,32 @@ type TemplateVariable struct {
 	Fallback string `json:"fallback,omitempty"`
 }
 
+// TemplateStatus represents the publication state of a template.
+type TemplateStatus string
+
+const (
+	// TemplateStatusDraft means the template can be edited but not sent.
+	TemplateStatusDraft TemplateStatus = "draft"
+	// TemplateStatusPublished means the template can be used to send.
+	TemplateStatusPublished TemplateStatus = "published"
+)
+
 // Template represents an SMS template.
 type Template struct {
-	ID          string             `json:"id"`
-	Name        string             `json:"name"`
-	Text        string             `json:"text"`
-	Variables   []TemplateVariable `json:"variables"`
-	IsPreset    bool               `json:"is_preset"`
-	PresetSlug  string             `json:"preset_slug,omitempty"`
-	Status      string             `json:"status"`
-	Version     int                `json:"version"`
-	PublishedAt string             `json:"published_at,omitempty"`
-	CreatedAt   string             `json:"created_at"`
-	UpdatedAt   string             `json:"updated_at"`
+	ID         string             `json:"id"`
+	Name       string             `json:"name"`
+	Text       string             `json:"text"`
+	Variables  []TemplateVariable `json:"variables"`
+	IsPreset   bool               `json:"is_preset"`
+	PresetSlug string             `json:"preset_slug,omitempty"`
+	Status     TemplateStatus     `json:"status"`
+	// Locale is the BCP 47 locale of Text. Sends that ask for a locale
+	// without a translation fall back to it.
+	Locale      string     `json:"locale,omitempty"`
+	Version     int        `json:"version"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
 }
 
 // TemplateListResponse is the response from listing templates.
@@ -41,6 +56,9 @@ type TemplateListResponse struct {
 type CreateTemplateRequest struct {
 	Name string `json:"name"`
 	Text string `json:"text"`
+	// Locale is the BCP 47 locale of Text, e.g. "en-US" (optional; defaults
+	// to the account locale). Add other languages with UpsertTranslation.
+	Locale string `json:"locale,omitempty"`
 }
 
 // UpdateTemplateRequest represents the parameters for updating a template.
@@ -58,20 +76,134 @@ type TemplatePreview struct {
 	Variables    []TemplateVariable `json:"variables"`
 }
 
+// MaxPreviewMatrixSize is the maximum number of renderings (locales times
+// sample sets) in one PreviewMatrix call.
+const MaxPreviewMatrixSize = 100
+
+// PreviewSampleSet is a named set of sample variable values.
+type PreviewSampleSet struct {
+	Name      string            `json:"name"`
+	Variables map[string]string `json:"variables"`
+}
+
+// PreviewMatrixRequest represents the parameters for rendering a template
+// across locales and sample data sets.
+type PreviewMatrixRequest struct {
+	// Locales are BCP 47 locale tags. If empty the template's default
+	// locale is rendered.
+	Locales []string `json:"locales,omitempty"`
+	// SampleSets are the variable sets to render (required).
+	SampleSets []PreviewSampleSet `json:"sample_sets"`
+	// Version renders a specific template version instead of the latest.
+	Version int `json:"version,omitempty"`
+}
+
+// PreviewRendering is a single cell of a preview matrix.
+type PreviewRendering struct {
+	Locale    string `json:"locale"`
+	SampleSet string `json:"sample_set"`
+	Text      string `json:"text"`
+	// Encoding is "gsm7" or "ucs2".
+	Encoding   string `json:"encoding"`
+	Characters int    `json:"characters"`
+	Segments   int    `json:"segments"`
+	// MissingVariables lists variables with no value and no fallback.
+	MissingVariables []string `json:"missing_variables,omitempty"`
+	// Error is set if the rendering failed, e.g. for an untranslated locale.
+	Error string `json:"error,omitempty"`
+}
+
+// PreviewMatrix is the result of rendering a template across locales and
+// sample data sets.
+type PreviewMatrix struct {
+	TemplateID string             `json:"template_id"`
+	Version    int                `json:"version"`
+	Renderings []PreviewRendering `json:"renderings"`
+}
+
+// MaxSegments returns the highest segment count across all renderings.
+func (m *PreviewMatrix) MaxSegments() int {
+	n := 0
+	for _, r := range m.Renderings {
+		n = max(n, r.Segments)
+	}
+	return n
+}
+
+// Problems returns the renderings that failed or have missing variables.
+func (m *PreviewMatrix) Problems() []PreviewRendering {
+	var problems []PreviewRendering
+	for _, r := range m.Renderings {
+		if r.Error != "" || len(r.MissingVariables) > 0 {
+			problems = append(problems, r)
+		}
+	}
+	return problems
+}
+
+// TemplateDiffOp is the kind of change in a template diff segment.
+type TemplateDiffOp string
+
+const (
+	TemplateDiffEqual  TemplateDiffOp = "equal"
+	TemplateDiffInsert TemplateDiffOp = "insert"
+	TemplateDiffDelete TemplateDiffOp = "delete"
+)
+
+// TemplateDiffSegment is a run of text that was kept, added or removed.
+type TemplateDiffSegment struct {
+	Op   TemplateDiffOp `json:"op"`
+	Text string         `json:"text"`
+}
+
+// TemplateDiff describes the changes between two versions of a template.
+type TemplateDiff struct {
+	TemplateID       string                `json:"template_id"`
+	VersionA         int                   `json:"version_a"`
+	VersionB         int                   `json:"version_b"`
+	Segments         []TemplateDiffSegment `json:"segments"`
+	AddedVariables   []TemplateVariable    `json:"added_variables"`
+	RemovedVariables []TemplateVariable    `json:"removed_variables"`
+}
+
+// Changed reports whether the two versions differ in text or variables.
+func (d *TemplateDiff) Changed() bool {
+	if len(d.AddedVariables) > 0 || len(d.RemovedVariables) > 0 {
+		return true
+	}
+	for _, seg := range d.Segments {
+		if seg.Op != TemplateDiffEqual {
+			return true
+		}
+	}
+	return false
+}
+
 // List retrieves all templates.
 func (s *TemplatesService) List(ctx context.Context) (*TemplateListResponse, error) {
 	var resp TemplateListResponse
-	err := s.client.doRequest(ctx, "GET", "/templates", nil, &resp)
+	err := s.client.request(ctx, "GET", "/templates", nil, &resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
+// ListAll returns every template as an Iter.
+func (s *TemplatesService) ListAll(ctx context.Context) Iter[Template] {
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[Template], error) {
+		resp, err := s.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &Page[Template]{Items: resp.Templates}, nil
+	}).Iter()
+}
+
 // Presets retrieves preset templates only.
 func (s *TemplatesService) Presets(ctx context.Context) (*TemplateListResponse, error) {
 	var resp TemplateListResponse
-	err := s.client.doRequest(ctx, "GET", "/templates/presets", nil, &resp)
+	err := s.client.request(ctx, "GET", "/templates/presets", nil, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +213,7 @@ func (s *TemplatesService) Presets(ctx context.Context) (*TemplateListResponse,
 // Get retrieves a template by ID.
 func (s *TemplatesService) Get(ctx context.Context, id string) (*Template, error) {
 	var resp Template
-	err := s.client.doRequest(ctx, "GET", fmt.Sprintf("/templates/%s", id), nil, &resp)
+	err := s.client.request(ctx, "GET", fmt.Sprintf("/templates/%s", id), nil, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +223,7 @@ func (s *TemplatesService) Get(ctx context.Context, id string) (*Template, error
 // Create creates a new template.
 func (s *TemplatesService) Create(ctx context.Context, req *CreateTemplateRequest) (*Template, error) {
 	var resp Template
-	err := s.client.doRequest(ctx, "POST", "/templates", req, &resp)
+	err := s.client.request(ctx, "POST", "/templates", req, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -101,20 +233,22 @@ func (s *TemplatesService) Create(ctx context.Context, req *CreateTemplateReques
 // Update updates a template.
 func (s *TemplatesService) Update(ctx context.Context, id string, req *UpdateTemplateRequest) (*Template, error) {
 	var resp Template
-	err := s.client.doRequest(ctx, "PATCH", fmt.Sprintf("/templates/%s", id), req, &resp)
+	err := s.client.request(ctx, "PATCH", fmt.Sprintf("/templates/%s", id), req, &resp)
 	if err != nil {
 		return nil, err
 	}
+	s.client.templates.invalidate(id)
 	return &resp, nil
 }
 
 // Publish publishes a draft template.
 func (s *TemplatesService) Publish(ctx context.Context, id string) (*Template, error) {
 	var resp Template
-	err := s.client.doRequest(ctx, "POST", fmt.Sprintf("/templates/%s/publish", id), nil, &resp)
+	err := s.client.request(ctx, "POST", fmt.Sprintf("/templates/%s/publish", id), nil, &resp)
 	if err != nil {
 		return nil, err
 	}
+	s.client.templates.invalidate(id)
 	return &resp, nil
 }
 
@@ -126,14 +260,46 @@ func (s *TemplatesService) Preview(ctx context.Context, id string, variables map
 	}
 
 	var resp TemplatePreview
-	err := s.client.doRequest(ctx, "POST", fmt.Sprintf("/templates/%s/preview", id), body, &resp)
+	err := s.client.request(ctx, "POST", fmt.Sprintf("/templates/%s/preview", id), body, &resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
+// PreviewMatrix renders a template for every combination of locale and
+// sample set in one call, reporting encoding and segment counts for each.
+func (s *TemplatesService) PreviewMatrix(ctx context.Context, id string, req *PreviewMatrixRequest) (*PreviewMatrix, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "template ID is required")
+	}
+	if req == nil || len(req.SampleSets) == 0 {
+		return nil, invalidParamError("sample_sets", "at least one sample set is required")
+	}
+	if size := len(req.SampleSets) * max(len(req.Locales), 1); size > MaxPreviewMatrixSize {
+		return nil, invalidParamError("sample_sets", fmt.Sprintf("matrix of %d renderings exceeds the maximum of %d", size, MaxPreviewMatrixSize))
+	}
+
+	var resp PreviewMatrix
+	if err := s.client.request(ctx, "POST", "/templates/"+url.PathEscape(id)+"/preview-matrix", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // Delete deletes a template.
 func (s *TemplatesService) Delete(ctx context.Context, id string) error {
-	return s.client.doRequest(ctx, "DELETE", fmt.Sprintf("/templates/%s", id), nil, nil)
+	s.client.templates.invalidate(id)
+	return s.client.request(ctx, "DELETE", fmt.Sprintf("/templates/%s", id), nil, nil)
+}
+
+// Diff compares two versions of a template.
+func (s *TemplatesService) Diff(ctx context.Context, id string, versionA, versionB int) (*TemplateDiff, error) {
+	var resp TemplateDiff
+	path := fmt.Sprintf("/templates/%s/diff?from=%d&to=%d", id, versionA, versionB)
+	err := s.client.request(ctx, "GET", path, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
 }
@@ -0,0 +1,48 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTemplatesService_PreviewMatrix(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/templates/tpl_1/preview-matrix" {
+			t.Errorf("expected path to be '/templates/tpl_1/preview-matrix', got '%s'", r.URL.Path)
+		}
+		var body PreviewMatrixRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body.Locales) != 2 || len(body.SampleSets) != 1 {
+			t.Errorf("unexpected request body: %+v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"template_id":"tpl_1","version":3,"renderings":[
+			{"locale":"en","sample_set":"short","text":"Hi Al","encoding":"gsm7","segments":1},
+			{"locale":"el","sample_set":"short","text":"Γειά Al","encoding":"ucs2","segments":2,"missing_variables":["code"]}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	matrix, err := client.Templates.PreviewMatrix(context.Background(), "tpl_1", &PreviewMatrixRequest{
+		Locales:    []string{"en", "el"},
+		SampleSets: []PreviewSampleSet{{Name: "short", Variables: map[string]string{"name": "Al"}}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if matrix.MaxSegments() != 2 {
+		t.Errorf("expected MaxSegments to be 2, got %d", matrix.MaxSegments())
+	}
+	if problems := matrix.Problems(); len(problems) != 1 || problems[0].Locale != "el" {
+		t.Errorf("unexpected problems: %+v", problems)
+	}
+
+	big := &PreviewMatrixRequest{Locales: make([]string, 11), SampleSets: make([]PreviewSampleSet, 10)}
+	if _, err := client.Templates.PreviewMatrix(context.Background(), "tpl_1", big); !IsValidationError(err) {
+		t.Errorf("expected validation error for oversized matrix, got %v", err)
+	}
+}
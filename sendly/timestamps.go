@@ -0,0 +1,187 @@
+package sendly
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// parseTime parses an RFC 3339 timestamp from the API. An empty value yields
+// the zero time; a malformed one is an error.
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339Nano, s)
+}
+
+// apiTime is an RFC 3339 timestamp in an API response. Empty strings and
+// null decode to the zero time; malformed values fail decoding.
+type apiTime struct {
+	time.Time
+}
+
+// UnmarshalJSON parses the timestamp with parseTime.
+func (t *apiTime) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil {
+		t.Time = time.Time{}
+		return nil
+	}
+	parsed, err := parseTime(*s)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+	return nil
+}
+
+// ptr returns the timestamp for an optional field, or nil if it is missing
+// or empty.
+func (t *apiTime) ptr() *time.Time {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
+
+// formatTime formats t as RFC 3339, or "" for the zero time.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// formatTimePtr is like formatTime for optional timestamps.
+func formatTimePtr(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return formatTime(*t)
+}
+
+// UnmarshalJSON decodes a verification, parsing its RFC 3339 timestamps.
+func (v *Verification) UnmarshalJSON(data []byte) error {
+	type alias Verification
+	aux := struct {
+		*alias
+		ExpiresAt  apiTime  `json:"expires_at"`
+		VerifiedAt *apiTime `json:"verified_at,omitempty"`
+		CreatedAt  apiTime  `json:"created_at"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	v.ExpiresAt = aux.ExpiresAt.Time
+	v.VerifiedAt = aux.VerifiedAt.ptr()
+	v.CreatedAt = aux.CreatedAt.Time
+	return nil
+}
+
+// MarshalJSON encodes a verification with RFC 3339 timestamps, in the same
+// form the API returns.
+func (v Verification) MarshalJSON() ([]byte, error) {
+	type alias Verification
+	return json.Marshal(struct {
+		alias
+		ExpiresAt  string `json:"expires_at"`
+		VerifiedAt string `json:"verified_at,omitempty"`
+		CreatedAt  string `json:"created_at"`
+	}{
+		alias:      alias(v),
+		ExpiresAt:  formatTime(v.ExpiresAt),
+		VerifiedAt: formatTimePtr(v.VerifiedAt),
+		CreatedAt:  formatTime(v.CreatedAt),
+	})
+}
+
+// UnmarshalJSON decodes a template, parsing its RFC 3339 timestamps.
+func (t *Template) UnmarshalJSON(data []byte) error {
+	type alias Template
+	aux := struct {
+		*alias
+		PublishedAt *apiTime `json:"published_at,omitempty"`
+		CreatedAt   apiTime  `json:"created_at"`
+		UpdatedAt   apiTime  `json:"updated_at"`
+	}{alias: (*alias)(t)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.PublishedAt = aux.PublishedAt.ptr()
+	t.CreatedAt = aux.CreatedAt.Time
+	t.UpdatedAt = aux.UpdatedAt.Time
+	return nil
+}
+
+// MarshalJSON encodes a template with RFC 3339 timestamps, in the same form
+// the API returns.
+func (t Template) MarshalJSON() ([]byte, error) {
+	type alias Template
+	return json.Marshal(struct {
+		alias
+		PublishedAt string `json:"published_at,omitempty"`
+		CreatedAt   string `json:"created_at"`
+		UpdatedAt   string `json:"updated_at"`
+	}{
+		alias:       alias(t),
+		PublishedAt: formatTimePtr(t.PublishedAt),
+		CreatedAt:   formatTime(t.CreatedAt),
+		UpdatedAt:   formatTime(t.UpdatedAt),
+	})
+}
+
+// UnmarshalJSON decodes a send response, parsing ExpiresAt.
+func (r *SendVerificationResponse) UnmarshalJSON(data []byte) error {
+	type alias SendVerificationResponse
+	aux := struct {
+		*alias
+		ExpiresAt apiTime `json:"expires_at"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.ExpiresAt = aux.ExpiresAt.Time
+	return nil
+}
+
+// MarshalJSON encodes a send response with an RFC 3339 ExpiresAt.
+func (r SendVerificationResponse) MarshalJSON() ([]byte, error) {
+	type alias SendVerificationResponse
+	return json.Marshal(struct {
+		alias
+		ExpiresAt string `json:"expires_at"`
+	}{
+		alias:     alias(r),
+		ExpiresAt: formatTime(r.ExpiresAt),
+	})
+}
+
+// UnmarshalJSON decodes a check response, parsing VerifiedAt.
+func (r *CheckVerificationResponse) UnmarshalJSON(data []byte) error {
+	type alias CheckVerificationResponse
+	aux := struct {
+		*alias
+		VerifiedAt *apiTime `json:"verified_at,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.VerifiedAt = aux.VerifiedAt.ptr()
+	return nil
+}
+
+// MarshalJSON encodes a check response with an RFC 3339 VerifiedAt.
+func (r CheckVerificationResponse) MarshalJSON() ([]byte, error) {
+	type alias CheckVerificationResponse
+	return json.Marshal(struct {
+		alias
+		VerifiedAt string `json:"verified_at,omitempty"`
+	}{
+		alias:      alias(r),
+		VerifiedAt: formatTimePtr(r.VerifiedAt),
+	})
+}
@@ -0,0 +1,117 @@
+package sendly
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerification_JSONTimestamps(t *testing.T) {
+	var v Verification
+	body := `{"id":"ver_1","status":"pending","expires_at":"2025-01-15T10:05:00Z","verified_at":"","created_at":"2025-01-15T10:00:00.5Z"}`
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.ID != "ver_1" || v.Status != "pending" {
+		t.Errorf("unexpected verification: %+v", v)
+	}
+	if !v.ExpiresAt.Equal(time.Date(2025, 1, 15, 10, 5, 0, 0, time.UTC)) {
+		t.Errorf("unexpected ExpiresAt: %s", v.ExpiresAt)
+	}
+	if v.CreatedAt.Nanosecond() != 500000000 {
+		t.Errorf("expected fractional seconds to be kept, got %s", v.CreatedAt)
+	}
+	if v.VerifiedAt != nil {
+		t.Errorf("expected VerifiedAt to be nil for an empty value, got %s", v.VerifiedAt)
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(out), `"expires_at":"2025-01-15T10:05:00Z"`) || strings.Contains(string(out), "verified_at") {
+		t.Errorf("unexpected JSON: %s", out)
+	}
+}
+
+func TestTransformWebhook_Timestamps(t *testing.T) {
+	var api webhookAPIResponse
+	body := `{"id":"whk_1","created_at":"2025-01-01T00:00:00Z","last_failure_at":"2025-01-15T10:00:00Z","last_delivery_at":"","paused_at":null}`
+	if err := json.Unmarshal([]byte(body), &api); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wh := transformWebhook(api)
+	if wh.CreatedAt.Year() != 2025 || wh.LastFailureAt == nil || wh.LastFailureAt.Hour() != 10 {
+		t.Errorf("unexpected timestamps: %+v", wh)
+	}
+	if wh.LastDeliveryAt != nil || wh.CircuitOpenedAt != nil || wh.PausedAt != nil {
+		t.Error("expected empty, null and missing timestamps to be nil")
+	}
+}
+
+func TestMalformedTimestamps(t *testing.T) {
+	for name, decode := range map[string]func() error{
+		"Verification": func() error {
+			var v Verification
+			return json.Unmarshal([]byte(`{"id":"ver_1","expires_at":"yesterday"}`), &v)
+		},
+		"Template": func() error {
+			var tpl Template
+			return json.Unmarshal([]byte(`{"id":"tpl_1","created_at":"2025-13-01T00:00:00Z"}`), &tpl)
+		},
+		"SendVerificationResponse": func() error {
+			var r SendVerificationResponse
+			return json.Unmarshal([]byte(`{"id":"ver_1","expires_at":"soon"}`), &r)
+		},
+		"CheckVerificationResponse": func() error {
+			var r CheckVerificationResponse
+			return json.Unmarshal([]byte(`{"id":"ver_1","verified_at":"now"}`), &r)
+		},
+		"webhook": func() error {
+			var api webhookAPIResponse
+			return json.Unmarshal([]byte(`{"id":"whk_1","paused_at":"1736935200"}`), &api)
+		},
+		"delivery": func() error {
+			var api webhookDeliveryAPIResponse
+			return json.Unmarshal([]byte(`{"id":"del_1","created_at":"2025-01-15"}`), &api)
+		},
+	} {
+		var parseErr *time.ParseError
+		if err := decode(); !errors.As(err, &parseErr) {
+			t.Errorf("%s: expected a *time.ParseError, got %v", name, err)
+		}
+	}
+}
+
+func TestVerificationResponses_JSONTimestamps(t *testing.T) {
+	var sent SendVerificationResponse
+	if err := json.Unmarshal([]byte(`{"id":"ver_1","status":"pending","expires_at":"2025-01-15T10:05:00Z","sandbox":true}`), &sent); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !sent.ExpiresAt.Equal(time.Date(2025, 1, 15, 10, 5, 0, 0, time.UTC)) || !sent.Sandbox {
+		t.Errorf("unexpected response: %+v", sent)
+	}
+	out, err := json.Marshal(sent)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(out), `"expires_at":"2025-01-15T10:05:00Z"`) {
+		t.Errorf("unexpected JSON: %s", out)
+	}
+
+	var checked CheckVerificationResponse
+	if err := json.Unmarshal([]byte(`{"id":"ver_1","status":"invalid","remaining_attempts":2}`), &checked); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if checked.VerifiedAt != nil || checked.RemainingAttempts != 2 {
+		t.Errorf("unexpected response: %+v", checked)
+	}
+	if err := json.Unmarshal([]byte(`{"id":"ver_1","status":"verified","verified_at":"2025-01-15T10:01:00Z"}`), &checked); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if checked.VerifiedAt == nil || checked.VerifiedAt.Minute() != 1 {
+		t.Errorf("unexpected VerifiedAt: %v", checked.VerifiedAt)
+	}
+}
@@ -1,5 +1,7 @@
 package sendly
 
+import "time"
+
 // Message represents an SMS message.
 type Message struct {
 	// ID is the unique message identifier.
@@ -22,6 +24,10 @@ type Message struct {
 	CreditsUsed int `json:"creditsUsed,omitempty"`
 	// IsSandbox indicates if the message was sent in sandbox mode.
 	IsSandbox bool `json:"isSandbox,omitempty"`
+	// IsDuplicate indicates the send was suppressed as a duplicate and this is the prior message.
+	IsDuplicate bool `json:"isDuplicate,omitempty"`
+	// IsLoadTest indicates if the message was accepted in load-test mode and never sent to a carrier.
+	IsLoadTest bool `json:"isLoadTest,omitempty"`
 	// SenderType indicates how the message was sent (number_pool, alphanumeric, sandbox).
 	SenderType string `json:"senderType,omitempty"`
 	// TelnyxMessageID is the Telnyx message ID for tracking.
@@ -34,6 +40,22 @@ type Message struct {
 	CreatedAt string `json:"createdAt,omitempty"`
 	// DeliveredAt is when the message was delivered (if applicable).
 	DeliveredAt *string `json:"deliveredAt,omitempty"`
+	// TemplateID is the template the message was rendered from, if any.
+	TemplateID *string `json:"templateId,omitempty"`
+	// Metadata is custom metadata attached when sending.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// ScheduledAt is when a scheduled message will be sent (ISO 8601).
+	ScheduledAt *string `json:"scheduledAt,omitempty"`
+	// StatusCallbackURL is the per-message status callback, if one was set.
+	StatusCallbackURL *string `json:"statusCallbackUrl,omitempty"`
+	// SenderSelection is set when the message was sent with
+	// SenderStrategyAuto.
+	SenderSelection *SenderSelection `json:"senderSelection,omitempty"`
+	// ExpiresAt is when the message is dropped if it has not been
+	// delivered, set when it was sent with a ValidityPeriod.
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+	// MessageClass is the message class, if one was set.
+	MessageClass MessageClass `json:"messageClass,omitempty"`
 }
 
 // MessageStatus represents the status of a message.
@@ -50,6 +72,39 @@ const (
 	MessageStatusFailed MessageStatus = "failed"
 	// MessageStatusBounced means the message bounced (carrier rejected).
 	MessageStatusBounced MessageStatus = "bounced"
+	// MessageStatusExpired means the message expired before it could be delivered.
+	MessageStatusExpired MessageStatus = "expired"
+	// MessageStatusCancelled means the message was cancelled before it was sent.
+	MessageStatusCancelled MessageStatus = "cancelled"
+	// MessageStatusScheduled means the message is waiting for its scheduled send time.
+	MessageStatusScheduled MessageStatus = "scheduled"
+)
+
+// IsTerminal reports whether a message with this status can no longer change.
+func (s MessageStatus) IsTerminal() bool {
+	switch s {
+	case MessageStatusDelivered, MessageStatusFailed, MessageStatusBounced, MessageStatusExpired, MessageStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// Sandbox test numbers. Messages sent to these numbers with a test API key
+// produce deterministic outcomes and never reach a carrier.
+const (
+	// TestNumberSuccess is delivered instantly.
+	TestNumberSuccess = "+15005550000"
+	// TestNumberInvalid fails with invalid_number.
+	TestNumberInvalid = "+15005550001"
+	// TestNumberUnroutable fails with unroutable_destination.
+	TestNumberUnroutable = "+15005550002"
+	// TestNumberQueueFull fails with queue_full.
+	TestNumberQueueFull = "+15005550003"
+	// TestNumberRateLimited fails with rate_limit_exceeded.
+	TestNumberRateLimited = "+15005550004"
+	// TestNumberCarrierViolation fails with carrier_violation.
+	TestNumberCarrierViolation = "+15005550006"
 )
 
 // SenderType indicates how a message was sent.
@@ -74,14 +129,109 @@ const (
 	MessageTypeTransactional MessageType = "transactional"
 )
 
+// SenderStrategy controls how the sender of a message is chosen.
+type SenderStrategy string
+
+const (
+	// SenderStrategyAuto lets the platform pick a compliant sender type
+	// (alphanumeric, long code or short code) for the destination country.
+	// It cannot be combined with From.
+	SenderStrategyAuto SenderStrategy = "auto"
+)
+
+// SenderSelection reports which sender the platform chose for a message
+// sent with SenderStrategyAuto.
+type SenderSelection struct {
+	// Type is the sender type: "alphanumeric", "long_code" or "short_code".
+	Type string `json:"type"`
+	// Sender is the sender ID or number used.
+	Sender string `json:"sender"`
+	// Country is the destination country (ISO 3166-1 alpha-2).
+	Country string `json:"country"`
+	// Reason explains the choice, e.g. "alphanumeric sender IDs are not
+	// supported in US".
+	Reason string `json:"reason,omitempty"`
+}
+
 // SendMessageRequest is the request to send a message.
 type SendMessageRequest struct {
 	// To is the recipient phone number in E.164 format (required).
-	To string `json:"to"`
-	// Text is the message content (required).
-	Text string `json:"text"`
+	To Phone `json:"to"`
+	// Text is the message content (required unless TemplateID is set).
+	Text string `json:"text,omitempty"`
+	// From is the sender ID or phone number (optional).
+	From string `json:"from,omitempty"`
+	// SenderStrategy set to SenderStrategyAuto picks a compliant sender for
+	// the destination country instead of From (optional).
+	SenderStrategy SenderStrategy `json:"senderStrategy,omitempty"`
+	// TemplateID renders a published template instead of Text (optional).
+	TemplateID string `json:"templateId,omitempty"`
+	// Variables are substituted into the template referenced by TemplateID.
+	Variables map[string]string `json:"variables,omitempty"`
+	// Locale selects a translation of the template referenced by TemplateID
+	// (BCP 47, e.g. "de-DE"). Untranslated locales use the template's own text.
+	Locale string `json:"locale,omitempty"`
+	// Metadata is custom metadata stored with the message and echoed in webhooks.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// MessageType is the message type for compliance: "marketing" (default) or "transactional".
 	MessageType MessageType `json:"messageType,omitempty"`
+	// MessageClass set to MessageClassFlash sends a flash SMS (optional).
+	// Sending fails with an UnsupportedMessageClassError where the
+	// destination does not support it.
+	MessageClass MessageClass `json:"messageClass,omitempty"`
+	// Links controls link shortening, previews, and UTM tagging (optional).
+	Links *LinkOptions `json:"links,omitempty"`
+	// DuplicateWindow suppresses this send if an identical body was sent to
+	// the same recipient within the window; the prior message is returned
+	// with IsDuplicate set instead (optional, max MaxDuplicateWindow). It is
+	// sent in whole seconds.
+	DuplicateWindow time.Duration `json:"-"`
+	// ScheduleAt schedules the message instead of sending it now (optional).
+	// The returned Message has status "scheduled" and its ID can be passed
+	// to CancelScheduled or Reschedule.
+	ScheduleAt time.Time `json:"-"`
+	// StatusCallbackURL receives this message's lifecycle events instead of
+	// the account-level webhooks (optional). Events are signed with the
+	// account webhook secret. Must be HTTPS.
+	StatusCallbackURL string `json:"statusCallbackUrl,omitempty"`
+	// FailIfSuppressed checks the suppression list before sending and
+	// returns a SuppressedError instead of submitting the message if the
+	// recipient has opted out (optional).
+	FailIfSuppressed bool `json:"-"`
+	// ValidityPeriod is how long carriers keep trying to deliver the
+	// message before dropping it with status "expired" (optional, max
+	// MaxValidityPeriod). Use it for codes and offers that are useless when
+	// late. It is sent in whole seconds.
+	ValidityPeriod time.Duration `json:"-"`
+}
+
+// LinkOptions controls how URLs in message text are handled.
+// Unset fields fall back to the account defaults.
+type LinkOptions struct {
+	// Shorten replaces URLs with tracked short links.
+	Shorten *bool `json:"shorten,omitempty"`
+	// SuppressPreview asks the carrier/handset not to render rich link previews.
+	SuppressPreview *bool `json:"suppressPreview,omitempty"`
+	// UTM is appended to shortened link destinations. Set Disabled to opt
+	// out of account-level UTM tagging for this message.
+	UTM *UTMParams `json:"utm,omitempty"`
+}
+
+// UTMParams are the UTM query parameters appended to shortened links.
+type UTMParams struct {
+	// Disabled turns off UTM tagging for this message.
+	Disabled bool   `json:"disabled,omitempty"`
+	Source   string `json:"source,omitempty"`
+	Medium   string `json:"medium,omitempty"`
+	Campaign string `json:"campaign,omitempty"`
+	Term     string `json:"term,omitempty"`
+	Content  string `json:"content,omitempty"`
+}
+
+// SimulateStatusRequest is the request to simulate a message status transition.
+type SimulateStatusRequest struct {
+	// Status is the target status (delivered, failed, or expired).
+	Status MessageStatus `json:"status"`
 }
 
 // SendMessageResponse is the response from sending a message.
@@ -116,6 +266,13 @@ type APIError struct {
 	Message string `json:"message"`
 	// Details contains additional error details.
 	Details map[string]interface{} `json:"details,omitempty"`
+	// Param is the request parameter the error relates to, if any.
+	Param string `json:"param,omitempty"`
+	// RequestID identifies the failed request when contacting support.
+	RequestID string `json:"request_id,omitempty"`
+
+	// statusCode is the HTTP status of the response, 0 for client-side errors.
+	statusCode int
 }
 
 // ScheduledMessageStatus represents the status of a scheduled message.
@@ -132,6 +289,16 @@ const (
 	ScheduledMessageStatusFailed ScheduledMessageStatus = "failed"
 )
 
+// IsTerminal reports whether the scheduled message has been sent, cancelled or has failed.
+func (s ScheduledMessageStatus) IsTerminal() bool {
+	switch s {
+	case ScheduledMessageStatusSent, ScheduledMessageStatusCancelled, ScheduledMessageStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
 // ScheduledMessage represents a scheduled SMS message.
 type ScheduledMessage struct {
 	// ID is the unique scheduled message identifier.
@@ -144,6 +311,10 @@ type ScheduledMessage struct {
 	Text string `json:"text"`
 	// ScheduledAt is when the message is scheduled to be sent (ISO 8601).
 	ScheduledAt string `json:"scheduledAt"`
+	// SendAtLocalTime is the requested recipient-local send time, if any.
+	SendAtLocalTime *string `json:"sendAtLocalTime,omitempty"`
+	// RecipientTimezone is the resolved recipient time zone, if SendAtLocalTime was used.
+	RecipientTimezone *string `json:"recipientTimezone,omitempty"`
 	// Status is the scheduled message status.
 	Status ScheduledMessageStatus `json:"status"`
 	// CreditsReserved is the number of credits reserved for this message.
@@ -161,17 +332,61 @@ type ScheduledMessage struct {
 // ScheduleMessageRequest is the request to schedule a message.
 type ScheduleMessageRequest struct {
 	// To is the recipient phone number in E.164 format (required).
-	To string `json:"to"`
-	// Text is the message content (required).
-	Text string `json:"text"`
-	// ScheduledAt is when to send the message in ISO 8601 format (required).
-	ScheduledAt string `json:"scheduledAt"`
+	To Phone `json:"to"`
+	// Text is the message content (required unless TemplateID is set).
+	Text string `json:"text,omitempty"`
+	// TemplateID renders a published template instead of Text (optional).
+	TemplateID string `json:"templateId,omitempty"`
+	// Variables are substituted into the template referenced by TemplateID.
+	Variables map[string]string `json:"variables,omitempty"`
+	// Locale selects a translation of the template referenced by TemplateID
+	// (BCP 47, e.g. "de-DE"). Untranslated locales use the template's own text.
+	Locale string `json:"locale,omitempty"`
+	// Metadata is custom metadata stored with the message and echoed in webhooks.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// ScheduledAt is when to send the message in ISO 8601 format.
+	// Required unless SendAtLocalTime is set.
+	ScheduledAt string `json:"scheduledAt,omitempty"`
+	// SendAtLocalTime is a wall-clock time without offset (e.g. "2025-01-15T09:00:00")
+	// interpreted in the recipient's time zone. Mutually exclusive with ScheduledAt.
+	SendAtLocalTime string `json:"sendAtLocalTime,omitempty"`
+	// Timezone is an IANA time zone (e.g. "Europe/Berlin") that overrides
+	// recipient time zone resolution for SendAtLocalTime (optional).
+	Timezone string `json:"timezone,omitempty"`
+	// TimezoneResolution controls how the recipient time zone is resolved for
+	// SendAtLocalTime when Timezone is not set (default: contact, then number prefix).
+	TimezoneResolution TimezoneResolution `json:"timezoneResolution,omitempty"`
 	// From is the sender ID or phone number (optional).
 	From string `json:"from,omitempty"`
+	// SenderStrategy set to SenderStrategyAuto picks a compliant sender for
+	// the destination country instead of From (optional).
+	SenderStrategy SenderStrategy `json:"senderStrategy,omitempty"`
 	// MessageType is the message type for compliance: "marketing" (default) or "transactional".
 	MessageType MessageType `json:"messageType,omitempty"`
+	// Links controls link shortening, previews, and UTM tagging (optional).
+	Links *LinkOptions `json:"links,omitempty"`
+	// StatusCallbackURL receives this message's lifecycle events (optional).
+	StatusCallbackURL string `json:"statusCallbackUrl,omitempty"`
+	// ValidityPeriod is how long carriers keep trying to deliver the
+	// message, counted from its scheduled send time (optional, max
+	// MaxValidityPeriod). It is sent in whole seconds.
+	ValidityPeriod time.Duration `json:"-"`
 }
 
+// TimezoneResolution is the strategy used to resolve a recipient's time zone.
+type TimezoneResolution string
+
+const (
+	// TimezoneResolutionContact uses the contact's stored time zone, falling
+	// back to the number prefix.
+	TimezoneResolutionContact TimezoneResolution = "contact"
+	// TimezoneResolutionNumberPrefix infers the time zone from the phone number prefix.
+	TimezoneResolutionNumberPrefix TimezoneResolution = "number_prefix"
+)
+
+// LocalTimeLayout is the layout for SendAtLocalTime values.
+const LocalTimeLayout = "2006-01-02T15:04:05"
+
 // ListScheduledMessagesRequest is the request to list scheduled messages.
 type ListScheduledMessagesRequest struct {
 	// Limit is the maximum number of messages to return (default: 20, max: 100).
@@ -203,9 +418,26 @@ type CancelScheduledMessageResponse struct {
 // BatchMessageItem represents a single message in a batch request.
 type BatchMessageItem struct {
 	// To is the recipient phone number in E.164 format (required).
-	To string `json:"to"`
-	// Text is the message content (required).
-	Text string `json:"text"`
+	To Phone `json:"to"`
+	// Text is the message content (required unless TemplateID is set).
+	Text string `json:"text,omitempty"`
+	// From overrides the batch-level sender for this message (optional).
+	From string `json:"from,omitempty"`
+	// MessageType overrides the batch-level message type for this message (optional).
+	MessageType MessageType `json:"messageType,omitempty"`
+	// TemplateID renders a published template instead of Text (optional).
+	TemplateID string `json:"templateId,omitempty"`
+	// Variables are substituted into the template referenced by TemplateID.
+	Variables map[string]string `json:"variables,omitempty"`
+	// Locale selects a translation of the template referenced by TemplateID
+	// (BCP 47, e.g. "de-DE"). Untranslated locales use the template's own text.
+	Locale string `json:"locale,omitempty"`
+	// Metadata is custom metadata stored with the message.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Links overrides the batch-level link options for this message (optional).
+	Links *LinkOptions `json:"links,omitempty"`
+	// StatusCallbackURL overrides the batch-level callback for this message (optional).
+	StatusCallbackURL string `json:"statusCallbackUrl,omitempty"`
 }
 
 // SendBatchRequest is the request to send batch messages.
@@ -214,8 +446,27 @@ type SendBatchRequest struct {
 	Messages []BatchMessageItem `json:"messages"`
 	// From is the sender ID or phone number (optional, applies to all).
 	From string `json:"from,omitempty"`
+	// SenderStrategy set to SenderStrategyAuto picks a compliant sender per
+	// recipient country (optional). Items must not set From.
+	SenderStrategy SenderStrategy `json:"senderStrategy,omitempty"`
 	// MessageType is the message type for compliance: "marketing" (default) or "transactional".
 	MessageType MessageType `json:"messageType,omitempty"`
+	// MessageClass applies to all messages in the batch (optional).
+	// Messages to destinations without support for it fail individually.
+	MessageClass MessageClass `json:"messageClass,omitempty"`
+	// Links controls link handling for all messages in the batch (optional).
+	Links *LinkOptions `json:"links,omitempty"`
+	// DuplicateWindow suppresses messages whose body was already sent to
+	// the same recipient within the window (optional, max
+	// MaxDuplicateWindow). It is sent in whole seconds.
+	DuplicateWindow time.Duration `json:"-"`
+	// StatusCallbackURL receives lifecycle events for every message in the
+	// batch instead of the account-level webhooks (optional).
+	StatusCallbackURL string `json:"statusCallbackUrl,omitempty"`
+	// ValidityPeriod is how long carriers keep trying to deliver each
+	// message before dropping it (optional, max MaxValidityPeriod). It is
+	// sent in whole seconds.
+	ValidityPeriod time.Duration `json:"-"`
 }
 
 // BatchStatus represents the status of a batch.
@@ -232,6 +483,16 @@ const (
 	BatchStatusFailed BatchStatus = "failed"
 )
 
+// IsTerminal reports whether the batch has finished processing.
+func (s BatchStatus) IsTerminal() bool {
+	switch s {
+	case BatchStatusCompleted, BatchStatusPartialFailure, BatchStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
 // BatchMessageResult represents the result of a single message in a batch.
 type BatchMessageResult struct {
 	// To is the recipient phone number.
@@ -239,7 +500,7 @@ type BatchMessageResult struct {
 	// MessageID is the message ID if successful.
 	MessageID *string `json:"messageId,omitempty"`
 	// Status is the message status.
-	Status string `json:"status"`
+	Status MessageStatus `json:"status"`
 	// Error is the error message if failed.
 	Error *string `json:"error,omitempty"`
 }
@@ -268,6 +529,23 @@ type BatchMessageResponse struct {
 	CompletedAt *string `json:"completedAt,omitempty"`
 }
 
+// SendManyResult is the outcome of sending a list of messages with SendMany.
+type SendManyResult struct {
+	// BatchID is the batch identifier, if the bulk endpoint was used.
+	BatchID string
+	// Results contains one entry per request, in input order.
+	Results []ParallelResult[*Message]
+	// Sent is the number of messages accepted.
+	Sent int
+	// Failed is the number of messages rejected.
+	Failed int
+}
+
+// PartialFailure reports whether some, but not all, messages failed.
+func (r *SendManyResult) PartialFailure() bool {
+	return r.Failed > 0 && r.Sent > 0
+}
+
 // ListBatchesRequest is the request to list batches.
 type ListBatchesRequest struct {
 	// Limit is the maximum number of batches to return (default: 20, max: 100).
@@ -363,6 +641,16 @@ const (
 	DeliveryStatusCancelled DeliveryStatus = "cancelled"
 )
 
+// IsTerminal reports whether the delivery will not be attempted again.
+func (s DeliveryStatus) IsTerminal() bool {
+	switch s {
+	case DeliveryStatusDelivered, DeliveryStatusFailed, DeliveryStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
 // Webhook represents a configured webhook endpoint.
 type Webhook struct {
 	// ID is the unique webhook identifier (whk_xxx).
@@ -380,19 +668,22 @@ type Webhook struct {
 	// FailureCount is the number of consecutive failures.
 	FailureCount int `json:"failureCount"`
 	// LastFailureAt is when the last failure occurred.
-	LastFailureAt *string `json:"lastFailureAt,omitempty"`
+	LastFailureAt *time.Time `json:"lastFailureAt,omitempty"`
 	// CircuitState is the circuit breaker state.
 	CircuitState CircuitState `json:"circuitState"`
 	// CircuitOpenedAt is when the circuit was opened.
-	CircuitOpenedAt *string `json:"circuitOpenedAt,omitempty"`
+	CircuitOpenedAt *time.Time `json:"circuitOpenedAt,omitempty"`
+	// PausedAt is when deliveries were paused with Pause, or nil if the
+	// webhook is not paused.
+	PausedAt *time.Time `json:"pausedAt,omitempty"`
 	// APIVersion is the API version for payloads.
 	APIVersion string `json:"apiVersion"`
 	// Metadata is custom metadata.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// CreatedAt is when the webhook was created.
-	CreatedAt string `json:"createdAt"`
+	CreatedAt time.Time `json:"createdAt"`
 	// UpdatedAt is when the webhook was last updated.
-	UpdatedAt string `json:"updatedAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
 	// TotalDeliveries is the total number of delivery attempts.
 	TotalDeliveries int `json:"totalDeliveries"`
 	// SuccessfulDeliveries is the number of successful deliveries.
@@ -400,7 +691,26 @@ type Webhook struct {
 	// SuccessRate is the success rate (0-100).
 	SuccessRate float64 `json:"successRate"`
 	// LastDeliveryAt is when the last successful delivery occurred.
-	LastDeliveryAt *string `json:"lastDeliveryAt,omitempty"`
+	LastDeliveryAt *time.Time `json:"lastDeliveryAt,omitempty"`
+	// TracingEnabled indicates whether delivery attempts record HTTP traces.
+	TracingEnabled bool `json:"tracingEnabled"`
+	// EventSecretTypes lists event types signed with their own secret
+	// rather than the webhook secret.
+	EventSecretTypes []string `json:"eventSecretTypes,omitempty"`
+	// BlueprintID is the blueprint the webhook was instantiated from, if
+	// any, and TenantVars the values it was instantiated with.
+	BlueprintID string            `json:"blueprintId,omitempty"`
+	TenantVars  map[string]string `json:"tenantVars,omitempty"`
+	// PayloadFormat is how events are encoded (sendly or CloudEvents).
+	PayloadFormat WebhookPayloadFormat `json:"payloadFormat"`
+	// HeaderNames lists the custom headers sent with each delivery. Their
+	// values are not returned.
+	HeaderNames []string `json:"headerNames,omitempty"`
+	// AuthType is how Sendly authenticates to the endpoint.
+	AuthType WebhookAuthType `json:"authType"`
+	// RetryPolicy is the effective retry policy, with account defaults
+	// filled in.
+	RetryPolicy WebhookRetryPolicy `json:"retryPolicy"`
 }
 
 // WebhookCreatedResponse is returned when creating a webhook.
@@ -422,6 +732,17 @@ type CreateWebhookRequest struct {
 	Mode WebhookMode `json:"mode,omitempty"`
 	// Metadata is optional custom metadata.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// PayloadFormat selects Sendly envelopes (default) or CloudEvents 1.0
+	// in structured or binary mode.
+	PayloadFormat WebhookPayloadFormat `json:"payload_format,omitempty"`
+	// Headers are static headers sent with each delivery, e.g. an API key
+	// required by a gateway in front of the endpoint.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Auth is the basic auth or OAuth 2.0 client credentials Sendly
+	// presents to the endpoint.
+	Auth *WebhookAuth `json:"auth,omitempty"`
+	// RetryPolicy tunes delivery retries for this endpoint.
+	RetryPolicy *WebhookRetryPolicy `json:"retry_policy,omitempty"`
 }
 
 // UpdateWebhookRequest is the request to update a webhook.
@@ -438,6 +759,20 @@ type UpdateWebhookRequest struct {
 	Mode *WebhookMode `json:"mode,omitempty"`
 	// Metadata is the new custom metadata.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// TracingEnabled turns delivery trace recording on or off.
+	TracingEnabled *bool `json:"tracing_enabled,omitempty"`
+	// PayloadFormat changes how events are encoded. Receivers built with
+	// Webhooks.Handler accept every format and need no change.
+	PayloadFormat *WebhookPayloadFormat `json:"payload_format,omitempty"`
+	// Headers adds or replaces custom headers; RemoveHeaders deletes them.
+	Headers       map[string]string `json:"headers,omitempty"`
+	RemoveHeaders []string          `json:"remove_headers,omitempty"`
+	// Auth replaces the endpoint credentials. Set Type to WebhookAuthNone
+	// to remove them.
+	Auth *WebhookAuth `json:"auth,omitempty"`
+	// RetryPolicy replaces the retry policy; zero fields revert to the
+	// account defaults.
+	RetryPolicy *WebhookRetryPolicy `json:"retry_policy,omitempty"`
 }
 
 // WebhookDelivery represents a webhook delivery attempt.
@@ -450,9 +785,10 @@ type WebhookDelivery struct {
 	EventID string `json:"eventId"`
 	// EventType is the event type.
 	EventType string `json:"eventType"`
-	// AttemptNumber is the attempt number (1-6).
+	// AttemptNumber is the attempt number, from 1 to MaxAttempts.
 	AttemptNumber int `json:"attemptNumber"`
-	// MaxAttempts is the maximum number of attempts.
+	// MaxAttempts is the maximum number of attempts under the webhook's
+	// retry policy.
 	MaxAttempts int `json:"maxAttempts"`
 	// Status is the delivery status.
 	Status DeliveryStatus `json:"status"`
@@ -465,11 +801,42 @@ type WebhookDelivery struct {
 	// ErrorCode is the error code if failed.
 	ErrorCode *string `json:"errorCode,omitempty"`
 	// NextRetryAt is when the next retry will occur.
-	NextRetryAt *string `json:"nextRetryAt,omitempty"`
+	NextRetryAt *time.Time `json:"nextRetryAt,omitempty"`
 	// CreatedAt is when the delivery was created.
-	CreatedAt string `json:"createdAt"`
+	CreatedAt time.Time `json:"createdAt"`
 	// DeliveredAt is when the delivery succeeded.
-	DeliveredAt *string `json:"deliveredAt,omitempty"`
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+}
+
+// DeliveryListOptions are options for listing webhook deliveries.
+type DeliveryListOptions struct {
+	// Limit is the maximum number of deliveries per page (default: 20, max: 100).
+	Limit int
+	// Cursor continues from a previous page's NextCursor.
+	Cursor string
+	// Status filters by delivery status.
+	Status DeliveryStatus
+	// EventType filters by event type.
+	EventType string
+	// Since returns deliveries created at or after this ISO 8601 time.
+	Since string
+	// Until returns deliveries created before this ISO 8601 time.
+	Until string
+	// ResponseStatusCode filters by the HTTP status your endpoint returned,
+	// e.g. 500.
+	ResponseStatusCode int
+	// EventID filters to the deliveries of one event.
+	EventID string
+}
+
+// DeliveryListResponse is a page of webhook deliveries.
+type DeliveryListResponse struct {
+	// Data contains the deliveries on this page.
+	Data []WebhookDelivery `json:"data"`
+	// NextCursor is the cursor for the next page, empty on the last page.
+	NextCursor string `json:"nextCursor,omitempty"`
+	// HasMore indicates whether more pages are available.
+	HasMore bool `json:"hasMore"`
 }
 
 // WebhookTestResult is the result of testing a webhook.
@@ -496,6 +863,210 @@ type WebhookSecretRotation struct {
 	Message string `json:"message"`
 }
 
+// DelayDistribution is the shape of artificial latency injected by chaos mode.
+type DelayDistribution string
+
+const (
+	// DelayDistributionFixed always waits MinDelayMs.
+	DelayDistributionFixed DelayDistribution = "fixed"
+	// DelayDistributionUniform waits a uniformly random time between MinDelayMs and MaxDelayMs.
+	DelayDistributionUniform DelayDistribution = "uniform"
+	// DelayDistributionExponential waits an exponentially distributed time capped at MaxDelayMs.
+	DelayDistributionExponential DelayDistribution = "exponential"
+)
+
+// WebhookChaosConfig configures sandbox fault injection for a webhook.
+// Chaos mode only affects test events; live deliveries are never altered.
+type WebhookChaosConfig struct {
+	// Enabled turns fault injection on or off.
+	Enabled bool `json:"enabled"`
+	// FailureRate is the fraction of deliveries to fail (0.0-1.0).
+	FailureRate float64 `json:"failure_rate"`
+	// FailureStatusCode is the HTTP status reported for injected failures (default: 500).
+	FailureStatusCode int `json:"failure_status_code,omitempty"`
+	// DuplicateRate is the fraction of deliveries to send twice (0.0-1.0).
+	DuplicateRate float64 `json:"duplicate_rate,omitempty"`
+	// MinDelayMs is the minimum injected delay in milliseconds.
+	MinDelayMs int `json:"min_delay_ms,omitempty"`
+	// MaxDelayMs is the maximum injected delay in milliseconds.
+	MaxDelayMs int `json:"max_delay_ms,omitempty"`
+	// DelayDistribution is the delay distribution (fixed, uniform, exponential).
+	DelayDistribution DelayDistribution `json:"delay_distribution,omitempty"`
+}
+
+// WebhookMigrationStatus represents the state of a webhook URL migration.
+type WebhookMigrationStatus string
+
+const (
+	// WebhookMigrationStatusShadowing means deliveries go to both URLs.
+	WebhookMigrationStatusShadowing WebhookMigrationStatus = "shadowing"
+	// WebhookMigrationStatusCutOver means the webhook now delivers to the new URL only.
+	WebhookMigrationStatusCutOver WebhookMigrationStatus = "cut_over"
+	// WebhookMigrationStatusExpired means the window ended without meeting the threshold.
+	WebhookMigrationStatusExpired WebhookMigrationStatus = "expired"
+	// WebhookMigrationStatusCancelled means the migration was cancelled.
+	WebhookMigrationStatusCancelled WebhookMigrationStatus = "cancelled"
+)
+
+// StartWebhookMigrationRequest is the request to start a URL cutover.
+type StartWebhookMigrationRequest struct {
+	// NewURL is the HTTPS endpoint to migrate to (required).
+	NewURL string `json:"new_url"`
+	// ShadowWindow is how long deliveries are duplicated to both URLs
+	// (default: 24 hours). It is sent in whole seconds.
+	ShadowWindow time.Duration `json:"-"`
+	// AutoCutoverThreshold is the success rate (0-100) the new URL must reach
+	// to cut over automatically. Zero disables automatic cutover.
+	AutoCutoverThreshold float64 `json:"auto_cutover_threshold,omitempty"`
+	// MinDeliveries is the number of shadow deliveries required before
+	// automatic cutover is considered (default: 100).
+	MinDeliveries int `json:"min_deliveries,omitempty"`
+}
+
+// WebhookEndpointStats contains delivery statistics for one endpoint of a migration.
+type WebhookEndpointStats struct {
+	// URL is the endpoint URL.
+	URL string `json:"url"`
+	// Deliveries is the number of delivery attempts during the migration.
+	Deliveries int `json:"deliveries"`
+	// Successful is the number of successful deliveries.
+	Successful int `json:"successful"`
+	// SuccessRate is the success rate (0-100).
+	SuccessRate float64 `json:"success_rate"`
+	// AvgResponseTimeMs is the average response time in milliseconds.
+	AvgResponseTimeMs int `json:"avg_response_time_ms"`
+}
+
+// WebhookMigration represents a webhook URL cutover with shadow deliveries.
+type WebhookMigration struct {
+	// WebhookID is the webhook being migrated.
+	WebhookID string `json:"webhook_id"`
+	// Status is the migration status.
+	Status WebhookMigrationStatus `json:"status"`
+	// Old contains statistics for the current URL.
+	Old WebhookEndpointStats `json:"old"`
+	// New contains statistics for the new URL.
+	New WebhookEndpointStats `json:"new"`
+	// AutoCutoverThreshold is the success rate required for automatic cutover.
+	AutoCutoverThreshold float64 `json:"auto_cutover_threshold,omitempty"`
+	// MinDeliveries is the number of deliveries required before automatic cutover.
+	MinDeliveries int `json:"min_deliveries,omitempty"`
+	// StartedAt is when shadowing started.
+	StartedAt string `json:"started_at"`
+	// EndsAt is when the shadow window ends.
+	EndsAt string `json:"ends_at"`
+	// CutOverAt is when the webhook switched to the new URL.
+	CutOverAt *string `json:"cut_over_at,omitempty"`
+}
+
+// DeliveryTraceTimings breaks down where time was spent in a delivery attempt.
+type DeliveryTraceTimings struct {
+	// DNSMs is the time spent resolving the endpoint host.
+	DNSMs int `json:"dns_ms"`
+	// ConnectMs is the time spent establishing the TCP connection.
+	ConnectMs int `json:"connect_ms"`
+	// TLSHandshakeMs is the time spent on the TLS handshake.
+	TLSHandshakeMs int `json:"tls_handshake_ms"`
+	// TimeToFirstByteMs is the time from sending the request to the first response byte.
+	TimeToFirstByteMs int `json:"time_to_first_byte_ms"`
+	// TotalMs is the total attempt duration.
+	TotalMs int `json:"total_ms"`
+}
+
+// DeliveryTraceTLS describes the negotiated TLS connection.
+type DeliveryTraceTLS struct {
+	Version     string `json:"version"`
+	CipherSuite string `json:"cipher_suite"`
+	ServerName  string `json:"server_name"`
+	// CertExpiresAt is when the endpoint's leaf certificate expires.
+	CertExpiresAt string `json:"cert_expires_at,omitempty"`
+}
+
+// DeliveryTraceRequest is the HTTP request sent to the endpoint.
+type DeliveryTraceRequest struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+	Body    string            `json:"body"`
+}
+
+// DeliveryTraceResponse is the HTTP response received from the endpoint.
+type DeliveryTraceResponse struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers"`
+	Body       string            `json:"body"`
+	// BodyTruncated indicates the recorded body was cut short.
+	BodyTruncated bool `json:"body_truncated"`
+}
+
+// DeliveryTrace is a HAR-like record of a single delivery attempt.
+// Traces are only recorded while tracing is enabled on the webhook.
+type DeliveryTrace struct {
+	DeliveryID    string               `json:"delivery_id"`
+	AttemptNumber int                  `json:"attempt_number"`
+	StartedAt     string               `json:"started_at"`
+	RemoteAddr    string               `json:"remote_addr,omitempty"`
+	Timings       DeliveryTraceTimings `json:"timings"`
+	TLS           *DeliveryTraceTLS    `json:"tls,omitempty"`
+	Request       DeliveryTraceRequest `json:"request"`
+	// Response is nil if the attempt failed before a response was received.
+	Response *DeliveryTraceResponse `json:"response,omitempty"`
+	// Error describes a transport failure (DNS, connect, TLS, timeout).
+	Error string `json:"error,omitempty"`
+}
+
+// MaxWebhookBulkSize is the maximum number of webhooks in one bulk call.
+const MaxWebhookBulkSize = 100
+
+// WebhookPatch is a single update in a bulk update.
+type WebhookPatch struct {
+	// ID is the webhook to update (required).
+	ID string `json:"id"`
+	UpdateWebhookRequest
+}
+
+// WebhookBulkResult is the outcome for one webhook in a bulk operation.
+type WebhookBulkResult struct {
+	ID      string    `json:"id"`
+	Success bool      `json:"success"`
+	Error   *APIError `json:"error,omitempty"`
+}
+
+// WebhookBulkResponse is the response from a bulk webhook operation.
+// Items are applied independently, so some may fail while others succeed.
+type WebhookBulkResponse struct {
+	Results   []WebhookBulkResult `json:"results"`
+	Succeeded int                 `json:"succeeded"`
+	Failed    int                 `json:"failed"`
+}
+
+// WebhookBacklog reports events queued for a webhook but not yet delivered.
+type WebhookBacklog struct {
+	WebhookID string `json:"webhook_id"`
+	// Pending is the number of events awaiting a first delivery attempt.
+	Pending int `json:"pending"`
+	// Retrying is the number of events waiting for a retry.
+	Retrying int `json:"retrying"`
+	// OldestPendingAt is when the oldest undelivered event was created.
+	OldestPendingAt *string `json:"oldest_pending_at,omitempty"`
+	// OldestPendingAgeSecs is the age of the oldest undelivered event.
+	OldestPendingAgeSecs int `json:"oldest_pending_age_secs"`
+	// CircuitState is the webhook's current circuit breaker state.
+	CircuitState CircuitState `json:"circuit_state"`
+	// MeasuredAt is when the backlog was measured.
+	MeasuredAt string `json:"measured_at"`
+}
+
+// Total returns the number of undelivered events.
+func (b *WebhookBacklog) Total() int {
+	return b.Pending + b.Retrying
+}
+
+// OldestPendingAge returns the age of the oldest undelivered event.
+func (b *WebhookBacklog) OldestPendingAge() time.Duration {
+	return time.Duration(b.OldestPendingAgeSecs) * time.Second
+}
+
 // ============================================================================
 // Account & Credits
 // ============================================================================
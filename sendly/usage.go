@@ -0,0 +1,203 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+)
+
+// UsageService provides usage, cost and spend alert reporting for billing
+// dashboards.
+type UsageService struct {
+	client *Client
+}
+
+// UsageGranularity is the bucket size of a usage time series.
+type UsageGranularity string
+
+const (
+	UsageGranularityDay   UsageGranularity = "day"
+	UsageGranularityMonth UsageGranularity = "month"
+)
+
+// CostGroupBy is the dimension used to group a cost breakdown.
+type CostGroupBy string
+
+const (
+	CostGroupByCountry CostGroupBy = "country"
+	CostGroupByProduct CostGroupBy = "product"
+)
+
+// UsageProduct is a billed product.
+type UsageProduct string
+
+const (
+	UsageProductSMS          UsageProduct = "sms"
+	UsageProductMMS          UsageProduct = "mms"
+	UsageProductVerify       UsageProduct = "verify"
+	UsageProductLookup       UsageProduct = "lookup"
+	UsageProductNumberRental UsageProduct = "number_rental"
+)
+
+// UsageOptions are options for usage and cost queries.
+type UsageOptions struct {
+	// Start is the beginning of the period in ISO 8601 format (required).
+	Start string
+	// End is the end of the period in ISO 8601 format (default: now).
+	End string
+	// Granularity is the bucket size for Summary (default: day).
+	Granularity UsageGranularity
+	// GroupBy is the grouping dimension for Costs (default: product).
+	GroupBy CostGroupBy
+}
+
+func (o *UsageOptions) params() (map[string]string, error) {
+	if o == nil || o.Start == "" {
+		return nil, invalidParamError("start", "start is required")
+	}
+	return map[string]string{
+		"start":       o.Start,
+		"end":         o.End,
+		"granularity": string(o.Granularity),
+		"group_by":    string(o.GroupBy),
+	}, nil
+}
+
+// UsageBucket is one period of a usage time series.
+type UsageBucket struct {
+	// Start is the beginning of the bucket (ISO 8601).
+	Start         string `json:"start"`
+	Messages      int    `json:"messages"`
+	Segments      int    `json:"segments"`
+	Verifications int    `json:"verifications"`
+	CreditsUsed   int    `json:"credits_used"`
+}
+
+// UsageSummary is message and verification usage over a period.
+type UsageSummary struct {
+	PeriodStart string           `json:"period_start"`
+	PeriodEnd   string           `json:"period_end"`
+	Granularity UsageGranularity `json:"granularity"`
+	Buckets     []UsageBucket    `json:"buckets"`
+	// Totals sums every bucket; its Start is empty.
+	Totals UsageBucket `json:"totals"`
+}
+
+// CostRow is spend for one country or product.
+type CostRow struct {
+	Country string       `json:"country,omitempty"`
+	Product UsageProduct `json:"product,omitempty"`
+	// Quantity is the number of billed units (segments, verifications,
+	// lookups or number-months).
+	Quantity    int `json:"quantity"`
+	CreditsUsed int `json:"credits_used"`
+}
+
+// CostBreakdown is spend over a period grouped by country or product.
+type CostBreakdown struct {
+	PeriodStart  string      `json:"period_start"`
+	PeriodEnd    string      `json:"period_end"`
+	GroupBy      CostGroupBy `json:"group_by"`
+	Rows         []CostRow   `json:"rows"`
+	TotalCredits int         `json:"total_credits"`
+}
+
+// SpendAlertPeriod is the window a spend alert threshold applies to.
+type SpendAlertPeriod string
+
+const (
+	SpendAlertDaily   SpendAlertPeriod = "daily"
+	SpendAlertMonthly SpendAlertPeriod = "monthly"
+)
+
+// SpendAlert notifies when spend in a period crosses a threshold.
+type SpendAlert struct {
+	ID               string           `json:"id"`
+	ThresholdCredits int              `json:"threshold_credits"`
+	Period           SpendAlertPeriod `json:"period"`
+	Emails           []string         `json:"emails,omitempty"`
+	// LastTriggeredAt is when the alert last fired, empty if never.
+	LastTriggeredAt string `json:"last_triggered_at,omitempty"`
+	CreatedAt       string `json:"created_at"`
+}
+
+// CreateSpendAlertRequest represents the parameters for creating a spend
+// alert. Alerts are also delivered to webhooks subscribed to account events.
+type CreateSpendAlertRequest struct {
+	// ThresholdCredits is the spend that triggers the alert (required).
+	ThresholdCredits int              `json:"threshold_credits"`
+	Period           SpendAlertPeriod `json:"period"`
+	Emails           []string         `json:"emails,omitempty"`
+}
+
+// SpendAlertListResponse is the list of spend alerts.
+type SpendAlertListResponse struct {
+	Data []SpendAlert `json:"data"`
+}
+
+// Balance retrieves the current credit balance.
+func (s *UsageService) Balance(ctx context.Context) (*Credits, error) {
+	return s.client.Account.GetCredits(ctx)
+}
+
+// Summary retrieves message and verification counts per day or month.
+func (s *UsageService) Summary(ctx context.Context, opts *UsageOptions) (*UsageSummary, error) {
+	params, err := opts.params()
+	if err != nil {
+		return nil, err
+	}
+	delete(params, "group_by")
+
+	var resp UsageSummary
+	if err := s.client.request(ctx, "GET", "/usage"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Costs retrieves spend over a period broken down by country or product.
+func (s *UsageService) Costs(ctx context.Context, opts *UsageOptions) (*CostBreakdown, error) {
+	params, err := opts.params()
+	if err != nil {
+		return nil, err
+	}
+	delete(params, "granularity")
+
+	var resp CostBreakdown
+	if err := s.client.request(ctx, "GET", "/usage/costs"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListSpendAlerts retrieves the configured spend alerts.
+func (s *UsageService) ListSpendAlerts(ctx context.Context) (*SpendAlertListResponse, error) {
+	var resp SpendAlertListResponse
+	if err := s.client.request(ctx, "GET", "/usage/alerts", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateSpendAlert creates a spend alert.
+func (s *UsageService) CreateSpendAlert(ctx context.Context, req *CreateSpendAlertRequest) (*SpendAlert, error) {
+	if req == nil || req.ThresholdCredits <= 0 {
+		return nil, invalidParamError("threshold_credits", "threshold must be positive")
+	}
+	if req.Period != SpendAlertDaily && req.Period != SpendAlertMonthly {
+		return nil, invalidParamError("period", "period must be daily or monthly")
+	}
+
+	var resp SpendAlert
+	if err := s.client.request(ctx, "POST", "/usage/alerts", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteSpendAlert deletes a spend alert.
+func (s *UsageService) DeleteSpendAlert(ctx context.Context, id string) error {
+	if id == "" {
+		return invalidParamError("id", "alert ID is required")
+	}
+	return s.client.request(ctx, "DELETE", "/usage/alerts/"+url.PathEscape(id), nil, nil)
+}
@@ -0,0 +1,39 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUsageService_Costs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/usage/costs" {
+			t.Errorf("expected path to be '/usage/costs', got '%s'", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("group_by") != "country" || q.Get("start") != "2025-01-01" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		if q.Has("granularity") {
+			t.Error("expected granularity not to be sent")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"group_by":"country","rows":[{"country":"US","quantity":120,"credits_used":240}],"total_credits":240}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	costs, err := client.Usage.Costs(context.Background(), &UsageOptions{Start: "2025-01-01", GroupBy: CostGroupByCountry})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if costs.TotalCredits != 240 || len(costs.Rows) != 1 || costs.Rows[0].Country != "US" {
+		t.Errorf("unexpected breakdown: %+v", costs)
+	}
+
+	if _, err := client.Usage.Summary(context.Background(), nil); !IsValidationError(err) {
+		t.Errorf("expected validation error for missing start, got %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+package sendly
+
+import (
+	"context"
+	"sort"
+	"strconv"
+)
+
+// VerificationFailuresOptions are options for the verification failure
+// report.
+type VerificationFailuresOptions struct {
+	// Start is the beginning of the period in ISO 8601 format (required).
+	Start string
+	// End is the end of the period in ISO 8601 format (default: now).
+	End string
+	// GroupBy is the grouping dimension (default: country).
+	GroupBy SLAGroupBy
+	// Country restricts the report to one ISO 3166-1 alpha-2 country code.
+	Country string
+	// MinVerifications omits rows with fewer verifications, to hide noisy
+	// low-volume corridors.
+	MinVerifications int
+}
+
+// VerificationFailureRow counts verification outcomes for one destination.
+type VerificationFailureRow struct {
+	Country  string `json:"country"`
+	Carrier  string `json:"carrier,omitempty"`
+	Total    int    `json:"total"`
+	Verified int    `json:"verified"`
+	// Undelivered verifications never had their code delivered.
+	Undelivered int `json:"undelivered"`
+	// ExpiredUnverified codes were delivered but never checked correctly.
+	ExpiredUnverified int `json:"expired_unverified"`
+	// MaxAttempts verifications ran out of check attempts.
+	MaxAttempts int `json:"max_attempts"`
+	// FailureRate is the failed fraction of Total (0.0-1.0).
+	FailureRate float64 `json:"failure_rate"`
+}
+
+// Failures returns the number of verifications that failed for any reason.
+func (r VerificationFailureRow) Failures() int {
+	return r.Undelivered + r.ExpiredUnverified + r.MaxAttempts
+}
+
+// VerificationFailureReport summarizes verification failure reasons per
+// destination.
+type VerificationFailureReport struct {
+	PeriodStart string                   `json:"period_start"`
+	PeriodEnd   string                   `json:"period_end"`
+	GroupBy     SLAGroupBy               `json:"group_by"`
+	Rows        []VerificationFailureRow `json:"rows"`
+}
+
+// Worst returns up to n rows with the highest failure rate, breaking ties
+// by the number of failures.
+func (r *VerificationFailureReport) Worst(n int) []VerificationFailureRow {
+	rows := append([]VerificationFailureRow(nil), r.Rows...)
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].FailureRate != rows[j].FailureRate {
+			return rows[i].FailureRate > rows[j].FailureRate
+		}
+		return rows[i].Failures() > rows[j].Failures()
+	})
+	if n < len(rows) {
+		rows = rows[:max(n, 0)]
+	}
+	return rows
+}
+
+// VerificationFailures returns verification failure reasons (undelivered,
+// expired without verifying, out of attempts) per destination country or
+// carrier over a period, to find the corridors where OTPs fail most.
+func (s *ReportsService) VerificationFailures(ctx context.Context, opts *VerificationFailuresOptions) (*VerificationFailureReport, error) {
+	if opts == nil || opts.Start == "" {
+		return nil, invalidParamError("start", "start is required")
+	}
+
+	params := map[string]string{
+		"start":    opts.Start,
+		"end":      opts.End,
+		"group_by": string(opts.GroupBy),
+		"country":  opts.Country,
+	}
+	if opts.MinVerifications > 0 {
+		params["min_verifications"] = strconv.Itoa(opts.MinVerifications)
+	}
+
+	var resp VerificationFailureReport
+	if err := s.client.request(ctx, "GET", "/reports/verification-failures"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
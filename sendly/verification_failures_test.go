@@ -0,0 +1,49 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReportsService_VerificationFailures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reports/verification-failures" {
+			t.Errorf("expected path to be '/reports/verification-failures', got '%s'", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("group_by") != "carrier" || q.Get("min_verifications") != "100" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"group_by":"carrier","rows":[
+			{"country":"US","carrier":"A","total":1000,"verified":950,"undelivered":20,"expired_unverified":20,"max_attempts":10,"failure_rate":0.05},
+			{"country":"NG","carrier":"B","total":500,"verified":300,"undelivered":150,"expired_unverified":40,"max_attempts":10,"failure_rate":0.4},
+			{"country":"IN","carrier":"C","total":2000,"verified":1700,"undelivered":200,"expired_unverified":80,"max_attempts":20,"failure_rate":0.15}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	report, err := client.Reports.VerificationFailures(context.Background(), &VerificationFailuresOptions{
+		Start:            "2025-01-01",
+		GroupBy:          SLAGroupByCarrier,
+		MinVerifications: 100,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	worst := report.Worst(2)
+	if len(worst) != 2 || worst[0].Country != "NG" || worst[1].Country != "IN" {
+		t.Errorf("unexpected worst corridors: %+v", worst)
+	}
+	if worst[0].Failures() != 200 {
+		t.Errorf("expected 200 failures, got %d", worst[0].Failures())
+	}
+
+	if _, err := client.Reports.VerificationFailures(context.Background(), nil); !IsValidationError(err) {
+		t.Errorf("expected validation error for missing start, got %v", err)
+	}
+}
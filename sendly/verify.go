@@ -5,12 +5,17 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"time"
 )
 
 // VerifyService provides OTP verification operations.
 type VerifyService struct {
 	client   *Client
 	Sessions *SessionsService
+	// Policies manages rate limits and fraud rules for verifications.
+	Policies *VerifyPoliciesService
+	// TOTP manages authenticator app factors.
+	TOTP *VerifyTOTPService
 }
 
 // SessionsService provides hosted verification flow operations.
@@ -18,25 +23,91 @@ type SessionsService struct {
 	client *Client
 }
 
+// VerificationStatus represents the state of a verification.
+type VerificationStatus string
+
+const (
+	// VerificationStatusPending means the code was sent and has not been
+	// checked successfully yet.
+	VerificationStatusPending VerificationStatus = "pending"
+	// VerificationStatusVerified means a correct code was submitted.
+	VerificationStatusVerified VerificationStatus = "verified"
+	// VerificationStatusExpired means the code expired before it was verified.
+	VerificationStatusExpired VerificationStatus = "expired"
+	// VerificationStatusFailed means the maximum number of attempts was used.
+	VerificationStatusFailed VerificationStatus = "failed"
+	// VerificationStatusInvalid is returned by Check when the submitted code
+	// is wrong and attempts remain. The verification itself stays pending.
+	VerificationStatusInvalid VerificationStatus = "invalid"
+)
+
+// IsTerminal reports whether a verification with this status can no longer
+// change.
+func (s VerificationStatus) IsTerminal() bool {
+	switch s {
+	case VerificationStatusVerified, VerificationStatusExpired, VerificationStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// VerifySessionStatus represents the state of a hosted verification session.
+type VerifySessionStatus string
+
+const (
+	VerifySessionStatusPending   VerifySessionStatus = "pending"
+	VerifySessionStatusCompleted VerifySessionStatus = "completed"
+	VerifySessionStatusExpired   VerifySessionStatus = "expired"
+	VerifySessionStatusCancelled VerifySessionStatus = "cancelled"
+)
+
+// IsTerminal reports whether the session has ended.
+func (s VerifySessionStatus) IsTerminal() bool {
+	switch s {
+	case VerifySessionStatusCompleted, VerifySessionStatusExpired, VerifySessionStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
 // SendVerificationRequest represents the parameters for sending a verification.
 type SendVerificationRequest struct {
-	To          string `json:"to"`
-	TemplateID  string `json:"template_id,omitempty"`
-	ProfileID   string `json:"profile_id,omitempty"`
-	AppName     string `json:"app_name,omitempty"`
-	TimeoutSecs int    `json:"timeout_secs,omitempty"`
-	CodeLength  int    `json:"code_length,omitempty"`
+	// To is the recipient phone number. It may be empty when every
+	// channel is email.
+	To         Phone  `json:"to,omitempty"`
+	TemplateID string `json:"template_id,omitempty"`
+	ProfileID  string `json:"profile_id,omitempty"`
+	AppName    string `json:"app_name,omitempty"`
+	// Timeout is how long the code is valid. It is sent in whole seconds.
+	Timeout    time.Duration `json:"-"`
+	CodeLength int           `json:"code_length,omitempty"`
+	// Channel delivers the code (default: VerifyChannelSMS).
+	Channel VerifyChannel `json:"channel,omitempty"`
+	// Email is the recipient address for the email channel.
+	Email string `json:"email,omitempty"`
+	// Call, WhatsApp and EmailOptions tune their channel, whether it is
+	// Channel or a fallback.
+	Call         *VerifyCallOptions     `json:"call,omitempty"`
+	WhatsApp     *VerifyWhatsAppOptions `json:"whatsapp,omitempty"`
+	EmailOptions *VerifyEmailOptions    `json:"email_options,omitempty"`
+	// FallbackChannels are tried in order when the code is not verified
+	// within the previous channel's timeout. The same code is sent on
+	// every channel.
+	FallbackChannels []VerifyFallback `json:"fallback_channels,omitempty"`
 }
 
 // SendVerificationResponse represents the response from sending a verification.
 type SendVerificationResponse struct {
-	ID          string `json:"id"`
-	Status      string `json:"status"`
-	Phone       string `json:"phone"`
-	ExpiresAt   string `json:"expires_at"`
-	Sandbox     bool   `json:"sandbox"`
-	SandboxCode string `json:"sandbox_code,omitempty"`
-	Message     string `json:"message,omitempty"`
+	ID          VerificationID     `json:"id"`
+	Status      VerificationStatus `json:"status"`
+	Phone       string             `json:"phone"`
+	Channel     VerifyChannel      `json:"channel,omitempty"`
+	ExpiresAt   time.Time          `json:"expires_at"`
+	Sandbox     bool               `json:"sandbox"`
+	SandboxCode string             `json:"sandbox_code,omitempty"`
+	Message     string             `json:"message,omitempty"`
 }
 
 // CheckVerificationRequest represents the parameters for checking a verification.
@@ -46,45 +117,65 @@ type CheckVerificationRequest struct {
 
 // CheckVerificationResponse represents the response from checking a verification.
 type CheckVerificationResponse struct {
-	ID                string `json:"id"`
-	Status            string `json:"status"`
-	Phone             string `json:"phone"`
-	VerifiedAt        string `json:"verified_at,omitempty"`
-	RemainingAttempts int    `json:"remaining_attempts,omitempty"`
+	ID                VerificationID     `json:"id"`
+	Status            VerificationStatus `json:"status"`
+	Phone             string             `json:"phone"`
+	VerifiedAt        *time.Time         `json:"verified_at,omitempty"`
+	RemainingAttempts int                `json:"remaining_attempts,omitempty"`
 }
 
 // Verification represents a verification record.
 type Verification struct {
-	ID             string `json:"id"`
-	Status         string `json:"status"`
-	Phone          string `json:"phone"`
-	DeliveryStatus string `json:"delivery_status"`
-	Attempts       int    `json:"attempts"`
-	MaxAttempts    int    `json:"max_attempts"`
-	ExpiresAt      string `json:"expires_at"`
-	VerifiedAt     string `json:"verified_at,omitempty"`
-	CreatedAt      string `json:"created_at"`
-	Sandbox        bool   `json:"sandbox"`
-	AppName        string `json:"app_name,omitempty"`
-	TemplateID     string `json:"template_id,omitempty"`
-	ProfileID      string `json:"profile_id,omitempty"`
+	ID             VerificationID     `json:"id"`
+	Status         VerificationStatus `json:"status"`
+	Phone          string             `json:"phone"`
+	DeliveryStatus MessageStatus      `json:"delivery_status"`
+	Channel        VerifyChannel      `json:"channel,omitempty"`
+	Attempts       int                `json:"attempts"`
+	MaxAttempts    int                `json:"max_attempts"`
+	ExpiresAt      time.Time          `json:"expires_at"`
+	VerifiedAt     *time.Time         `json:"verified_at,omitempty"`
+	CreatedAt      time.Time          `json:"created_at"`
+	Sandbox        bool               `json:"sandbox"`
+	AppName        string             `json:"app_name,omitempty"`
+	TemplateID     string             `json:"template_id,omitempty"`
+	ProfileID      string             `json:"profile_id,omitempty"`
+	// SandboxCode is the issued code, returned only for sandbox
+	// verifications that are still pending.
+	SandboxCode string `json:"sandbox_code,omitempty"`
 }
 
 // VerificationListOptions are options for listing verifications.
 type VerificationListOptions struct {
 	Limit  int
-	Status string
+	Status VerificationStatus
+	// Cursor continues from a previous page's Pagination.NextCursor.
+	Cursor string
 }
 
 // VerificationListResponse is the response from listing verifications.
 type VerificationListResponse struct {
 	Verifications []Verification `json:"verifications"`
 	Pagination    struct {
-		Limit   int  `json:"limit"`
-		HasMore bool `json:"has_more"`
+		Limit      int    `json:"limit"`
+		HasMore    bool   `json:"has_more"`
+		NextCursor string `json:"next_cursor,omitempty"`
 	} `json:"pagination"`
 }
 
+// PurgeableVerifications reports verification records eligible for purging.
+type PurgeableVerifications struct {
+	Count           int    `json:"count"`
+	OldestCreatedAt string `json:"oldest_created_at,omitempty"`
+	Cutoff          string `json:"cutoff"`
+}
+
+// PurgeVerificationsResponse represents the result of purging verifications.
+type PurgeVerificationsResponse struct {
+	Purged int    `json:"purged"`
+	Cutoff string `json:"cutoff"`
+}
+
 // CreateSessionRequest represents the parameters for creating a verification session.
 type CreateSessionRequest struct {
 	SuccessURL string                 `json:"success_url"`
@@ -96,19 +187,20 @@ type CreateSessionRequest struct {
 
 // VerifySession represents a hosted verification session.
 type VerifySession struct {
-	ID             string                 `json:"id"`
+	ID             VerifySessionID        `json:"id"`
 	URL            string                 `json:"url"`
-	Status         string                 `json:"status"`
+	Status         VerifySessionStatus    `json:"status"`
 	SuccessURL     string                 `json:"success_url"`
 	CancelURL      string                 `json:"cancel_url,omitempty"`
 	BrandName      string                 `json:"brand_name,omitempty"`
 	BrandColor     string                 `json:"brand_color,omitempty"`
 	Phone          string                 `json:"phone,omitempty"`
-	VerificationID string                 `json:"verification_id,omitempty"`
+	VerificationID VerificationID         `json:"verification_id,omitempty"`
 	Token          string                 `json:"token,omitempty"`
 	Metadata       map[string]interface{} `json:"metadata,omitempty"`
 	ExpiresAt      string                 `json:"expires_at"`
 	CreatedAt      string                 `json:"created_at"`
+	CompletedAt    string                 `json:"completed_at,omitempty"`
 }
 
 // ValidateSessionRequest represents the parameters for validating a session token.
@@ -119,7 +211,7 @@ type ValidateSessionRequest struct {
 // ValidateSessionResponse represents the response from validating a session token.
 type ValidateSessionResponse struct {
 	Valid      bool                   `json:"valid"`
-	SessionID  string                 `json:"session_id,omitempty"`
+	SessionID  VerifySessionID        `json:"session_id,omitempty"`
 	Phone      string                 `json:"phone,omitempty"`
 	VerifiedAt string                 `json:"verified_at,omitempty"`
 	Metadata   map[string]interface{} `json:"metadata,omitempty"`
@@ -128,7 +220,7 @@ type ValidateSessionResponse struct {
 // Create creates a hosted verification session.
 func (s *SessionsService) Create(ctx context.Context, req *CreateSessionRequest) (*VerifySession, error) {
 	var resp VerifySession
-	err := s.client.doRequest(ctx, "POST", "/verify/sessions", req, &resp)
+	err := s.client.request(ctx, "POST", "/verify/sessions", req, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +230,7 @@ func (s *SessionsService) Create(ctx context.Context, req *CreateSessionRequest)
 // Validate validates a session token after user completes verification.
 func (s *SessionsService) Validate(ctx context.Context, req *ValidateSessionRequest) (*ValidateSessionResponse, error) {
 	var resp ValidateSessionResponse
-	err := s.client.doRequest(ctx, "POST", "/verify/sessions/validate", req, &resp)
+	err := s.client.request(ctx, "POST", "/verify/sessions/validate", req, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -147,8 +239,25 @@ func (s *SessionsService) Validate(ctx context.Context, req *ValidateSessionRequ
 
 // Send sends an OTP verification code.
 func (s *VerifyService) Send(ctx context.Context, req *SendVerificationRequest) (*SendVerificationResponse, error) {
+	if req != nil {
+		if err := req.validateChannels(); err != nil {
+			return nil, err
+		}
+		if req.To != "" || !req.emailOnly() {
+			to, err := s.client.normalizePhone("to", req.To)
+			if err != nil {
+				return nil, err
+			}
+			if to != req.To {
+				normalized := *req
+				normalized.To = to
+				req = &normalized
+			}
+		}
+	}
+
 	var resp SendVerificationResponse
-	err := s.client.doRequest(ctx, "POST", "/verify", req, &resp)
+	err := s.client.request(ctx, "POST", "/verify", req, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -156,9 +265,13 @@ func (s *VerifyService) Send(ctx context.Context, req *SendVerificationRequest)
 }
 
 // Resend resends an OTP verification code.
-func (s *VerifyService) Resend(ctx context.Context, id string) (*SendVerificationResponse, error) {
+func (s *VerifyService) Resend(ctx context.Context, id VerificationID) (*SendVerificationResponse, error) {
+	if _, err := ParseVerificationID(string(id)); err != nil {
+		return nil, err
+	}
+
 	var resp SendVerificationResponse
-	err := s.client.doRequest(ctx, "POST", fmt.Sprintf("/verify/%s/resend", id), nil, &resp)
+	err := s.client.request(ctx, "POST", fmt.Sprintf("/verify/%s/resend", id), nil, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -166,9 +279,13 @@ func (s *VerifyService) Resend(ctx context.Context, id string) (*SendVerificatio
 }
 
 // Check verifies an OTP code.
-func (s *VerifyService) Check(ctx context.Context, id string, req *CheckVerificationRequest) (*CheckVerificationResponse, error) {
+func (s *VerifyService) Check(ctx context.Context, id VerificationID, req *CheckVerificationRequest) (*CheckVerificationResponse, error) {
+	if _, err := ParseVerificationID(string(id)); err != nil {
+		return nil, err
+	}
+
 	var resp CheckVerificationResponse
-	err := s.client.doRequest(ctx, "POST", fmt.Sprintf("/verify/%s/check", id), req, &resp)
+	err := s.client.request(ctx, "POST", fmt.Sprintf("/verify/%s/check", id), req, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -176,9 +293,13 @@ func (s *VerifyService) Check(ctx context.Context, id string, req *CheckVerifica
 }
 
 // Get retrieves a verification by ID.
-func (s *VerifyService) Get(ctx context.Context, id string) (*Verification, error) {
+func (s *VerifyService) Get(ctx context.Context, id VerificationID) (*Verification, error) {
+	if _, err := ParseVerificationID(string(id)); err != nil {
+		return nil, err
+	}
+
 	var resp Verification
-	err := s.client.doRequest(ctx, "GET", fmt.Sprintf("/verify/%s", id), nil, &resp)
+	err := s.client.request(ctx, "GET", fmt.Sprintf("/verify/%s", id), nil, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -194,7 +315,10 @@ func (s *VerifyService) List(ctx context.Context, opts *VerificationListOptions)
 			params.Set("limit", strconv.Itoa(opts.Limit))
 		}
 		if opts.Status != "" {
-			params.Set("status", opts.Status)
+			params.Set("status", string(opts.Status))
+		}
+		if opts.Cursor != "" {
+			params.Set("cursor", opts.Cursor)
 		}
 		if len(params) > 0 {
 			path += "?" + params.Encode()
@@ -202,7 +326,78 @@ func (s *VerifyService) List(ctx context.Context, opts *VerificationListOptions)
 	}
 
 	var resp VerificationListResponse
-	err := s.client.doRequest(ctx, "GET", path, nil, &resp)
+	err := s.client.request(ctx, "GET", path, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAll returns every verification matching opts as an Iter, fetching
+// pages as needed.
+func (s *VerifyService) ListAll(ctx context.Context, opts *VerificationListOptions) Iter[Verification] {
+	var o VerificationListOptions
+	if opts != nil {
+		o = *opts
+	}
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[Verification], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[Verification]{Items: resp.Verifications}
+		if resp.Pagination.HasMore {
+			page.NextCursor = resp.Pagination.NextCursor
+		}
+		return page, nil
+	}).Iter()
+}
+
+// validatePurgeAge rejects purge ages under a second, which would be sent
+// as zero and match every record.
+func validatePurgeAge(olderThan time.Duration) error {
+	if olderThan < time.Second {
+		return invalidParamError("older_than_secs", "olderThan must be at least one second")
+	}
+	return nil
+}
+
+// CountPurgeable returns the number of expired or completed verifications
+// older than olderThan that PurgeExpired would delete.
+func (s *VerifyService) CountPurgeable(ctx context.Context, olderThan time.Duration) (*PurgeableVerifications, error) {
+	if err := validatePurgeAge(olderThan); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("older_than_secs", strconv.Itoa(durationSecs(olderThan)))
+
+	var resp PurgeableVerifications
+	err := s.client.request(ctx, "GET", "/verify/purgeable?"+params.Encode(), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// PurgeExpired permanently deletes expired or completed verifications older
+// than olderThan, including the phone numbers they reference. Pending
+// verifications are never purged. olderThan must be at least a second and
+// is rounded up to whole seconds.
+func (s *VerifyService) PurgeExpired(ctx context.Context, olderThan time.Duration) (*PurgeVerificationsResponse, error) {
+	if err := validatePurgeAge(olderThan); err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"older_than_secs": durationSecs(olderThan),
+	}
+
+	var resp PurgeVerificationsResponse
+	err := s.client.request(ctx, "POST", "/verify/purge", body, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,105 @@
+package sendly
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// VerifyChannel is how a verification code is delivered.
+type VerifyChannel string
+
+const (
+	VerifyChannelSMS      VerifyChannel = "sms"
+	VerifyChannelCall     VerifyChannel = "call"
+	VerifyChannelWhatsApp VerifyChannel = "whatsapp"
+	VerifyChannelEmail    VerifyChannel = "email"
+)
+
+// VerifyCallOptions configures delivery of the code by voice call.
+type VerifyCallOptions struct {
+	// Language is the BCP 47 language the code is read in (default: the
+	// account locale).
+	Language string `json:"language,omitempty"`
+	// Repeat is how many times the code is read out (default: 2, max: 5).
+	Repeat int `json:"repeat,omitempty"`
+}
+
+// VerifyWhatsAppOptions configures delivery of the code by WhatsApp.
+type VerifyWhatsAppOptions struct {
+	// Language is the BCP 47 language of the authentication template
+	// (default: the account locale).
+	Language string `json:"language,omitempty"`
+}
+
+// VerifyEmailOptions configures delivery of the code by email.
+type VerifyEmailOptions struct {
+	// From is a sender address on a domain verified for the account
+	// (default: Sendly's verification sender).
+	From    string `json:"from,omitempty"`
+	Subject string `json:"subject,omitempty"`
+}
+
+// VerifyFallback is a channel tried when the code has not been verified in
+// time on the previous one.
+type VerifyFallback struct {
+	Channel VerifyChannel `json:"channel"`
+	// Timeout is how long to wait for the code to be verified before
+	// moving on to the next fallback (default: 60s). It is sent in whole
+	// seconds.
+	Timeout time.Duration `json:"-"`
+}
+
+// validateChannels checks the primary and fallback channels of r.
+func (r *SendVerificationRequest) validateChannels() error {
+	if !r.Channel.valid() && r.Channel != "" {
+		return invalidParamError("channel", "channel must be sms, call, whatsapp or email")
+	}
+	needsEmail := r.Channel == VerifyChannelEmail
+
+	seen := map[VerifyChannel]bool{r.Channel: true}
+	if r.Channel == "" {
+		seen[VerifyChannelSMS] = true
+	}
+	for i, fb := range r.FallbackChannels {
+		param := "fallback_channels[" + strconv.Itoa(i) + "]"
+		if !fb.Channel.valid() {
+			return invalidParamError(param+".channel", "channel must be sms, call, whatsapp or email")
+		}
+		if seen[fb.Channel] {
+			return invalidParamError(param+".channel", "channel "+string(fb.Channel)+" is used more than once")
+		}
+		seen[fb.Channel] = true
+		if fb.Timeout < 0 {
+			return invalidParamError(param+".timeout_secs", "timeout must not be negative")
+		}
+		needsEmail = needsEmail || fb.Channel == VerifyChannelEmail
+	}
+
+	if needsEmail && !strings.Contains(r.Email, "@") {
+		return invalidParamError("email", "email is required for the email channel")
+	}
+	return nil
+}
+
+// emailOnly reports whether every channel of r is email, so r needs no
+// phone number.
+func (r *SendVerificationRequest) emailOnly() bool {
+	if r.Channel != VerifyChannelEmail {
+		return false
+	}
+	for _, fb := range r.FallbackChannels {
+		if fb.Channel != VerifyChannelEmail {
+			return false
+		}
+	}
+	return true
+}
+
+func (c VerifyChannel) valid() bool {
+	switch c {
+	case VerifyChannelSMS, VerifyChannelCall, VerifyChannelWhatsApp, VerifyChannelEmail:
+		return true
+	}
+	return false
+}
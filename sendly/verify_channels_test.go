@@ -0,0 +1,98 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestVerifyService_SendChannels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["channel"] != "whatsapp" {
+			t.Errorf("expected channel to be 'whatsapp', got '%v'", body["channel"])
+		}
+		fallbacks, _ := body["fallback_channels"].([]interface{})
+		if len(fallbacks) != 2 {
+			t.Fatalf("expected 2 fallback channels, got %v", body["fallback_channels"])
+		}
+		first := fallbacks[0].(map[string]interface{})
+		if first["channel"] != "sms" || first["timeout_secs"] != float64(45) {
+			t.Errorf("unexpected fallback: %v", first)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"ver_1","status":"pending","phone":"+15551234567","channel":"whatsapp","expires_at":"2025-01-01T00:10:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	resp, err := client.Verify.Send(context.Background(), &SendVerificationRequest{
+		To:      "+15551234567",
+		Channel: VerifyChannelWhatsApp,
+		FallbackChannels: []VerifyFallback{
+			{Channel: VerifyChannelSMS, Timeout: 45 * time.Second},
+			{Channel: VerifyChannelCall},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Channel != VerifyChannelWhatsApp {
+		t.Errorf("expected channel to be 'whatsapp', got '%s'", resp.Channel)
+	}
+}
+
+func TestVerifyService_SendChannelsValidation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	tests := []*SendVerificationRequest{
+		{To: "+15551234567", Channel: "pigeon"},
+		{To: "+15551234567", Channel: VerifyChannelEmail},
+		{To: "+15551234567", FallbackChannels: []VerifyFallback{{Channel: VerifyChannelEmail}}},
+		{To: "+15551234567", FallbackChannels: []VerifyFallback{{Channel: VerifyChannelSMS}}},
+		{To: "+15551234567", Channel: VerifyChannelCall, FallbackChannels: []VerifyFallback{{Channel: VerifyChannelSMS}, {Channel: VerifyChannelSMS}}},
+		{To: "+15551234567", FallbackChannels: []VerifyFallback{{Channel: VerifyChannelCall, Timeout: -time.Second}}},
+	}
+	for i, req := range tests {
+		if _, err := client.Verify.Send(ctx, req); !IsValidationError(err) {
+			t.Errorf("case %d: expected validation error, got %v", i, err)
+		}
+	}
+}
+
+func TestVerifyService_SendEmailOnlyWithPhoneValidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["email"] != "ada@example.com" {
+			t.Errorf("expected email to be 'ada@example.com', got '%v'", body["email"])
+		}
+		if _, ok := body["to"]; ok {
+			t.Errorf("expected no to, got '%v'", body["to"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"ver_1","status":"pending","channel":"email","expires_at":"2025-01-01T00:10:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithPhoneValidation("US"))
+	ctx := context.Background()
+	if _, err := client.Verify.Send(ctx, &SendVerificationRequest{Channel: VerifyChannelEmail, Email: "ada@example.com"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A phone channel still needs a number.
+	_, err := client.Verify.Send(ctx, &SendVerificationRequest{
+		Channel:          VerifyChannelEmail,
+		Email:            "ada@example.com",
+		FallbackChannels: []VerifyFallback{{Channel: VerifyChannelSMS}},
+	})
+	if !IsValidationError(err) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package sendly
+
+import "sync"
+
+// VerifyEventHub fans verify.* webhook events out to the WaitForResult calls
+// waiting on each verification, so one webhook stream can serve any number
+// of concurrent waits. It is safe for concurrent use.
+//
+// Example:
+//
+//	hub := sendly.NewVerifyEventHub()
+//	http.Handle("/webhooks/sendly", sendly.Webhooks{}.Handler(secret, func(e *sendly.Event) error {
+//	    hub.Publish(e)
+//	    return nil
+//	}))
+//
+//	v, err := client.Verify.WaitForResult(ctx, sent.ID, &sendly.WaitOptions{Hub: hub})
+type VerifyEventHub struct {
+	mu   sync.Mutex
+	subs map[VerificationID]map[chan *Event]struct{}
+}
+
+// NewVerifyEventHub creates an empty hub.
+func NewVerifyEventHub() *VerifyEventHub {
+	return &VerifyEventHub{subs: make(map[VerificationID]map[chan *Event]struct{})}
+}
+
+// Publish delivers event to every subscriber of its verification. Events
+// that are not verify.* events, or that nobody is waiting for, are dropped.
+// Publish never blocks.
+func (h *VerifyEventHub) Publish(event *Event) {
+	data, ok := event.Verification()
+	if !ok {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subs[data.VerificationID] {
+		// A pending event already wakes the waiter; one is enough.
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel receiving events for id and a function that
+// removes the subscription. The channel is never closed.
+func (h *VerifyEventHub) Subscribe(id VerificationID) (<-chan *Event, func()) {
+	ch := make(chan *Event, 1)
+	h.mu.Lock()
+	if h.subs[id] == nil {
+		h.subs[id] = make(map[chan *Event]struct{})
+	}
+	h.subs[id][ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.subs[id], ch)
+		if len(h.subs[id]) == 0 {
+			delete(h.subs, id)
+		}
+	}
+}
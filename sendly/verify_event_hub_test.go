@@ -0,0 +1,73 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestVerifyEventHub_ConcurrentWaiters(t *testing.T) {
+	var mu sync.Mutex
+	statuses := map[string]VerificationStatus{"ver_a": "pending", "ver_b": "pending"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/verify/")
+		mu.Lock()
+		status := statuses[id]
+		mu.Unlock()
+		json.NewEncoder(w).Encode(Verification{ID: VerificationID(id), Status: status})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	hub := NewVerifyEventHub()
+	results := make(chan error, 2)
+	for _, id := range []VerificationID{"ver_a", "ver_b"} {
+		go func(id VerificationID) {
+			_, err := client.Verify.WaitForResult(ctx, id, &WaitOptions{Hub: hub, PollInterval: time.Hour})
+			results <- err
+		}(id)
+	}
+	for {
+		hub.mu.Lock()
+		n := len(hub.subs)
+		hub.mu.Unlock()
+		if n == 2 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	mu.Lock()
+	statuses["ver_a"], statuses["ver_b"] = "verified", "expired"
+	mu.Unlock()
+	hub.Publish(&Event{Type: "verify.completed", Payload: &VerifyEventData{VerificationID: "ver_a"}})
+	hub.Publish(&Event{Type: "verify.expired", Payload: &VerifyEventData{VerificationID: "ver_b"}})
+
+	for i := 0; i < 2; i++ {
+		if err := <-results; err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if len(hub.subs) != 0 {
+		t.Errorf("expected waiters to unsubscribe, got %d subscriptions", len(hub.subs))
+	}
+}
+
+func TestVerifyService_WaitForResult_EventsAndHub(t *testing.T) {
+	client := NewClient("test-api-key")
+	_, err := client.Verify.WaitForResult(context.Background(), "ver_123", &WaitOptions{
+		Events: make(chan *Event),
+		Hub:    NewVerifyEventHub(),
+	})
+	if !IsValidationError(err) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
@@ -0,0 +1,132 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// HandoffStatus represents the state of a verification handoff.
+type HandoffStatus string
+
+const (
+	HandoffStatusPending   HandoffStatus = "pending"
+	HandoffStatusClaimed   HandoffStatus = "claimed"
+	HandoffStatusExpired   HandoffStatus = "expired"
+	HandoffStatusCancelled HandoffStatus = "cancelled"
+)
+
+// IsTerminal reports whether the handoff can no longer be claimed.
+func (s HandoffStatus) IsTerminal() bool {
+	switch s {
+	case HandoffStatusClaimed, HandoffStatusExpired, HandoffStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// CreateHandoffRequest represents the parameters for handing a pending
+// verification or hosted session to another device. Set exactly one of
+// SessionID or VerificationID.
+type CreateHandoffRequest struct {
+	SessionID      VerifySessionID `json:"session_id,omitempty"`
+	VerificationID VerificationID  `json:"verification_id,omitempty"`
+	// TTL is how long the handoff can be claimed (default: 5 minutes, max:
+	// 15 minutes). It is sent in whole seconds.
+	TTL time.Duration `json:"-"`
+}
+
+// Handoff is a one-time token that lets a second device continue a pending
+// verification, for example logging in on a desktop and verifying on a phone.
+type Handoff struct {
+	ID             string          `json:"id"`
+	Status         HandoffStatus   `json:"status"`
+	SessionID      VerifySessionID `json:"session_id,omitempty"`
+	VerificationID VerificationID  `json:"verification_id,omitempty"`
+	// ShortCode is a short human-typeable code to enter on the other device.
+	ShortCode string `json:"short_code"`
+	// QRPayload is the content to encode in a QR code for the other device
+	// to scan. It is a URL that claims the handoff when opened.
+	QRPayload string `json:"qr_payload"`
+	// ClaimedBy is the device label supplied when the handoff was claimed.
+	ClaimedBy string `json:"claimed_by,omitempty"`
+	ClaimedAt string `json:"claimed_at,omitempty"`
+	ExpiresAt string `json:"expires_at"`
+	CreatedAt string `json:"created_at"`
+}
+
+// ClaimHandoffRequest represents the parameters for claiming a handoff on
+// the new device. Set either ShortCode or Token (the token embedded in
+// QRPayload).
+type ClaimHandoffRequest struct {
+	ShortCode string `json:"short_code,omitempty"`
+	Token     string `json:"token,omitempty"`
+	// DeviceLabel describes the claiming device, e.g. "iPhone 15".
+	DeviceLabel string `json:"device_label,omitempty"`
+}
+
+// ClaimHandoffResponse is returned when a handoff is claimed. Continue the
+// verification on this device using Session or VerificationID.
+type ClaimHandoffResponse struct {
+	Handoff        Handoff        `json:"handoff"`
+	Session        *VerifySession `json:"session,omitempty"`
+	VerificationID VerificationID `json:"verification_id,omitempty"`
+}
+
+// CreateHandoff creates a handoff for a pending verification or session.
+// Display Handoff.ShortCode or render Handoff.QRPayload on the originating
+// device, then poll GetHandoff or wait on the verification for completion.
+func (s *SessionsService) CreateHandoff(ctx context.Context, req *CreateHandoffRequest) (*Handoff, error) {
+	if req == nil || (req.SessionID == "") == (req.VerificationID == "") {
+		return nil, invalidParamError("session_id", "exactly one of session ID or verification ID is required")
+	}
+	if req.SessionID != "" {
+		if _, err := ParseVerifySessionID(string(req.SessionID)); err != nil {
+			return nil, err
+		}
+	} else if _, err := ParseVerificationID(string(req.VerificationID)); err != nil {
+		return nil, err
+	}
+
+	var resp Handoff
+	if err := s.client.request(ctx, "POST", "/verify/handoffs", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetHandoff retrieves a handoff by ID.
+func (s *SessionsService) GetHandoff(ctx context.Context, id string) (*Handoff, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "handoff ID is required")
+	}
+
+	var resp Handoff
+	if err := s.client.request(ctx, "GET", "/verify/handoffs/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ClaimHandoff claims a handoff on the new device. A handoff can be claimed
+// once; later claims fail with a ValidationError.
+func (s *SessionsService) ClaimHandoff(ctx context.Context, req *ClaimHandoffRequest) (*ClaimHandoffResponse, error) {
+	if req == nil || (req.ShortCode == "") == (req.Token == "") {
+		return nil, invalidParamError("short_code", "exactly one of short code or token is required")
+	}
+
+	var resp ClaimHandoffResponse
+	if err := s.client.request(ctx, "POST", "/verify/handoffs/claim", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CancelHandoff cancels a pending handoff so it can no longer be claimed.
+func (s *SessionsService) CancelHandoff(ctx context.Context, id string) error {
+	if id == "" {
+		return invalidParamError("id", "handoff ID is required")
+	}
+	return s.client.request(ctx, "DELETE", "/verify/handoffs/"+url.PathEscape(id), nil, nil)
+}
@@ -0,0 +1,44 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSessionsService_ClaimHandoff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/verify/handoffs/claim" {
+			t.Errorf("expected path to be '/verify/handoffs/claim', got '%s'", r.URL.Path)
+		}
+		var body ClaimHandoffRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.ShortCode != "K7P-29Q" {
+			t.Errorf("expected short_code to be 'K7P-29Q', got '%s'", body.ShortCode)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"handoff":{"id":"hof_1","status":"claimed","session_id":"vs_1"},"session":{"id":"vs_1","url":"https://verify.sendly.live/vs_1"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	resp, err := client.Verify.Sessions.ClaimHandoff(context.Background(), &ClaimHandoffRequest{ShortCode: "K7P-29Q"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Handoff.Status != HandoffStatusClaimed || resp.Session == nil || resp.Session.ID != "vs_1" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	if _, err := client.Verify.Sessions.CreateHandoff(context.Background(), &CreateHandoffRequest{}); !IsValidationError(err) {
+		t.Errorf("expected validation error for missing target, got %v", err)
+	}
+	if _, err := client.Verify.Sessions.CreateHandoff(context.Background(), &CreateHandoffRequest{SessionID: "ver_1"}); !IsValidationError(err) {
+		t.Errorf("expected validation error for malformed session ID, got %v", err)
+	}
+	if _, err := client.Verify.Sessions.CreateHandoff(context.Background(), &CreateHandoffRequest{VerificationID: "123456"}); !IsValidationError(err) {
+		t.Errorf("expected validation error for malformed verification ID, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package sendly
+
+import "strings"
+
+const (
+	// VerificationIDPrefix starts every verification ID.
+	VerificationIDPrefix = "ver_"
+	// VerifySessionIDPrefix starts every hosted verification session ID.
+	VerifySessionIDPrefix = "vs_"
+)
+
+// VerificationID identifies an OTP verification, e.g. "ver_abc123".
+type VerificationID string
+
+// ParseVerificationID returns s as a VerificationID, or a ValidationError
+// if it is not one. Use it to check IDs from URLs or forms before storing
+// them; VerifyService methods perform the same check.
+func ParseVerificationID(s string) (VerificationID, error) {
+	if !hasIDPrefix(s, VerificationIDPrefix) {
+		return "", invalidParamError("id", "invalid verification ID format")
+	}
+	return VerificationID(s), nil
+}
+
+// Valid reports whether id is a well-formed verification ID.
+func (id VerificationID) Valid() bool {
+	return hasIDPrefix(string(id), VerificationIDPrefix)
+}
+
+// String returns id as a string.
+func (id VerificationID) String() string {
+	return string(id)
+}
+
+// VerifySessionID identifies a hosted verification session, e.g.
+// "vs_abc123".
+type VerifySessionID string
+
+// ParseVerifySessionID returns s as a VerifySessionID, or a
+// ValidationError if it is not one. Passing a verification ID is a common
+// mistake and gets its own message.
+func ParseVerifySessionID(s string) (VerifySessionID, error) {
+	if !hasIDPrefix(s, VerifySessionIDPrefix) {
+		if hasIDPrefix(s, VerificationIDPrefix) {
+			return "", invalidParamError("id", "expected a session ID (vs_...), got a verification ID")
+		}
+		return "", invalidParamError("id", "invalid session ID format")
+	}
+	return VerifySessionID(s), nil
+}
+
+// Valid reports whether id is a well-formed session ID.
+func (id VerifySessionID) Valid() bool {
+	return hasIDPrefix(string(id), VerifySessionIDPrefix)
+}
+
+// String returns id as a string.
+func (id VerifySessionID) String() string {
+	return string(id)
+}
+
+// hasIDPrefix reports whether s is prefix followed by at least one ASCII
+// letter, digit, "_" or "-", so an ID can be used as a path segment as is.
+func hasIDPrefix(s, prefix string) bool {
+	rest, ok := strings.CutPrefix(s, prefix)
+	if !ok || rest == "" {
+		return false
+	}
+	for _, r := range rest {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+package sendly
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestParseVerificationID(t *testing.T) {
+	valid := []string{"ver_1", "ver_abc-DEF_123"}
+	for _, s := range valid {
+		id, err := ParseVerificationID(s)
+		if err != nil || id.String() != s || !id.Valid() {
+			t.Errorf("expected %q to be valid, got %v", s, err)
+		}
+	}
+
+	invalid := []string{"", "ver_", "vs_1", "1234", "ver_1/check", "ver_1?x=y", "VER_1"}
+	for _, s := range invalid {
+		if _, err := ParseVerificationID(s); !IsValidationError(err) {
+			t.Errorf("expected %q to be rejected, got %v", s, err)
+		}
+	}
+}
+
+func TestParseVerifySessionID(t *testing.T) {
+	if id, err := ParseVerifySessionID("vs_1"); err != nil || !id.Valid() {
+		t.Errorf("expected vs_1 to be valid, got %v", err)
+	}
+	_, err := ParseVerifySessionID("ver_1")
+	if !IsValidationError(err) || !strings.Contains(err.Error(), "verification ID") {
+		t.Errorf("expected a verification ID error, got %v", err)
+	}
+}
+
+func TestVerifyService_RejectsMalformedIDs(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	if _, err := client.Verify.Get(ctx, "vs_1"); !IsValidationError(err) {
+		t.Errorf("Get: expected validation error, got %v", err)
+	}
+	if _, err := client.Verify.Check(ctx, "123456", &CheckVerificationRequest{Code: "123456"}); !IsValidationError(err) {
+		t.Errorf("Check: expected validation error, got %v", err)
+	}
+	if _, err := client.Verify.Resend(ctx, ""); !IsValidationError(err) {
+		t.Errorf("Resend: expected validation error, got %v", err)
+	}
+	if _, err := client.Verify.WaitForResult(ctx, "ver_../x", nil); !IsValidationError(err) {
+		t.Errorf("WaitForResult: expected validation error, got %v", err)
+	}
+	if _, err := client.Verify.Sessions.Get(ctx, "ver_1"); !IsValidationError(err) {
+		t.Errorf("Sessions.Get: expected validation error, got %v", err)
+	}
+	if _, err := client.Verify.Sessions.Expire(ctx, "sess 1"); !IsValidationError(err) {
+		t.Errorf("Sessions.Expire: expected validation error, got %v", err)
+	}
+}
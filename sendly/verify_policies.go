@@ -0,0 +1,247 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// VerifyPoliciesService manages verification rate limits and fraud rules.
+type VerifyPoliciesService struct {
+	client *Client
+}
+
+// VerifyLimitScope is what a verification rate limit counts sends by.
+type VerifyLimitScope string
+
+const (
+	VerifyLimitPerPhone   VerifyLimitScope = "phone"
+	VerifyLimitPerIP      VerifyLimitScope = "ip"
+	VerifyLimitPerCountry VerifyLimitScope = "country"
+	VerifyLimitPerAccount VerifyLimitScope = "account"
+)
+
+// VerifyLimitAction is what happens to a send that exceeds a limit.
+type VerifyLimitAction string
+
+const (
+	// VerifyActionBlock rejects the send with a rate limit error.
+	VerifyActionBlock VerifyLimitAction = "block"
+	// VerifyActionRequireSNA only allows silent network authentication,
+	// which cannot be abused to pump SMS traffic.
+	VerifyActionRequireSNA VerifyLimitAction = "require_sna"
+	// VerifyActionFlag allows the send and marks it as suspicious in logs
+	// and verify.* events.
+	VerifyActionFlag VerifyLimitAction = "flag"
+)
+
+// VerifyRateLimit caps verification sends per scope within a sliding
+// window. Limits with an action other than block act as velocity rules.
+type VerifyRateLimit struct {
+	Scope VerifyLimitScope `json:"scope"`
+	Max   int              `json:"max"`
+	// Window is the sliding window the limit applies to. It is sent in
+	// whole seconds.
+	Window time.Duration `json:"-"`
+	// Action defaults to VerifyActionBlock.
+	Action VerifyLimitAction `json:"action,omitempty"`
+}
+
+// VerifyPolicy is a set of anti-abuse rules applied to verification sends.
+type VerifyPolicy struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// ProfileID scopes the policy to one verify profile. The policy
+	// without a profile applies to every other send.
+	ProfileID  string            `json:"profile_id,omitempty"`
+	Enabled    bool              `json:"enabled"`
+	RateLimits []VerifyRateLimit `json:"rate_limits,omitempty"`
+	// AllowedCountries and BlockedCountries are ISO 3166-1 alpha-2 codes.
+	// At most one of them is set.
+	AllowedCountries []string `json:"allowed_countries,omitempty"`
+	BlockedCountries []string `json:"blocked_countries,omitempty"`
+	// BlockedCarriers are carrier names as returned by the Lookup API.
+	BlockedCarriers []string `json:"blocked_carriers,omitempty"`
+	// BlockedLineTypes are line types such as "voip" or "landline".
+	BlockedLineTypes []string `json:"blocked_line_types,omitempty"`
+	CreatedAt        string   `json:"created_at"`
+	UpdatedAt        string   `json:"updated_at"`
+}
+
+// CreateVerifyPolicyRequest represents the parameters for creating a
+// verification policy.
+type CreateVerifyPolicyRequest struct {
+	Name      string `json:"name"`
+	ProfileID string `json:"profile_id,omitempty"`
+	// Disabled creates the policy without enforcing it.
+	Disabled         bool              `json:"disabled,omitempty"`
+	RateLimits       []VerifyRateLimit `json:"rate_limits,omitempty"`
+	AllowedCountries []string          `json:"allowed_countries,omitempty"`
+	BlockedCountries []string          `json:"blocked_countries,omitempty"`
+	BlockedCarriers  []string          `json:"blocked_carriers,omitempty"`
+	BlockedLineTypes []string          `json:"blocked_line_types,omitempty"`
+}
+
+// UpdateVerifyPolicyRequest represents the parameters for updating a
+// verification policy. Nil fields are left unchanged; a list replaces the
+// current one, and a pointer to an empty list clears it.
+//
+// Example:
+//
+//	// Switch from a block list to an allow list
+//	blocked, allowed := []string{}, []string{"US", "CA"}
+//	policy, err := client.Verify.Policies.Update(ctx, id, &sendly.UpdateVerifyPolicyRequest{
+//	    BlockedCountries: &blocked,
+//	    AllowedCountries: &allowed,
+//	})
+type UpdateVerifyPolicyRequest struct {
+	Name             *string            `json:"name,omitempty"`
+	Enabled          *bool              `json:"enabled,omitempty"`
+	RateLimits       *[]VerifyRateLimit `json:"rate_limits,omitempty"`
+	AllowedCountries *[]string          `json:"allowed_countries,omitempty"`
+	BlockedCountries *[]string          `json:"blocked_countries,omitempty"`
+	BlockedCarriers  *[]string          `json:"blocked_carriers,omitempty"`
+	BlockedLineTypes *[]string          `json:"blocked_line_types,omitempty"`
+}
+
+// withEmptyLists returns a copy of r in which lists set to nil slices are
+// empty, so they are sent as [] rather than null.
+func (r UpdateVerifyPolicyRequest) withEmptyLists() *UpdateVerifyPolicyRequest {
+	r.RateLimits = emptyIfNil(r.RateLimits)
+	r.AllowedCountries = emptyIfNil(r.AllowedCountries)
+	r.BlockedCountries = emptyIfNil(r.BlockedCountries)
+	r.BlockedCarriers = emptyIfNil(r.BlockedCarriers)
+	r.BlockedLineTypes = emptyIfNil(r.BlockedLineTypes)
+	return &r
+}
+
+func emptyIfNil[T any](list *[]T) *[]T {
+	if list != nil && *list == nil {
+		return &[]T{}
+	}
+	return list
+}
+
+func derefList[T any](list *[]T) []T {
+	if list == nil {
+		return nil
+	}
+	return *list
+}
+
+// VerifyPolicyListResponse is the list of verification policies.
+type VerifyPolicyListResponse struct {
+	Data []VerifyPolicy `json:"data"`
+}
+
+func validateVerifyPolicy(limits []VerifyRateLimit, allowed, blocked []string) error {
+	for i, l := range limits {
+		param := "rate_limits[" + strconv.Itoa(i) + "]"
+		switch l.Scope {
+		case VerifyLimitPerPhone, VerifyLimitPerIP, VerifyLimitPerCountry, VerifyLimitPerAccount:
+		default:
+			return invalidParamError(param+".scope", "scope must be phone, ip, country or account")
+		}
+		if l.Max <= 0 {
+			return invalidParamError(param+".max", "max must be positive")
+		}
+		if l.Window < time.Second {
+			return invalidParamError(param+".window_secs", "window must be at least one second")
+		}
+		switch l.Action {
+		case "", VerifyActionBlock, VerifyActionRequireSNA, VerifyActionFlag:
+		default:
+			return invalidParamError(param+".action", "action must be block, require_sna or flag")
+		}
+	}
+	if len(allowed) > 0 && len(blocked) > 0 {
+		return invalidParamError("allowed_countries", "allowed_countries and blocked_countries are mutually exclusive")
+	}
+	for _, countries := range [][]string{allowed, blocked} {
+		for _, c := range countries {
+			if len(c) != 2 || c[0] < 'A' || c[0] > 'Z' || c[1] < 'A' || c[1] > 'Z' {
+				return invalidParamError("countries", "invalid ISO 3166-1 alpha-2 country code "+strconv.Quote(c))
+			}
+		}
+	}
+	return nil
+}
+
+// List returns the account's verification policies.
+func (s *VerifyPoliciesService) List(ctx context.Context) (*VerifyPolicyListResponse, error) {
+	var resp VerifyPolicyListResponse
+	if err := s.client.request(ctx, "GET", "/verify/policies", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get retrieves a verification policy by ID.
+func (s *VerifyPoliciesService) Get(ctx context.Context, id string) (*VerifyPolicy, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "policy ID is required")
+	}
+
+	var resp VerifyPolicy
+	if err := s.client.request(ctx, "GET", "/verify/policies/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Create creates a verification policy. It is enforced immediately unless
+// Disabled is set.
+//
+// Example:
+//
+//	policy, err := client.Verify.Policies.Create(ctx, &sendly.CreateVerifyPolicyRequest{
+//	    Name: "signup",
+//	    RateLimits: []sendly.VerifyRateLimit{
+//	        {Scope: sendly.VerifyLimitPerPhone, Max: 5, Window: time.Hour},
+//	        {Scope: sendly.VerifyLimitPerCountry, Max: 500, Window: time.Minute, Action: sendly.VerifyActionRequireSNA},
+//	    },
+//	    BlockedLineTypes: []string{"voip"},
+//	})
+func (s *VerifyPoliciesService) Create(ctx context.Context, req *CreateVerifyPolicyRequest) (*VerifyPolicy, error) {
+	if req == nil || req.Name == "" {
+		return nil, invalidParamError("name", "name is required")
+	}
+	if err := validateVerifyPolicy(req.RateLimits, req.AllowedCountries, req.BlockedCountries); err != nil {
+		return nil, err
+	}
+
+	var resp VerifyPolicy
+	if err := s.client.request(ctx, "POST", "/verify/policies", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Update changes a verification policy.
+func (s *VerifyPoliciesService) Update(ctx context.Context, id string, req *UpdateVerifyPolicyRequest) (*VerifyPolicy, error) {
+	if id == "" {
+		return nil, invalidParamError("id", "policy ID is required")
+	}
+	if req == nil {
+		return nil, invalidParamError("request", "request is required")
+	}
+	if err := validateVerifyPolicy(derefList(req.RateLimits), derefList(req.AllowedCountries), derefList(req.BlockedCountries)); err != nil {
+		return nil, err
+	}
+	req = req.withEmptyLists()
+
+	var resp VerifyPolicy
+	if err := s.client.request(ctx, "PATCH", "/verify/policies/"+url.PathEscape(id), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Delete removes a verification policy.
+func (s *VerifyPoliciesService) Delete(ctx context.Context, id string) error {
+	if id == "" {
+		return invalidParamError("id", "policy ID is required")
+	}
+	return s.client.request(ctx, "DELETE", "/verify/policies/"+url.PathEscape(id), nil, nil)
+}
@@ -0,0 +1,103 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestVerifyPoliciesService_Create(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/verify/policies" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		limits, _ := body["rate_limits"].([]interface{})
+		if len(limits) != 1 {
+			t.Fatalf("expected 1 rate limit, got %v", body["rate_limits"])
+		}
+		if limit := limits[0].(map[string]interface{}); limit["window_secs"] != float64(3600) || limit["scope"] != "phone" {
+			t.Errorf("unexpected rate limit: %v", limit)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"vpol_1","name":"signup","enabled":true,"rate_limits":[{"scope":"phone","max":5,"window_secs":3600,"action":"block"}],"blocked_countries":["XX"]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	policy, err := client.Verify.Policies.Create(context.Background(), &CreateVerifyPolicyRequest{
+		Name:             "signup",
+		RateLimits:       []VerifyRateLimit{{Scope: VerifyLimitPerPhone, Max: 5, Window: time.Hour}},
+		BlockedCountries: []string{"XX"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policy.ID != "vpol_1" || len(policy.RateLimits) != 1 || policy.RateLimits[0].Window != time.Hour {
+		t.Errorf("unexpected policy: %+v", policy)
+	}
+}
+
+func TestVerifyPoliciesService_Validation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	tests := []*CreateVerifyPolicyRequest{
+		nil,
+		{Name: "p", RateLimits: []VerifyRateLimit{{Scope: "device", Max: 1, Window: time.Hour}}},
+		{Name: "p", RateLimits: []VerifyRateLimit{{Scope: VerifyLimitPerIP, Window: time.Hour}}},
+		{Name: "p", RateLimits: []VerifyRateLimit{{Scope: VerifyLimitPerIP, Max: 1}}},
+		{Name: "p", RateLimits: []VerifyRateLimit{{Scope: VerifyLimitPerIP, Max: 1, Window: time.Hour, Action: "captcha"}}},
+		{Name: "p", AllowedCountries: []string{"US"}, BlockedCountries: []string{"RU"}},
+		{Name: "p", BlockedCountries: []string{"usa"}},
+	}
+	for i, req := range tests {
+		if _, err := client.Verify.Policies.Create(ctx, req); !IsValidationError(err) {
+			t.Errorf("case %d: expected validation error, got %v", i, err)
+		}
+	}
+}
+
+func TestVerifyPoliciesService_UpdateClearsLists(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/verify/policies/vpol_1" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if blocked, ok := body["blocked_countries"].([]interface{}); !ok || len(blocked) != 0 {
+			t.Errorf("expected blocked_countries to be cleared, got %v", body["blocked_countries"])
+		}
+		if limits, ok := body["rate_limits"].([]interface{}); !ok || len(limits) != 0 {
+			t.Errorf("expected rate_limits to be cleared, got %v", body["rate_limits"])
+		}
+		if allowed, _ := body["allowed_countries"].([]interface{}); len(allowed) != 1 {
+			t.Errorf("expected allowed_countries to be set, got %v", body["allowed_countries"])
+		}
+		if _, ok := body["blocked_carriers"]; ok {
+			t.Errorf("expected blocked_carriers to be left unchanged, got %v", body["blocked_carriers"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"vpol_1","name":"signup","enabled":true,"allowed_countries":["US"]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	var limits []VerifyRateLimit
+	blocked, allowed := []string{}, []string{"US"}
+	policy, err := client.Verify.Policies.Update(context.Background(), "vpol_1", &UpdateVerifyPolicyRequest{
+		RateLimits:       &limits,
+		BlockedCountries: &blocked,
+		AllowedCountries: &allowed,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(policy.AllowedCountries) != 1 || len(policy.BlockedCountries) != 0 {
+		t.Errorf("unexpected policy: %+v", policy)
+	}
+}
@@ -0,0 +1,81 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestVerifyService_CountPurgeable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/verify/purgeable" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("older_than_secs"); got != "2592000" {
+			t.Errorf("expected older_than_secs to be '2592000', got '%s'", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count":42,"oldest_created_at":"2024-01-01T00:00:00Z","cutoff":"2024-02-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	resp, err := client.Verify.CountPurgeable(context.Background(), 30*24*time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Count != 42 || resp.Cutoff != "2024-02-01T00:00:00Z" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
+
+func TestVerifyService_PurgeExpired(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/verify/purge" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		// Fractional seconds round up, so nothing newer than asked is purged.
+		if body["older_than_secs"] != float64(91) {
+			t.Errorf("expected older_than_secs to be 91, got %v", body["older_than_secs"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"purged":7,"cutoff":"2024-02-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	resp, err := client.Verify.PurgeExpired(context.Background(), 90*time.Second+time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Purged != 7 {
+		t.Errorf("expected Purged to be 7, got %d", resp.Purged)
+	}
+}
+
+func TestVerifyService_PurgeRejectsShortAges(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+	for _, d := range []time.Duration{0, -time.Hour, 500 * time.Millisecond} {
+		if _, err := client.Verify.PurgeExpired(ctx, d); !IsValidationError(err) {
+			t.Errorf("PurgeExpired(%v): expected validation error, got %v", d, err)
+		}
+		if _, err := client.Verify.CountPurgeable(ctx, d); !IsValidationError(err) {
+			t.Errorf("CountPurgeable(%v): expected validation error, got %v", d, err)
+		}
+	}
+	if calls != 0 {
+		t.Errorf("expected no API calls, got %d", calls)
+	}
+}
@@ -0,0 +1,117 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// SessionListOptions are options for listing hosted verification sessions.
+type SessionListOptions struct {
+	Limit  int
+	Status VerifySessionStatus
+	// Phone filters to sessions completed with this number, in E.164
+	// format.
+	Phone string
+	// CreatedAfter and CreatedBefore filter by creation time (RFC 3339).
+	CreatedAfter  string
+	CreatedBefore string
+	// Cursor continues from a previous page's Pagination.NextCursor.
+	Cursor string
+}
+
+// SessionListResponse is the response from listing verification sessions.
+type SessionListResponse struct {
+	Sessions   []VerifySession `json:"sessions"`
+	Pagination struct {
+		Limit      int    `json:"limit"`
+		HasMore    bool   `json:"has_more"`
+		NextCursor string `json:"next_cursor,omitempty"`
+	} `json:"pagination"`
+}
+
+// Get retrieves a hosted verification session by ID.
+func (s *SessionsService) Get(ctx context.Context, id VerifySessionID) (*VerifySession, error) {
+	if _, err := ParseVerifySessionID(string(id)); err != nil {
+		return nil, err
+	}
+
+	var resp VerifySession
+	if err := s.client.request(ctx, "GET", "/verify/sessions/"+url.PathEscape(string(id)), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// List retrieves hosted verification sessions, newest first.
+func (s *SessionsService) List(ctx context.Context, opts *SessionListOptions) (*SessionListResponse, error) {
+	path := "/verify/sessions"
+	if opts != nil {
+		params := url.Values{}
+		if opts.Limit > 0 {
+			params.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Status != "" {
+			params.Set("status", string(opts.Status))
+		}
+		if opts.Phone != "" {
+			params.Set("phone", opts.Phone)
+		}
+		if opts.CreatedAfter != "" {
+			params.Set("created_after", opts.CreatedAfter)
+		}
+		if opts.CreatedBefore != "" {
+			params.Set("created_before", opts.CreatedBefore)
+		}
+		if opts.Cursor != "" {
+			params.Set("cursor", opts.Cursor)
+		}
+		if len(params) > 0 {
+			path += "?" + params.Encode()
+		}
+	}
+
+	var resp SessionListResponse
+	if err := s.client.request(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAll returns every session matching opts as an Iter, fetching pages as
+// needed.
+func (s *SessionsService) ListAll(ctx context.Context, opts *SessionListOptions) Iter[VerifySession] {
+	var o SessionListOptions
+	if opts != nil {
+		o = *opts
+	}
+	return NewPager(ctx, func(ctx context.Context, cursor string) (*Page[VerifySession], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[VerifySession]{Items: resp.Sessions}
+		if resp.Pagination.HasMore {
+			page.NextCursor = resp.Pagination.NextCursor
+		}
+		return page, nil
+	}).Iter()
+}
+
+// Expire ends a pending session so its URL can no longer be used, e.g. when
+// the user abandons signup. A verify.session.expired event is sent. Expiring
+// a session that has already ended returns it unchanged.
+func (s *SessionsService) Expire(ctx context.Context, id VerifySessionID) (*VerifySession, error) {
+	if _, err := ParseVerifySessionID(string(id)); err != nil {
+		return nil, err
+	}
+
+	var resp VerifySession
+	if err := s.client.request(ctx, "POST", "/verify/sessions/"+url.PathEscape(string(id))+"/expire", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
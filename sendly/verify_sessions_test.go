@@ -0,0 +1,84 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSessionsService_ListAll(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/verify/sessions" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("status") != "completed" || q.Get("created_after") != "2024-01-01T00:00:00Z" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if q.Get("cursor") == "" {
+			w.Write([]byte(`{"sessions":[{"id":"vs_1","status":"completed"}],"pagination":{"has_more":true,"next_cursor":"c2"}}`))
+			return
+		}
+		w.Write([]byte(`{"sessions":[{"id":"vs_2","status":"completed"}],"pagination":{"has_more":false}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	sessions, err := client.Verify.Sessions.ListAll(context.Background(), &SessionListOptions{
+		Status:       VerifySessionStatusCompleted,
+		CreatedAfter: "2024-01-01T00:00:00Z",
+	}).Collect()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sessions) != 2 || sessions[1].ID != "vs_2" {
+		t.Errorf("unexpected sessions: %+v", sessions)
+	}
+}
+
+func TestSessionsService_Expire(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/verify/sessions/vs_1/expire" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"vs_1","status":"expired"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	session, err := client.Verify.Sessions.Expire(context.Background(), "vs_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if session.Status != VerifySessionStatusExpired {
+		t.Errorf("expected status to be 'expired', got '%s'", session.Status)
+	}
+
+	if _, err := client.Verify.Sessions.Get(context.Background(), ""); !IsValidationError(err) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
+
+func TestParseEvent_SessionPayload(t *testing.T) {
+	body := `{"id":"evt_1","type":"verify.session.completed","created_at":"2024-01-01T00:00:00Z","data":{"session_id":"vs_1","status":"completed","phone":"+15551234567","metadata":{"user_id":42}}}`
+	event, err := ParseEvent([]byte(body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	session, ok := event.Session()
+	if !ok {
+		t.Fatalf("expected session payload, got %T", event.Payload)
+	}
+	if session.SessionID != "vs_1" || session.Status != VerifySessionStatusCompleted {
+		t.Errorf("unexpected payload: %+v", session)
+	}
+	if userID, err := GetMetadata[int](session, "user_id"); err != nil || userID != 42 {
+		t.Errorf("expected user_id 42, got %d (%v)", userID, err)
+	}
+	if _, ok := event.Verification(); ok {
+		t.Error("expected session event not to decode as a verification")
+	}
+}
@@ -0,0 +1,180 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+)
+
+// VerifyTOTPService manages authenticator app (TOTP) factors, so apps can
+// offer authenticator-based two-factor authentication alongside SMS codes.
+type VerifyTOTPService struct {
+	client *Client
+}
+
+// TOTPFactorStatus represents the state of a TOTP factor.
+type TOTPFactorStatus string
+
+const (
+	// TOTPFactorPending means the factor was enrolled and no code from the
+	// authenticator app has been checked yet.
+	TOTPFactorPending TOTPFactorStatus = "pending"
+	// TOTPFactorActive means the user has proven the app is set up.
+	TOTPFactorActive TOTPFactorStatus = "active"
+)
+
+// EnrollTOTPRequest represents the parameters for enrolling a TOTP factor.
+type EnrollTOTPRequest struct {
+	// UserID is your identifier for the user. A user can have several
+	// factors, e.g. one per device.
+	UserID string `json:"user_id"`
+	// AccountName is shown in the authenticator app, e.g. the user's email.
+	AccountName string `json:"account_name"`
+	// Issuer is shown above the account name (default: the account's brand
+	// name).
+	Issuer string `json:"issuer,omitempty"`
+	// Label distinguishes factors of one user, e.g. "Work phone".
+	Label string `json:"label,omitempty"`
+}
+
+// TOTPFactor is an authenticator app enrolled for a user.
+type TOTPFactor struct {
+	ID          string           `json:"id"`
+	UserID      string           `json:"user_id"`
+	AccountName string           `json:"account_name"`
+	Issuer      string           `json:"issuer"`
+	Label       string           `json:"label,omitempty"`
+	Status      TOTPFactorStatus `json:"status"`
+	// BackupCodesRemaining is the number of unused backup codes.
+	BackupCodesRemaining int    `json:"backup_codes_remaining"`
+	CreatedAt            string `json:"created_at"`
+	ActivatedAt          string `json:"activated_at,omitempty"`
+	LastUsedAt           string `json:"last_used_at,omitempty"`
+}
+
+// TOTPEnrollment is returned when a factor is enrolled. Secret,
+// ProvisioningURI, QRCode and BackupCodes are only returned here; show them
+// to the user once and do not store them.
+type TOTPEnrollment struct {
+	Factor TOTPFactor `json:"factor"`
+	// Secret is the base32 shared secret, for users who enter it by hand.
+	Secret string `json:"secret"`
+	// ProvisioningURI is the otpauth:// URI encoded in QRCode.
+	ProvisioningURI string `json:"provisioning_uri"`
+	// QRCode is a PNG data URI that can be used as an <img> src.
+	QRCode      string   `json:"qr_code"`
+	BackupCodes []string `json:"backup_codes"`
+}
+
+// TOTPCheckResponse is the result of checking a TOTP or backup code.
+type TOTPCheckResponse struct {
+	Valid    bool             `json:"valid"`
+	FactorID string           `json:"factor_id"`
+	Status   TOTPFactorStatus `json:"status"`
+	// BackupCodeUsed reports whether a backup code was accepted instead of
+	// an authenticator code. Each backup code works once.
+	BackupCodeUsed       bool `json:"backup_code_used"`
+	BackupCodesRemaining int  `json:"backup_codes_remaining"`
+}
+
+// TOTPBackupCodes is a new set of backup codes.
+type TOTPBackupCodes struct {
+	BackupCodes []string `json:"backup_codes"`
+	CreatedAt   string   `json:"created_at"`
+}
+
+// Enroll creates a pending TOTP factor for a user. Show the QR code or
+// secret to the user, then activate the factor by checking a code from
+// their app with Check.
+//
+// Example:
+//
+//	enrollment, err := client.Verify.TOTP.Enroll(ctx, &sendly.EnrollTOTPRequest{
+//	    UserID:      user.ID,
+//	    AccountName: user.Email,
+//	})
+//	renderQR(enrollment.QRCode)
+//	// later, with the code the user typed
+//	res, err := client.Verify.TOTP.Check(ctx, enrollment.Factor.ID, code)
+func (s *VerifyTOTPService) Enroll(ctx context.Context, req *EnrollTOTPRequest) (*TOTPEnrollment, error) {
+	if req == nil || req.UserID == "" {
+		return nil, invalidParamError("user_id", "user ID is required")
+	}
+	if req.AccountName == "" {
+		return nil, invalidParamError("account_name", "account name is required")
+	}
+
+	var resp TOTPEnrollment
+	if err := s.client.request(ctx, "POST", "/verify/totp/factors", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get retrieves a TOTP factor by ID.
+func (s *VerifyTOTPService) Get(ctx context.Context, factorID string) (*TOTPFactor, error) {
+	if factorID == "" {
+		return nil, invalidParamError("factor_id", "factor ID is required")
+	}
+
+	var resp TOTPFactor
+	if err := s.client.request(ctx, "GET", "/verify/totp/factors/"+url.PathEscape(factorID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// List returns a user's TOTP factors.
+func (s *VerifyTOTPService) List(ctx context.Context, userID string) ([]TOTPFactor, error) {
+	if userID == "" {
+		return nil, invalidParamError("user_id", "user ID is required")
+	}
+
+	var resp struct {
+		Data []TOTPFactor `json:"data"`
+	}
+	if err := s.client.request(ctx, "GET", "/verify/totp/factors"+buildQueryString(map[string]string{"user_id": userID}), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// Check checks a code from the user's authenticator app, or one of their
+// backup codes. The first valid code activates a pending factor. A wrong
+// code returns Valid false rather than an error.
+func (s *VerifyTOTPService) Check(ctx context.Context, factorID, code string) (*TOTPCheckResponse, error) {
+	if factorID == "" {
+		return nil, invalidParamError("factor_id", "factor ID is required")
+	}
+	if code == "" {
+		return nil, invalidParamError("code", "code is required")
+	}
+
+	body := map[string]string{"code": code}
+	var resp TOTPCheckResponse
+	if err := s.client.request(ctx, "POST", "/verify/totp/factors/"+url.PathEscape(factorID)+"/check", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RegenerateBackupCodes replaces a factor's backup codes. The previous codes
+// stop working immediately.
+func (s *VerifyTOTPService) RegenerateBackupCodes(ctx context.Context, factorID string) (*TOTPBackupCodes, error) {
+	if factorID == "" {
+		return nil, invalidParamError("factor_id", "factor ID is required")
+	}
+
+	var resp TOTPBackupCodes
+	if err := s.client.request(ctx, "POST", "/verify/totp/factors/"+url.PathEscape(factorID)+"/backup-codes", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Delete removes a TOTP factor, e.g. when the user loses their device.
+func (s *VerifyTOTPService) Delete(ctx context.Context, factorID string) error {
+	if factorID == "" {
+		return invalidParamError("factor_id", "factor ID is required")
+	}
+	return s.client.request(ctx, "DELETE", "/verify/totp/factors/"+url.PathEscape(factorID), nil, nil)
+}
@@ -0,0 +1,73 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVerifyTOTPService_EnrollAndCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/verify/totp/factors":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["user_id"] != "usr_1" || body["account_name"] != "ada@example.com" {
+				t.Errorf("unexpected body: %v", body)
+			}
+			w.Write([]byte(`{"factor":{"id":"totp_1","user_id":"usr_1","status":"pending"},"secret":"JBSWY3DPEHPK3PXP","provisioning_uri":"otpauth://totp/Acme:ada@example.com?secret=JBSWY3DPEHPK3PXP","backup_codes":["1111-2222","3333-4444"]}`))
+		case "/verify/totp/factors/totp_1/check":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["code"] != "123456" {
+				t.Errorf("expected code to be '123456', got '%s'", body["code"])
+			}
+			w.Write([]byte(`{"valid":true,"factor_id":"totp_1","status":"active","backup_codes_remaining":2}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	enrollment, err := client.Verify.TOTP.Enroll(ctx, &EnrollTOTPRequest{UserID: "usr_1", AccountName: "ada@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if enrollment.Factor.Status != TOTPFactorPending || enrollment.Secret == "" || len(enrollment.BackupCodes) != 2 {
+		t.Errorf("unexpected enrollment: %+v", enrollment)
+	}
+
+	res, err := client.Verify.TOTP.Check(ctx, enrollment.Factor.ID, "123456")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !res.Valid || res.Status != TOTPFactorActive {
+		t.Errorf("unexpected check response: %+v", res)
+	}
+}
+
+func TestVerifyTOTPService_Validation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	if _, err := client.Verify.TOTP.Enroll(ctx, &EnrollTOTPRequest{UserID: "usr_1"}); !IsValidationError(err) {
+		t.Errorf("expected validation error for missing account name, got %v", err)
+	}
+	if _, err := client.Verify.TOTP.Check(ctx, "totp_1", ""); !IsValidationError(err) {
+		t.Errorf("expected validation error for missing code, got %v", err)
+	}
+	if _, err := client.Verify.TOTP.List(ctx, ""); !IsValidationError(err) {
+		t.Errorf("expected validation error for missing user ID, got %v", err)
+	}
+	for _, key := range []string{"provisioning_uri", "qr_code", "backup_codes"} {
+		if !isSensitiveLogKey(key) {
+			t.Errorf("expected %s to be redacted from logs", key)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package sendly
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// DefaultWaitPollInterval is the initial delay between status polls.
+	DefaultWaitPollInterval = time.Second
+	// DefaultWaitMaxPollInterval caps the delay between status polls.
+	DefaultWaitMaxPollInterval = 15 * time.Second
+)
+
+// WaitOptions configures VerifyService.WaitForResult.
+type WaitOptions struct {
+	// Events, if set, delivers webhook events (for example from a
+	// Webhooks.Handler callback). A verify.* event for the verification
+	// wakes the wait immediately instead of waiting for the next poll.
+	//
+	// The wait consumes every event it reads and discards those for other
+	// verifications, so each channel must serve exactly one waiter. Use
+	// Hub to share a webhook stream between concurrent waits.
+	Events <-chan *Event
+	// Hub, if set, wakes the wait when it publishes a verify.* event for
+	// the verification. It cannot be combined with Events.
+	Hub *VerifyEventHub
+	// PollInterval is the initial delay between polls (default: 1s). It
+	// doubles after each poll up to MaxPollInterval.
+	PollInterval time.Duration
+	// MaxPollInterval caps the delay between polls (default: 15s).
+	MaxPollInterval time.Duration
+}
+
+// WaitForResult blocks until the verification reaches a terminal state
+// (verified, expired or failed) and returns it. Use a context deadline to
+// bound the wait.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+//	defer cancel()
+//	v, err := client.Verify.WaitForResult(ctx, sent.ID, nil)
+//	if err == nil && v.Status == sendly.VerificationStatusVerified {
+//	    fmt.Println("logged in")
+//	}
+func (s *VerifyService) WaitForResult(ctx context.Context, id VerificationID, opts *WaitOptions) (*Verification, error) {
+	if _, err := ParseVerificationID(string(id)); err != nil {
+		return nil, err
+	}
+
+	var o WaitOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = DefaultWaitPollInterval
+	}
+	if o.MaxPollInterval <= 0 {
+		o.MaxPollInterval = DefaultWaitMaxPollInterval
+	}
+	if o.Events != nil && o.Hub != nil {
+		return nil, invalidParamError("events", "events and hub are mutually exclusive")
+	}
+
+	interval := o.PollInterval
+	events := o.Events
+	if o.Hub != nil {
+		// Subscribe before the first poll so an event that arrives
+		// between the poll and the wait is not missed.
+		sub, unsubscribe := o.Hub.Subscribe(id)
+		defer unsubscribe()
+		events = sub
+	}
+	for {
+		v, err := s.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if v.Status.IsTerminal() {
+			return v, nil
+		}
+
+		timer := time.NewTimer(interval)
+	wait:
+		for {
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
+				break wait
+			case event, ok := <-events:
+				if !ok {
+					// Stream closed; keep polling
+					events = nil
+					continue
+				}
+				if data, isVerify := event.Verification(); isVerify && data.VerificationID == id {
+					timer.Stop()
+					break wait
+				}
+			}
+		}
+
+		interval *= 2
+		if interval > o.MaxPollInterval {
+			interval = o.MaxPollInterval
+		}
+	}
+}
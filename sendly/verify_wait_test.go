@@ -0,0 +1,103 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func verificationServer(t *testing.T, statuses ...VerificationStatus) (*httptest.Server, *int) {
+	t.Helper()
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/verify/ver_123" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		status := statuses[len(statuses)-1]
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+		json.NewEncoder(w).Encode(Verification{ID: "ver_123", Status: status})
+	}))
+	return server, &calls
+}
+
+func TestVerifyService_WaitForResult_Polling(t *testing.T) {
+	server, calls := verificationServer(t, "pending", "pending", "verified")
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	v, err := client.Verify.WaitForResult(context.Background(), "ver_123", &WaitOptions{
+		PollInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.Status != VerificationStatusVerified {
+		t.Errorf("expected status to be 'verified', got '%s'", v.Status)
+	}
+	if *calls != 3 {
+		t.Errorf("expected 3 polls, got %d", *calls)
+	}
+}
+
+func TestVerifyService_WaitForResult_Event(t *testing.T) {
+	server, calls := verificationServer(t, "pending", "expired")
+	defer server.Close()
+
+	events := make(chan *Event, 2)
+	events <- &Event{Type: "verify.completed", Payload: &VerifyEventData{VerificationID: "ver_other"}}
+	events <- &Event{Type: "verify.expired", Payload: &VerifyEventData{VerificationID: "ver_123"}}
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	v, err := client.Verify.WaitForResult(ctx, "ver_123", &WaitOptions{
+		Events:       events,
+		PollInterval: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.Status != VerificationStatusExpired {
+		t.Errorf("expected status to be 'expired', got '%s'", v.Status)
+	}
+	if *calls != 2 {
+		t.Errorf("expected 2 fetches, got %d", *calls)
+	}
+}
+
+func TestVerifyService_WaitForResult_ContextCancelled(t *testing.T) {
+	server, _ := verificationServer(t, "pending")
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err := client.Verify.WaitForResult(ctx, "ver_123", &WaitOptions{PollInterval: time.Millisecond})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestVerificationStatus_IsTerminal(t *testing.T) {
+	terminal := map[VerificationStatus]bool{
+		VerificationStatusPending:  false,
+		VerificationStatusInvalid:  false,
+		VerificationStatusVerified: true,
+		VerificationStatusExpired:  true,
+		VerificationStatusFailed:   true,
+	}
+	for status, want := range terminal {
+		if got := status.IsTerminal(); got != want {
+			t.Errorf("expected %s.IsTerminal() to be %v, got %v", status, want, got)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package sendly
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// WebhookAuthType is how Sendly authenticates to a webhook endpoint, in
+// addition to signing the payload.
+type WebhookAuthType string
+
+const (
+	// WebhookAuthNone sends no credentials. Use it in an update to remove
+	// the configured authentication.
+	WebhookAuthNone WebhookAuthType = "none"
+	// WebhookAuthBasic sends HTTP basic auth credentials.
+	WebhookAuthBasic WebhookAuthType = "basic"
+	// WebhookAuthOAuth2 fetches a bearer token with the OAuth 2.0 client
+	// credentials grant and sends it in the Authorization header. Tokens
+	// are cached until they expire.
+	WebhookAuthOAuth2 WebhookAuthType = "oauth2"
+)
+
+// WebhookAuth holds the credentials Sendly presents to a webhook endpoint.
+// Credentials are write-only: webhooks returned by the API only report
+// the AuthType.
+type WebhookAuth struct {
+	Type WebhookAuthType `json:"type"`
+
+	// Username and Password are the basic auth credentials.
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+
+	// TokenURL is the HTTPS token endpoint for the client credentials
+	// grant.
+	TokenURL     string   `json:"token_url,omitempty"`
+	ClientID     string   `json:"client_id,omitempty"`
+	ClientSecret string   `json:"client_secret,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`
+	// Audience is sent as the audience parameter, for providers that
+	// require one.
+	Audience string `json:"audience,omitempty"`
+}
+
+// MaxWebhookHeaders is the maximum number of custom headers on a webhook.
+const MaxWebhookHeaders = 10
+
+// validateWebhookAuth checks custom headers and endpoint credentials.
+// Headers that Sendly sets itself cannot be overridden, and an
+// Authorization header cannot be combined with auth.
+func validateWebhookAuth(headers map[string]string, auth *WebhookAuth) error {
+	if len(headers) > MaxWebhookHeaders {
+		return invalidParamError("headers", fmt.Sprintf("at most %d custom headers are allowed", MaxWebhookHeaders))
+	}
+	for name, value := range headers {
+		if !validHeaderName(name) {
+			return invalidParamError("headers", "invalid header name "+name)
+		}
+		canonical := http.CanonicalHeaderKey(name)
+		switch {
+		case canonical == "Host", canonical == "Content-Type", canonical == "Content-Length",
+			canonical == "User-Agent", canonical == "Transfer-Encoding",
+			strings.HasPrefix(canonical, "X-Sendly-"), strings.HasPrefix(canonical, "Ce-"):
+			return invalidParamError("headers", "header "+canonical+" is set by Sendly")
+		case canonical == "Authorization" && auth != nil && auth.Type != WebhookAuthNone:
+			return invalidParamError("headers", "the Authorization header cannot be combined with auth")
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return invalidParamError("headers", "header "+canonical+" contains a line break")
+		}
+	}
+
+	if auth == nil {
+		return nil
+	}
+	switch auth.Type {
+	case WebhookAuthNone:
+	case WebhookAuthBasic:
+		if auth.Username == "" || auth.Password == "" {
+			return invalidParamError("auth", "basic auth requires a username and password")
+		}
+	case WebhookAuthOAuth2:
+		if !strings.HasPrefix(auth.TokenURL, "https://") {
+			return invalidParamError("auth.token_url", "token URL must be HTTPS")
+		}
+		if auth.ClientID == "" || auth.ClientSecret == "" {
+			return invalidParamError("auth", "OAuth 2.0 requires a client ID and client secret")
+		}
+	default:
+		return invalidParamError("auth.type", "auth type must be none, basic or oauth2")
+	}
+	return nil
+}
+
+// validHeaderName reports whether name is an RFC 7230 token.
+func validHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if r > 0x7e || r <= ' ' || strings.ContainsRune(`"(),/:;<=>?@[\]{}`, r) {
+			return false
+		}
+	}
+	return true
+}
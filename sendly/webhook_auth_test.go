@@ -0,0 +1,92 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWebhooksService_CreateWithAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		auth, _ := body["auth"].(map[string]interface{})
+		if auth["type"] != "oauth2" || auth["client_secret"] != "cs_1" || auth["token_url"] != "https://auth.example.com/token" {
+			t.Errorf("unexpected auth: %v", body["auth"])
+		}
+		if headers, _ := body["headers"].(map[string]interface{}); headers["X-Api-Key"] != "k_1" {
+			t.Errorf("unexpected headers: %v", body["headers"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"whk_1","url":"https://example.com/hook","events":["message.delivered"],"header_names":["X-Api-Key"],"auth_type":"oauth2"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	created, err := client.WebhooksService.Create(context.Background(), CreateWebhookRequest{
+		URL:     "https://example.com/hook",
+		Events:  []string{"message.delivered"},
+		Headers: map[string]string{"X-Api-Key": "k_1"},
+		Auth: &WebhookAuth{
+			Type:         WebhookAuthOAuth2,
+			TokenURL:     "https://auth.example.com/token",
+			ClientID:     "sendly",
+			ClientSecret: "cs_1",
+			Scopes:       []string{"webhooks:write"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.AuthType != WebhookAuthOAuth2 || len(created.HeaderNames) != 1 || created.HeaderNames[0] != "X-Api-Key" {
+		t.Errorf("unexpected webhook: %+v", created.Webhook)
+	}
+}
+
+func TestWebhooksService_AuthValidation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	tooMany := make(map[string]string)
+	for i := 0; i <= MaxWebhookHeaders; i++ {
+		tooMany["X-Header-"+strings.Repeat("a", i+1)] = "v"
+	}
+	tests := []struct {
+		headers map[string]string
+		auth    *WebhookAuth
+	}{
+		{headers: tooMany},
+		{headers: map[string]string{"Bad Header": "v"}},
+		{headers: map[string]string{"x-sendly-signature": "v"}},
+		{headers: map[string]string{"ce-type": "v"}},
+		{headers: map[string]string{"Content-Type": "text/plain"}},
+		{headers: map[string]string{"X-Key": "a\r\nX-Injected: b"}},
+		{headers: map[string]string{"Authorization": "Bearer x"}, auth: &WebhookAuth{Type: WebhookAuthBasic, Username: "u", Password: "p"}},
+		{auth: &WebhookAuth{Type: WebhookAuthBasic, Username: "u"}},
+		{auth: &WebhookAuth{Type: WebhookAuthOAuth2, TokenURL: "http://auth.example.com/token", ClientID: "c", ClientSecret: "s"}},
+		{auth: &WebhookAuth{Type: WebhookAuthOAuth2, TokenURL: "https://auth.example.com/token", ClientID: "c"}},
+		{auth: &WebhookAuth{Type: "digest"}},
+	}
+	for i, tt := range tests {
+		_, err := client.WebhooksService.Create(ctx, CreateWebhookRequest{
+			URL:     "https://example.com/hook",
+			Events:  []string{"message.delivered"},
+			Headers: tt.headers,
+			Auth:    tt.auth,
+		})
+		if !IsValidationError(err) {
+			t.Errorf("case %d: expected validation error, got %v", i, err)
+		}
+		_, err = client.WebhooksService.Update(ctx, "whk_1", UpdateWebhookRequest{Headers: tt.headers, Auth: tt.auth})
+		if !IsValidationError(err) {
+			t.Errorf("case %d: expected validation error on update, got %v", i, err)
+		}
+	}
+
+	if err := validateWebhookAuth(map[string]string{"Authorization": "Bearer x"}, &WebhookAuth{Type: WebhookAuthNone}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
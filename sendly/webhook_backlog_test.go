@@ -0,0 +1,56 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWebhooksService_Backlog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/webhooks/whk_1/backlog" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"webhook_id":"whk_1","pending":120,"retrying":30,"oldest_pending_at":"2025-01-01T00:00:00Z","oldest_pending_age_secs":900,"circuit_state":"half_open","measured_at":"2025-01-01T00:15:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	backlog, err := client.WebhooksService.Backlog(context.Background(), "whk_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if backlog.Total() != 150 {
+		t.Errorf("expected Total to be 150, got %d", backlog.Total())
+	}
+	if backlog.OldestPendingAge() != 15*time.Minute {
+		t.Errorf("expected OldestPendingAge to be 15m, got %v", backlog.OldestPendingAge())
+	}
+	if backlog.CircuitState != CircuitStateHalfOpen || backlog.OldestPendingAt == nil {
+		t.Errorf("unexpected backlog: %+v", backlog)
+	}
+}
+
+func TestWebhooksService_BacklogEmpty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"webhook_id":"whk_1","pending":0,"retrying":0,"oldest_pending_age_secs":0,"circuit_state":"closed","measured_at":"2025-01-01T00:15:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	backlog, err := client.WebhooksService.Backlog(context.Background(), "whk_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if backlog.Total() != 0 || backlog.OldestPendingAge() != 0 || backlog.OldestPendingAt != nil {
+		t.Errorf("unexpected backlog: %+v", backlog)
+	}
+
+	if _, err := client.WebhooksService.Backlog(context.Background(), "wh_1"); !IsValidationError(err) {
+		t.Errorf("expected validation error for webhook ID, got %v", err)
+	}
+}
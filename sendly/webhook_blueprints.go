@@ -0,0 +1,230 @@
+package sendly
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// WebhookEventFilter limits a blueprint's webhooks to events whose Field
+// (a dotted path into the event data, e.g. "metadata.tenant_id") equals
+// Value. Value may contain {{variable}} placeholders.
+type WebhookEventFilter struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
+// WebhookBlueprint is a template for per-tenant webhooks. Its URLPattern
+// and filter values contain {{variable}} placeholders that are filled in
+// from tenant variables when the blueprint is instantiated. Changing a
+// blueprint updates every webhook instantiated from it.
+type WebhookBlueprint struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// URLPattern is the webhook URL with placeholders, e.g.
+	// "https://{{tenant}}.example.com/sendly/events".
+	URLPattern string               `json:"url_pattern"`
+	Events     []string             `json:"events"`
+	Mode       WebhookMode          `json:"mode,omitempty"`
+	Filters    []WebhookEventFilter `json:"filters,omitempty"`
+	// Variables are the placeholder names used by URLPattern and Filters.
+	Variables []string `json:"variables"`
+	// InstanceCount is the number of webhooks instantiated from the
+	// blueprint.
+	InstanceCount int    `json:"instance_count"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+// CreateWebhookBlueprintRequest represents the parameters for creating a
+// webhook blueprint.
+type CreateWebhookBlueprintRequest struct {
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	URLPattern  string               `json:"url_pattern"`
+	Events      []string             `json:"events"`
+	Mode        WebhookMode          `json:"mode,omitempty"`
+	Filters     []WebhookEventFilter `json:"filters,omitempty"`
+}
+
+// UpdateWebhookBlueprintRequest represents the parameters for updating a
+// webhook blueprint. Nil fields are left unchanged. Changes are applied to
+// every instantiated webhook; URL changes are rolled out as migrations.
+type UpdateWebhookBlueprintRequest struct {
+	Name        *string              `json:"name,omitempty"`
+	Description *string              `json:"description,omitempty"`
+	URLPattern  *string              `json:"url_pattern,omitempty"`
+	Events      []string             `json:"events,omitempty"`
+	Mode        *WebhookMode         `json:"mode,omitempty"`
+	Filters     []WebhookEventFilter `json:"filters,omitempty"`
+}
+
+// RenderURL fills URLPattern with vars locally, reporting missing
+// variables as a *ValidationError.
+func (b *WebhookBlueprint) RenderURL(vars map[string]string) (string, error) {
+	if err := checkBlueprintVars(b.URLPattern, b.Filters, vars); err != nil {
+		return "", err
+	}
+	return templatePlaceholder.ReplaceAllStringFunc(b.URLPattern, func(m string) string {
+		return url.PathEscape(vars[templatePlaceholder.FindStringSubmatch(m)[1]])
+	}), nil
+}
+
+// blueprintVariables returns the sorted placeholder names in pattern and
+// the filter values.
+func blueprintVariables(pattern string, filters []WebhookEventFilter) []string {
+	seen := make(map[string]bool)
+	texts := []string{pattern}
+	for _, f := range filters {
+		texts = append(texts, f.Value)
+	}
+	var names []string
+	for _, text := range texts {
+		for _, m := range templatePlaceholder.FindAllStringSubmatch(text, -1) {
+			if !seen[m[1]] {
+				seen[m[1]] = true
+				names = append(names, m[1])
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// checkBlueprintVars reports the first placeholder without a non-empty
+// value in vars.
+func checkBlueprintVars(pattern string, filters []WebhookEventFilter, vars map[string]string) error {
+	for _, name := range blueprintVariables(pattern, filters) {
+		if vars[name] == "" {
+			return invalidParamError("tenant_vars."+name, "variable "+name+" is required")
+		}
+	}
+	return nil
+}
+
+func validateBlueprintURLPattern(pattern string) error {
+	if !strings.HasPrefix(pattern, "https://") {
+		return invalidParamError("url_pattern", "webhook URL must be HTTPS")
+	}
+	return nil
+}
+
+// CreateBlueprint creates a webhook blueprint.
+func (s *WebhooksService) CreateBlueprint(ctx context.Context, req CreateWebhookBlueprintRequest) (*WebhookBlueprint, error) {
+	if req.Name == "" {
+		return nil, invalidParamError("name", "name is required")
+	}
+	if err := validateBlueprintURLPattern(req.URLPattern); err != nil {
+		return nil, err
+	}
+	if len(req.Events) == 0 {
+		return nil, invalidParamError("events", "at least one event type is required")
+	}
+
+	var blueprint WebhookBlueprint
+	if err := s.client.request(ctx, "POST", "/webhooks/blueprints", req, &blueprint); err != nil {
+		return nil, err
+	}
+	return &blueprint, nil
+}
+
+// ListBlueprints returns all webhook blueprints for the account.
+func (s *WebhooksService) ListBlueprints(ctx context.Context) ([]WebhookBlueprint, error) {
+	var resp struct {
+		Data []WebhookBlueprint `json:"data"`
+	}
+	if err := s.client.request(ctx, "GET", "/webhooks/blueprints", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// GetBlueprint retrieves a webhook blueprint by ID.
+func (s *WebhooksService) GetBlueprint(ctx context.Context, blueprintID string) (*WebhookBlueprint, error) {
+	if blueprintID == "" {
+		return nil, invalidParamError("blueprint_id", "blueprint ID is required")
+	}
+
+	var blueprint WebhookBlueprint
+	if err := s.client.request(ctx, "GET", "/webhooks/blueprints/"+url.PathEscape(blueprintID), nil, &blueprint); err != nil {
+		return nil, err
+	}
+	return &blueprint, nil
+}
+
+// UpdateBlueprint changes a webhook blueprint and every webhook
+// instantiated from it.
+func (s *WebhooksService) UpdateBlueprint(ctx context.Context, blueprintID string, req UpdateWebhookBlueprintRequest) (*WebhookBlueprint, error) {
+	if blueprintID == "" {
+		return nil, invalidParamError("blueprint_id", "blueprint ID is required")
+	}
+	if req.URLPattern != nil {
+		if err := validateBlueprintURLPattern(*req.URLPattern); err != nil {
+			return nil, err
+		}
+	}
+
+	var blueprint WebhookBlueprint
+	if err := s.client.request(ctx, "PATCH", "/webhooks/blueprints/"+url.PathEscape(blueprintID), req, &blueprint); err != nil {
+		return nil, err
+	}
+	return &blueprint, nil
+}
+
+// DeleteBlueprint removes a webhook blueprint. Its instantiated webhooks
+// are kept as ordinary webhooks.
+func (s *WebhooksService) DeleteBlueprint(ctx context.Context, blueprintID string) error {
+	if blueprintID == "" {
+		return invalidParamError("blueprint_id", "blueprint ID is required")
+	}
+	return s.client.request(ctx, "DELETE", "/webhooks/blueprints/"+url.PathEscape(blueprintID), nil, nil)
+}
+
+// InstantiateForTenant creates a webhook from a blueprint, filling its
+// placeholders with tenantVars. Instantiating a blueprint again with the
+// same variables returns the existing webhook without a secret.
+//
+// Example:
+//
+//	hook, err := client.WebhooksService.InstantiateForTenant(ctx, "whbp_xxx", map[string]string{
+//	    "tenant": "acme",
+//	})
+//	store.SaveWebhookSecret("acme", hook.Secret)
+func (s *WebhooksService) InstantiateForTenant(ctx context.Context, blueprintID string, tenantVars map[string]string) (*WebhookCreatedResponse, error) {
+	if blueprintID == "" {
+		return nil, invalidParamError("blueprint_id", "blueprint ID is required")
+	}
+	if len(tenantVars) == 0 {
+		return nil, invalidParamError("tenant_vars", "tenant variables are required")
+	}
+
+	body := map[string]interface{}{"tenant_vars": tenantVars}
+	var apiResp webhookAPIResponse
+	if err := s.client.request(ctx, "POST", "/webhooks/blueprints/"+url.PathEscape(blueprintID)+"/instances", body, &apiResp); err != nil {
+		return nil, err
+	}
+	return &WebhookCreatedResponse{
+		Webhook: transformWebhook(apiResp),
+		Secret:  apiResp.Secret,
+	}, nil
+}
+
+// ListBlueprintInstances returns the webhooks instantiated from a
+// blueprint.
+func (s *WebhooksService) ListBlueprintInstances(ctx context.Context, blueprintID string) ([]Webhook, error) {
+	if blueprintID == "" {
+		return nil, invalidParamError("blueprint_id", "blueprint ID is required")
+	}
+
+	var apiResp []webhookAPIResponse
+	if err := s.client.request(ctx, "GET", "/webhooks/blueprints/"+url.PathEscape(blueprintID)+"/instances", nil, &apiResp); err != nil {
+		return nil, err
+	}
+	webhooks := make([]Webhook, len(apiResp))
+	for i, api := range apiResp {
+		webhooks[i] = transformWebhook(api)
+	}
+	return webhooks, nil
+}
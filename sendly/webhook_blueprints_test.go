@@ -0,0 +1,55 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWebhooksService_InstantiateForTenant(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/webhooks/blueprints/whbp_1/instances" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			TenantVars map[string]string `json:"tenant_vars"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.TenantVars["tenant"] != "acme" {
+			t.Errorf("expected tenant to be 'acme', got '%s'", body.TenantVars["tenant"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"whk_1","url":"https://acme.example.com/hooks","events":["message.delivered"],"is_active":true,"blueprint_id":"whbp_1","tenant_vars":{"tenant":"acme"},"secret":"whsec_1","created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	hook, err := client.WebhooksService.InstantiateForTenant(context.Background(), "whbp_1", map[string]string{"tenant": "acme"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hook.BlueprintID != "whbp_1" || hook.TenantVars["tenant"] != "acme" || hook.Secret != "whsec_1" {
+		t.Errorf("unexpected webhook: %+v", hook)
+	}
+}
+
+func TestWebhookBlueprint_RenderURL(t *testing.T) {
+	b := &WebhookBlueprint{
+		URLPattern: "https://{{tenant}}.example.com/hooks/{{ env }}",
+		Filters:    []WebhookEventFilter{{Field: "metadata.region", Value: "{{region}}"}},
+	}
+
+	got, err := b.RenderURL(map[string]string{"tenant": "acme", "env": "prod/eu", "region": "eu"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "https://acme.example.com/hooks/prod%2Feu" {
+		t.Errorf("expected URL to be 'https://acme.example.com/hooks/prod%%2Feu', got '%s'", got)
+	}
+
+	if _, err := b.RenderURL(map[string]string{"tenant": "acme", "env": "prod"}); !IsValidationError(err) {
+		t.Errorf("expected validation error for missing region, got %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWebhooksService_ChaosConfig(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks/whk_1/chaos" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		methods = append(methods, r.Method)
+		switch r.Method {
+		case "PUT":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["enabled"] != true || body["failure_rate"] != 0.25 || body["delay_distribution"] != "uniform" {
+				t.Errorf("unexpected body: %v", body)
+			}
+			if _, ok := body["duplicate_rate"]; ok {
+				t.Error("expected unset duplicate_rate to be omitted")
+			}
+			fallthrough
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"enabled":true,"failure_rate":0.25,"failure_status_code":503,"min_delay_ms":100,"max_delay_ms":2000,"delay_distribution":"uniform"}`))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	updated, err := client.WebhooksService.UpdateChaosConfig(ctx, "whk_1", WebhookChaosConfig{
+		Enabled:           true,
+		FailureRate:       0.25,
+		FailureStatusCode: 503,
+		MinDelayMs:        100,
+		MaxDelayMs:        2000,
+		DelayDistribution: DelayDistributionUniform,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !updated.Enabled || updated.FailureStatusCode != 503 || updated.DelayDistribution != DelayDistributionUniform {
+		t.Errorf("unexpected config: %+v", updated)
+	}
+
+	config, err := client.WebhooksService.GetChaosConfig(ctx, "whk_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.MaxDelayMs != 2000 {
+		t.Errorf("expected MaxDelayMs to be 2000, got %d", config.MaxDelayMs)
+	}
+
+	if err := client.WebhooksService.DisableChaos(ctx, "whk_1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(methods) != 3 || methods[0] != "PUT" || methods[1] != "GET" || methods[2] != "DELETE" {
+		t.Errorf("unexpected requests: %v", methods)
+	}
+}
+
+func TestWebhooksService_ChaosConfigValidation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	if _, err := client.WebhooksService.GetChaosConfig(ctx, "wh_1"); !IsValidationError(err) {
+		t.Errorf("expected validation error for webhook ID, got %v", err)
+	}
+	if err := client.WebhooksService.DisableChaos(ctx, ""); !IsValidationError(err) {
+		t.Errorf("expected validation error for webhook ID, got %v", err)
+	}
+	for _, config := range []WebhookChaosConfig{
+		{FailureRate: 1.5},
+		{FailureRate: -0.1},
+		{DuplicateRate: 2},
+		{MinDelayMs: -1},
+		{MinDelayMs: 500, MaxDelayMs: 100},
+	} {
+		if _, err := client.WebhooksService.UpdateChaosConfig(ctx, "whk_1", config); !IsValidationError(err) {
+			t.Errorf("%+v: expected validation error, got %v", config, err)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package sendly
+
+import (
+	"context"
+	"strings"
+)
+
+// ResetCircuit closes a webhook's open circuit breaker, for use once the
+// receiver has been fixed. Deliveries held while the circuit was open are
+// retried and the failure count is reset. Resetting a closed circuit is a
+// no-op.
+//
+// Example:
+//
+//	hook, err := client.WebhooksService.Get(ctx, "whk_xxx")
+//	if err == nil && hook.CircuitState == sendly.CircuitStateOpen {
+//	    hook, err = client.WebhooksService.ResetCircuit(ctx, hook.ID)
+//	}
+func (s *WebhooksService) ResetCircuit(ctx context.Context, webhookID string) (*Webhook, error) {
+	return s.circuitAction(ctx, webhookID, "circuit/reset")
+}
+
+// Pause stops deliveries to a webhook without deactivating it, e.g. during
+// receiver maintenance. Events keep queueing and are delivered in order on
+// Resume; queued events still expire after the retry window.
+func (s *WebhooksService) Pause(ctx context.Context, webhookID string) (*Webhook, error) {
+	return s.circuitAction(ctx, webhookID, "pause")
+}
+
+// Resume restarts deliveries to a paused webhook, starting with the events
+// queued while it was paused.
+func (s *WebhooksService) Resume(ctx context.Context, webhookID string) (*Webhook, error) {
+	return s.circuitAction(ctx, webhookID, "resume")
+}
+
+func (s *WebhooksService) circuitAction(ctx context.Context, webhookID, action string) (*Webhook, error) {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+
+	var apiResp webhookAPIResponse
+	if err := s.client.request(ctx, "POST", "/webhooks/"+webhookID+"/"+action, nil, &apiResp); err != nil {
+		return nil, err
+	}
+
+	webhook := transformWebhook(apiResp)
+	return &webhook, nil
+}
@@ -0,0 +1,64 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWebhooksService_CircuitControl(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/webhooks/whk_1/pause":
+			w.Write([]byte(`{"id":"whk_1","is_active":true,"circuit_state":"closed","paused_at":"2025-01-15T09:00:00Z"}`))
+		default:
+			w.Write([]byte(`{"id":"whk_1","is_active":true,"circuit_state":"closed"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	hook, err := client.WebhooksService.ResetCircuit(ctx, "whk_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hook.CircuitState != CircuitStateClosed {
+		t.Errorf("expected circuit state to be 'closed', got '%s'", hook.CircuitState)
+	}
+
+	hook, err = client.WebhooksService.Pause(ctx, "whk_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hook.PausedAt == nil {
+		t.Error("expected PausedAt to be set")
+	}
+
+	hook, err = client.WebhooksService.Resume(ctx, "whk_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hook.PausedAt != nil {
+		t.Errorf("expected PausedAt to be nil, got %v", hook.PausedAt)
+	}
+
+	want := []string{"/webhooks/whk_1/circuit/reset", "/webhooks/whk_1/pause", "/webhooks/whk_1/resume"}
+	for i, p := range want {
+		if i >= len(paths) || paths[i] != p {
+			t.Errorf("expected request %d to be %s, got %v", i, p, paths)
+		}
+	}
+
+	if _, err := client.WebhooksService.Pause(ctx, "bad"); !IsValidationError(err) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
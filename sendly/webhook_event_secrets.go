@@ -0,0 +1,118 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// WebhookEventSecret is a signing secret dedicated to one event type (or a
+// category wildcard such as "message.*") on a webhook. Events of that type
+// are signed with it instead of the webhook secret.
+type WebhookEventSecret struct {
+	EventType string `json:"event_type"`
+	// Secret is the signing secret. It is only returned by SetEventSecret.
+	Secret string `json:"secret,omitempty"`
+	// PreviousSecretExpiresAt is set when SetEventSecret replaced an
+	// existing secret; the old one keeps verifying until then.
+	PreviousSecretExpiresAt string `json:"previous_secret_expires_at,omitempty"`
+	CreatedAt               string `json:"created_at"`
+}
+
+// WebhookEventSecretList is the list of per-event secrets on a webhook.
+type WebhookEventSecretList struct {
+	Data []WebhookEventSecret `json:"data"`
+}
+
+// SetEventSecret creates, or rotates, a signing secret for one event type on
+// a webhook. eventType may be an exact type or a category wildcard such as
+// "verify.*". The secret is only returned once.
+func (s *WebhooksService) SetEventSecret(ctx context.Context, webhookID, eventType string) (*WebhookEventSecret, error) {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+	if eventType == "" {
+		return nil, invalidParamError("event_type", "event type is required")
+	}
+
+	body := map[string]string{"event_type": eventType}
+	var resp WebhookEventSecret
+	if err := s.client.request(ctx, "POST", "/webhooks/"+webhookID+"/event-secrets", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListEventSecrets lists the event types with dedicated secrets on a
+// webhook. Secret values are not returned.
+func (s *WebhooksService) ListEventSecrets(ctx context.Context, webhookID string) (*WebhookEventSecretList, error) {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+
+	var resp WebhookEventSecretList
+	if err := s.client.request(ctx, "GET", "/webhooks/"+webhookID+"/event-secrets", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteEventSecret removes a per-event secret. Events of that type are
+// signed with the webhook secret again.
+func (s *WebhooksService) DeleteEventSecret(ctx context.Context, webhookID, eventType string) error {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+	if eventType == "" {
+		return invalidParamError("event_type", "event type is required")
+	}
+	return s.client.request(ctx, "DELETE", "/webhooks/"+webhookID+"/event-secrets/"+url.PathEscape(eventType), nil, nil)
+}
+
+// EventSecrets maps event types to the secrets they are signed with. Keys
+// are exact event types or category wildcards such as "message.*". A
+// consumer that should only accept its own events lists just those types
+// and leaves Default empty.
+type EventSecrets struct {
+	// Default is the webhook secret, used for types with no entry.
+	Default string
+	ByType  map[string]string
+}
+
+// For returns the secret for an event type: an exact match first, then a
+// category wildcard, then Default.
+func (s EventSecrets) For(eventType WebhookEventType) string {
+	if secret, ok := s.ByType[string(eventType)]; ok {
+		return secret
+	}
+	if category, _, ok := strings.Cut(string(eventType), "."); ok {
+		if secret, ok := s.ByType[category+".*"]; ok {
+			return secret
+		}
+	}
+	return s.Default
+}
+
+// ConstructEventWithSecrets is like ConstructEvent for webhooks with
+// per-event secrets. The event type is read from the body to pick the
+// secret, and the whole body is then verified with it, so an event can only
+// be accepted with the secret configured for its type.
+func ConstructEventWithSecrets(body []byte, signature string, secrets EventSecrets) (*Event, error) {
+	return constructEventWithSecrets(body, signature, secrets, EventDecodeDefault)
+}
+
+func constructEventWithSecrets(body []byte, signature string, secrets EventSecrets, mode EventDecodeMode) (*Event, error) {
+	var envelope struct {
+		Type WebhookEventType `json:"type"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook payload: %w", err)
+	}
+	secret := secrets.For(envelope.Type)
+	if secret == "" {
+		return nil, ErrInvalidSignature
+	}
+	return constructEvent(body, signature, secret, mode)
+}
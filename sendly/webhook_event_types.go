@@ -0,0 +1,91 @@
+package sendly
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+//go:generate go run ../cmd/sendly-eventgen -catalog event_catalog.json -o event_types_gen.go
+
+// EventTypes returns every event type in the catalog this SDK version was
+// generated from, sorted.
+func EventTypes() []WebhookEventType {
+	return append([]WebhookEventType(nil), eventCatalog...)
+}
+
+// IsKnownEventType reports whether t is in the event catalog.
+func IsKnownEventType(t WebhookEventType) bool {
+	for _, known := range eventCatalog {
+		if known == t {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesEventPattern reports whether t matches an event subscription: an
+// exact type, a category wildcard such as "message.*", or "*".
+func matchesEventPattern(pattern string, t WebhookEventType) bool {
+	if pattern == "*" || pattern == string(t) {
+		return true
+	}
+	prefix, ok := strings.CutSuffix(pattern, "*")
+	return ok && strings.HasSuffix(prefix, ".") && strings.HasPrefix(string(t), prefix)
+}
+
+// eventPatternRE matches a well-formed event subscription: "*", a dotted
+// event type, or a dotted prefix followed by ".*".
+var eventPatternRE = regexp.MustCompile(`^(\*|[a-z0-9_]+(\.[a-z0-9_]+)*(\.\*)?)$`)
+
+// validateEventTypes rejects malformed event subscriptions and, unless
+// allowUnknown is set, subscriptions that match no catalog event type.
+func validateEventTypes(events []string, allowUnknown bool) error {
+	for i, e := range events {
+		param := "events[" + strconv.Itoa(i) + "]"
+		if !eventPatternRE.MatchString(e) {
+			return invalidParamError(param, "malformed event type "+strconv.Quote(e))
+		}
+		if !allowUnknown && !matchesCatalog(e) {
+			return invalidParamError(param, "unknown event type "+strconv.Quote(e)+"; use WithUnknownEventTypes to subscribe to types newer than this SDK")
+		}
+	}
+	return nil
+}
+
+// matchesCatalog reports whether pattern matches at least one catalog
+// event type.
+func matchesCatalog(pattern string) bool {
+	for _, t := range eventCatalog {
+		if matchesEventPattern(pattern, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithAllEventsExcept returns a copy of r subscribed to every catalog event
+// type except those matching except, which may include category wildcards
+// such as "verify.*".
+//
+// Example:
+//
+//	req := sendly.CreateWebhookRequest{URL: "https://example.com/hooks"}.
+//	    WithAllEventsExcept(sendly.EventMessageQueued, "template.*")
+//	webhook, err := client.WebhooksService.Create(ctx, req)
+func (r CreateWebhookRequest) WithAllEventsExcept(except ...WebhookEventType) CreateWebhookRequest {
+	r.Events = nil
+	for _, t := range eventCatalog {
+		excluded := false
+		for _, e := range except {
+			if matchesEventPattern(string(e), t) {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			r.Events = append(r.Events, string(t))
+		}
+	}
+	return r
+}
@@ -0,0 +1,89 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateWebhookRequest_WithAllEventsExcept(t *testing.T) {
+	req := CreateWebhookRequest{URL: "https://example.com/hooks"}.WithAllEventsExcept(EventMessageQueued, "verify.*")
+
+	if req.URL != "https://example.com/hooks" {
+		t.Errorf("expected URL to be kept, got '%s'", req.URL)
+	}
+	subscribed := make(map[string]bool, len(req.Events))
+	for _, e := range req.Events {
+		subscribed[e] = true
+	}
+	for _, want := range []WebhookEventType{EventMessageDelivered, EventContactOptedIn, EventTemplatePublished} {
+		if !subscribed[string(want)] {
+			t.Errorf("expected %s to be subscribed", want)
+		}
+	}
+	for _, unwanted := range []WebhookEventType{EventMessageQueued, EventVerifyCompleted, EventVerifySessionExpired} {
+		if subscribed[string(unwanted)] {
+			t.Errorf("expected %s not to be subscribed", unwanted)
+		}
+	}
+	if len(req.Events) != len(EventTypes())-6 {
+		t.Errorf("expected %d events, got %d", len(EventTypes())-6, len(req.Events))
+	}
+}
+
+func TestWebhooksService_EventTypeValidation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	for _, events := range [][]string{
+		{"message*"},
+		{"message.delivered", "Message.Delivered"},
+		{"message..sent"},
+		{"message.*.sent"},
+		{""},
+		{"message.deliverd"},
+		{"payments.*"},
+	} {
+		_, err := client.WebhooksService.Create(ctx, CreateWebhookRequest{URL: "https://example.com/hooks", Events: events})
+		if !IsValidationError(err) {
+			t.Errorf("Create %v: expected validation error, got %v", events, err)
+		}
+		_, err = client.WebhooksService.Update(ctx, "whk_1", UpdateWebhookRequest{Events: events})
+		if !IsValidationError(err) {
+			t.Errorf("Update %v: expected validation error, got %v", events, err)
+		}
+	}
+
+	if err := validateEventTypes([]string{"*", "message.*", "verify.session.*", string(EventContactOptedIn)}, false); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	// Types newer than the catalog are accepted only when allowed.
+	if err := validateEventTypes([]string{"message.read", "payments.*"}, true); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := validateEventTypes([]string{"message..read"}, true); !IsValidationError(err) {
+		t.Errorf("expected malformed types to be rejected even when unknown types are allowed, got %v", err)
+	}
+	if !IsKnownEventType(EventMessageExpired) || IsKnownEventType("message.*") {
+		t.Error("unexpected IsKnownEventType result")
+	}
+}
+
+func TestWebhooksService_WithUnknownEventTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"whk_1","url":"https://example.com/hooks","events":["message.read"],"secret":"whsec_1"}`))
+	}))
+	defer server.Close()
+
+	req := CreateWebhookRequest{URL: "https://example.com/hooks", Events: []string{"message.read"}}
+	strict := NewClient("test-api-key", WithBaseURL(server.URL))
+	if _, err := strict.WebhooksService.Create(context.Background(), req); !IsValidationError(err) {
+		t.Errorf("expected validation error by default, got %v", err)
+	}
+	lenient := NewClient("test-api-key", WithBaseURL(server.URL), WithUnknownEventTypes(true))
+	if _, err := lenient.WebhooksService.Create(context.Background(), req); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,238 @@
+package sendly
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// SignatureHeader is the HTTP header carrying the webhook signature.
+const SignatureHeader = "X-Sendly-Signature"
+
+// DefaultMaxWebhookBodyBytes is the default request body limit for webhook handlers.
+const DefaultMaxWebhookBodyBytes = 1 << 20
+
+// EventDeduplicator records processed event IDs so redelivered events are
+// acknowledged without being handled twice. Implementations must be safe for
+// concurrent use; back it with a shared store when running several replicas.
+type EventDeduplicator interface {
+	// Seen reports whether the event ID has already been processed.
+	Seen(eventID string) bool
+	// MarkProcessed records that the event ID was handled successfully.
+	MarkProcessed(eventID string)
+}
+
+// EventClaimer is implemented by deduplicators that can claim an event ID
+// atomically. Handlers claim an event before handling it, so concurrent
+// redeliveries of the same event are not handled twice; deduplicators
+// that do not implement it only skip events that were already processed.
+type EventClaimer interface {
+	// TryClaim claims eventID for handling. It reports false if the event
+	// was already processed or is claimed by another delivery.
+	TryClaim(eventID string) bool
+	// Release gives up a claim once the event has been handled, whether
+	// or not it was marked processed.
+	Release(eventID string)
+}
+
+// MemoryDeduplicator is an in-process EventDeduplicator that forgets event
+// IDs after a TTL.
+type MemoryDeduplicator struct {
+	ttl       time.Duration
+	mu        sync.Mutex
+	seen      map[string]time.Time
+	claimed   map[string]bool
+	lastPrune time.Time
+}
+
+// NewMemoryDeduplicator creates an in-memory deduplicator. Sendly retries
+// failed deliveries for up to three days, so the TTL should be at least that.
+func NewMemoryDeduplicator(ttl time.Duration) *MemoryDeduplicator {
+	return &MemoryDeduplicator{ttl: ttl, seen: make(map[string]time.Time), claimed: make(map[string]bool)}
+}
+
+// Seen reports whether eventID was marked within the TTL.
+func (d *MemoryDeduplicator) Seen(eventID string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.seenLocked(eventID)
+}
+
+func (d *MemoryDeduplicator) seenLocked(eventID string) bool {
+	at, ok := d.seen[eventID]
+	return ok && time.Since(at) < d.ttl
+}
+
+// TryClaim claims eventID unless it was marked within the TTL or is
+// already claimed.
+func (d *MemoryDeduplicator) TryClaim(eventID string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.claimed[eventID] || d.seenLocked(eventID) {
+		return false
+	}
+	d.claimed[eventID] = true
+	return true
+}
+
+// Release gives up the claim on eventID.
+func (d *MemoryDeduplicator) Release(eventID string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.claimed, eventID)
+}
+
+// MarkProcessed records eventID and prunes expired entries.
+func (d *MemoryDeduplicator) MarkProcessed(eventID string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := time.Now()
+	d.seen[eventID] = now
+	if now.Sub(d.lastPrune) > d.ttl/10 {
+		for id, at := range d.seen {
+			if now.Sub(at) >= d.ttl {
+				delete(d.seen, id)
+			}
+		}
+		d.lastPrune = now
+	}
+}
+
+// WebhookHandlerOptions configures a webhook http.Handler.
+type WebhookHandlerOptions struct {
+	// Deduplicator skips events that were already processed. Defaults to an
+	// in-memory deduplicator with a 72 hour TTL.
+	Deduplicator EventDeduplicator
+	// MaxBodyBytes limits the request body size (default: 1 MiB).
+	MaxBodyBytes int64
+	// EventSecrets verifies the listed event types (or "category.*"
+	// wildcards) with their per-event secrets instead of the webhook secret.
+	EventSecrets map[string]string
+	// NonceStore enables replay protection: events older than
+	// ReplayTolerance, or already accepted within it, are rejected. See
+	// CheckReplay.
+	NonceStore NonceStore
+	// ReplayTolerance is the maximum event age accepted when NonceStore is
+	// set (default: DefaultReplayTolerance).
+	ReplayTolerance time.Duration
+	// DecodeMode controls how events with undecodable data are handled.
+	// With the default mode they are rejected with a 400; use
+	// EventDecodeLenient to pass them to fn as an *UnknownEvent payload.
+	DecodeMode EventDecodeMode
+}
+
+// Handler returns an http.Handler that verifies, parses, and deduplicates
+// webhook events before calling fn. Webhooks using a CloudEvents payload
+// format are detected and decoded into the same Event.
+//
+// Responses follow Sendly's retry semantics:
+//   - 200 when fn succeeds or the event was already processed
+//   - 409 while another delivery of the same event is being handled, if
+//     the Deduplicator implements EventClaimer
+//   - 400 for malformed payloads and 401 for invalid signatures (not useful to retry)
+//   - 409 for replayed events and 400 for events outside the replay
+//     tolerance, when a NonceStore is configured
+//   - 500 when fn returns an error, so the delivery is retried
+//
+// Example:
+//
+//	http.Handle("/webhooks/sendly", sendly.Webhooks{}.Handler(secret, func(e *sendly.Event) error {
+//	    if msg, ok := e.Message(); ok {
+//	        return markDelivered(msg.MessageID)
+//	    }
+//	    return nil
+//	}))
+func (w Webhooks) Handler(secret string, fn func(*Event) error) http.Handler {
+	return w.HandlerWithOptions(secret, fn, WebhookHandlerOptions{})
+}
+
+// HandlerWithOptions is like Handler but accepts options.
+func (w Webhooks) HandlerWithOptions(secret string, fn func(*Event) error, opts WebhookHandlerOptions) http.Handler {
+	if opts.Deduplicator == nil {
+		opts.Deduplicator = NewMemoryDeduplicator(72 * time.Hour)
+	}
+	if opts.MaxBodyBytes <= 0 {
+		opts.MaxBodyBytes = DefaultMaxWebhookBodyBytes
+	}
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			rw.Header().Set("Allow", http.MethodPost)
+			http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(rw, r.Body, opts.MaxBodyBytes))
+		if err != nil {
+			var maxErr *http.MaxBytesError
+			if errors.As(err, &maxErr) {
+				http.Error(rw, "payload too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(rw, "failed to read body", http.StatusBadRequest)
+			return
+		}
+
+		var event *Event
+		if IsCloudEvent(r.Header) {
+			event, err = constructCloudEvent(r.Header, body, EventSecrets{
+				Default: secret,
+				ByType:  opts.EventSecrets,
+			}, opts.DecodeMode)
+		} else if len(opts.EventSecrets) > 0 {
+			event, err = constructEventWithSecrets(body, r.Header.Get(SignatureHeader), EventSecrets{
+				Default: secret,
+				ByType:  opts.EventSecrets,
+			}, opts.DecodeMode)
+		} else {
+			event, err = constructEvent(body, r.Header.Get(SignatureHeader), secret, opts.DecodeMode)
+		}
+		if err == ErrInvalidSignature {
+			http.Error(rw, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			http.Error(rw, "invalid payload", http.StatusBadRequest)
+			return
+		}
+
+		if opts.Deduplicator.Seen(event.ID) {
+			rw.WriteHeader(http.StatusOK)
+			return
+		}
+		if claimer, ok := opts.Deduplicator.(EventClaimer); ok {
+			if !claimer.TryClaim(event.ID) {
+				http.Error(rw, "event in progress", http.StatusConflict)
+				return
+			}
+			defer claimer.Release(event.ID)
+		}
+
+		if opts.NonceStore != nil {
+			switch err := CheckReplay(r.Context(), opts.NonceStore, event, opts.ReplayTolerance); {
+			case err == ErrEventReplayed:
+				http.Error(rw, "event replayed", http.StatusConflict)
+				return
+			case err == ErrEventTooOld:
+				http.Error(rw, "event outside replay tolerance", http.StatusBadRequest)
+				return
+			case err != nil:
+				http.Error(rw, "nonce store error", http.StatusInternalServerError)
+				return
+			}
+		}
+
+		if err := fn(event); err != nil {
+			if opts.NonceStore != nil {
+				opts.NonceStore.Remove(r.Context(), event.ID)
+			}
+			http.Error(rw, "handler error", http.StatusInternalServerError)
+			return
+		}
+
+		opts.Deduplicator.MarkProcessed(event.ID)
+		rw.WriteHeader(http.StatusOK)
+	})
+}
@@ -0,0 +1,192 @@
+package sendly
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWebhookHandler(t *testing.T) {
+	const secret = "whsec_test"
+	body := `{"id":"evt_1","type":"message.delivered","created_at":"2024-01-01T00:00:00Z","data":{"message_id":"msg_1","status":"delivered"}}`
+	signature := Webhooks{}.GenerateSignature(body, secret)
+
+	calls := 0
+	fail := false
+	handler := Webhooks{}.Handler(secret, func(e *Event) error {
+		calls++
+		if fail {
+			return errors.New("downstream unavailable")
+		}
+		if msg, ok := e.Message(); !ok || msg.MessageID != "msg_1" {
+			t.Errorf("unexpected event payload: %+v", e.Payload)
+		}
+		return nil
+	})
+
+	send := func(method, body, signature string) int {
+		req := httptest.NewRequest(method, "/webhooks", strings.NewReader(body))
+		req.Header.Set(SignatureHeader, signature)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := send("GET", body, signature); code != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405 for GET, got %d", code)
+	}
+	if code := send("POST", body, "sha256=bad"); code != http.StatusUnauthorized {
+		t.Errorf("expected 401 for bad signature, got %d", code)
+	}
+	badBody := `{"id":"evt_2"}`
+	if code := send("POST", badBody, Webhooks{}.GenerateSignature(badBody, secret)); code != http.StatusBadRequest {
+		t.Errorf("expected 400 for invalid payload, got %d", code)
+	}
+
+	fail = true
+	if code := send("POST", body, signature); code != http.StatusInternalServerError {
+		t.Errorf("expected 500 when handler fails, got %d", code)
+	}
+	fail = false
+	if code := send("POST", body, signature); code != http.StatusOK {
+		t.Errorf("expected 200 on retry, got %d", code)
+	}
+	if code := send("POST", body, signature); code != http.StatusOK {
+		t.Errorf("expected 200 for duplicate, got %d", code)
+	}
+	if calls != 2 {
+		t.Errorf("expected handler to be called twice (failure + success), got %d", calls)
+	}
+}
+
+func TestWebhookHandler_ConcurrentDuplicates(t *testing.T) {
+	const secret = "whsec_test"
+	body := `{"id":"evt_1","type":"message.delivered","created_at":"2024-01-01T00:00:00Z","data":{"message_id":"msg_1","status":"delivered"}}`
+	signature := Webhooks{}.GenerateSignature(body, secret)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var calls int32
+	handler := Webhooks{}.Handler(secret, func(e *Event) error {
+		atomic.AddInt32(&calls, 1)
+		close(started)
+		<-release
+		return nil
+	})
+	send := func() int {
+		req := httptest.NewRequest("POST", "/webhooks", strings.NewReader(body))
+		req.Header.Set(SignatureHeader, signature)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	first := make(chan int)
+	go func() { first <- send() }()
+	<-started
+	if code := send(); code != http.StatusConflict {
+		t.Errorf("expected 409 while the event is in progress, got %d", code)
+	}
+	close(release)
+	if code := <-first; code != http.StatusOK {
+		t.Errorf("expected 200 for the first delivery, got %d", code)
+	}
+	if code := send(); code != http.StatusOK {
+		t.Errorf("expected 200 for a later duplicate, got %d", code)
+	}
+	if calls != 1 {
+		t.Errorf("expected handler to be called once, got %d", calls)
+	}
+}
+
+func TestMemoryDeduplicator_TryClaim(t *testing.T) {
+	d := NewMemoryDeduplicator(time.Hour)
+	if !d.TryClaim("evt_1") || d.TryClaim("evt_1") {
+		t.Fatal("expected only the first claim to succeed")
+	}
+	d.Release("evt_1")
+	if !d.TryClaim("evt_1") {
+		t.Fatal("expected a released event to be claimable")
+	}
+	d.MarkProcessed("evt_1")
+	d.Release("evt_1")
+	if d.TryClaim("evt_1") {
+		t.Error("expected a processed event not to be claimable")
+	}
+}
+
+func TestWebhookHandler_BodyLimit(t *testing.T) {
+	handler := Webhooks{}.HandlerWithOptions("secret", func(e *Event) error { return nil }, WebhookHandlerOptions{MaxBodyBytes: 10})
+
+	req := httptest.NewRequest("POST", "/webhooks", strings.NewReader(strings.Repeat("x", 100)))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected 413, got %d", rec.Code)
+	}
+}
+
+func TestWebhookHandler_EventSecrets(t *testing.T) {
+	const webhookSecret = "whsec_default"
+	const verifySecret = "whsec_verify"
+	verifyBody := `{"id":"evt_1","type":"verify.completed","created_at":"2024-01-01T00:00:00Z","data":{"verification_id":"ver_1"}}`
+	messageBody := `{"id":"evt_2","type":"message.sent","created_at":"2024-01-01T00:00:00Z","data":{"message_id":"msg_1"}}`
+
+	handler := Webhooks{}.HandlerWithOptions(webhookSecret, func(e *Event) error { return nil }, WebhookHandlerOptions{
+		EventSecrets: map[string]string{"verify.*": verifySecret},
+	})
+	send := func(body, secret string) int {
+		req := httptest.NewRequest("POST", "/webhooks", strings.NewReader(body))
+		req.Header.Set(SignatureHeader, Webhooks{}.GenerateSignature(body, secret))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := send(verifyBody, verifySecret); code != http.StatusOK {
+		t.Errorf("expected 200 for verify event with its secret, got %d", code)
+	}
+	if code := send(verifyBody, webhookSecret); code != http.StatusUnauthorized {
+		t.Errorf("expected 401 for verify event with the webhook secret, got %d", code)
+	}
+	if code := send(messageBody, webhookSecret); code != http.StatusOK {
+		t.Errorf("expected 200 for message event with the webhook secret, got %d", code)
+	}
+}
+
+func TestConstructEventWithSecrets_NoDefault(t *testing.T) {
+	body := []byte(`{"id":"evt_2","type":"message.sent","created_at":"2024-01-01T00:00:00Z","data":{}}`)
+	signature := Webhooks{}.GenerateSignature(string(body), "whsec_default")
+	secrets := EventSecrets{ByType: map[string]string{"verify.completed": "whsec_verify"}}
+	if _, err := ConstructEventWithSecrets(body, signature, secrets); err != ErrInvalidSignature {
+		t.Errorf("expected ErrInvalidSignature for event outside the consumer's types, got %v", err)
+	}
+}
+
+func TestWebhookHandler_LenientDecoding(t *testing.T) {
+	const secret = "whsec_test"
+	body := `{"id":"evt_1","type":"message.delivered","created_at":"2024-01-01T00:00:00Z","data":{"segments":"two"}}`
+
+	var got *Event
+	handler := Webhooks{}.HandlerWithOptions(secret, func(e *Event) error {
+		got = e
+		return nil
+	}, WebhookHandlerOptions{DecodeMode: EventDecodeLenient})
+
+	req := httptest.NewRequest("POST", "/webhooks", strings.NewReader(body))
+	req.Header.Set(SignatureHeader, Webhooks{}.GenerateSignature(body, secret))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if unknown, ok := got.Unknown(); !ok || unknown.Err == nil {
+		t.Errorf("expected unknown payload with decode error, got %+v", got.Payload)
+	}
+}
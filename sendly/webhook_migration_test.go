@@ -0,0 +1,105 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWebhooksService_Migration(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /webhooks/whk_1/migration":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["new_url"] != "https://new.example.com/hooks" || body["shadow_window_secs"] != float64(7200) || body["auto_cutover_threshold"] != float64(99) {
+				t.Errorf("unexpected body: %v", body)
+			}
+			if _, ok := body["min_deliveries"]; ok {
+				t.Error("expected unset min_deliveries to be omitted")
+			}
+			w.Write([]byte(`{"webhook_id":"whk_1","status":"shadowing","old":{"url":"https://old.example.com/hooks"},"new":{"url":"https://new.example.com/hooks"},"auto_cutover_threshold":99,"started_at":"2025-01-01T00:00:00Z","ends_at":"2025-01-01T02:00:00Z"}`))
+		case "GET /webhooks/whk_1/migration":
+			w.Write([]byte(`{"webhook_id":"whk_1","status":"shadowing","old":{"url":"https://old.example.com/hooks","deliveries":50,"successful":40,"success_rate":80,"avg_response_time_ms":900},"new":{"url":"https://new.example.com/hooks","deliveries":50,"successful":50,"success_rate":100,"avg_response_time_ms":120}}`))
+		case "POST /webhooks/whk_1/migration/cutover":
+			w.Write([]byte(`{"webhook_id":"whk_1","status":"cut_over","cut_over_at":"2025-01-01T01:00:00Z"}`))
+		case "DELETE /webhooks/whk_1/migration":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	migration, err := client.WebhooksService.StartMigration(ctx, "whk_1", StartWebhookMigrationRequest{
+		NewURL:               "https://new.example.com/hooks",
+		ShadowWindow:         2 * time.Hour,
+		AutoCutoverThreshold: 99,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if migration.Status != WebhookMigrationStatusShadowing || migration.New.URL != "https://new.example.com/hooks" {
+		t.Errorf("unexpected migration: %+v", migration)
+	}
+
+	migration, err = client.WebhooksService.GetMigration(ctx, "whk_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if migration.Old.SuccessRate != 80 || migration.New.Successful != 50 || migration.New.AvgResponseTimeMs != 120 {
+		t.Errorf("unexpected stats: %+v", migration)
+	}
+
+	migration, err = client.WebhooksService.CutOver(ctx, "whk_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if migration.Status != WebhookMigrationStatusCutOver || migration.CutOverAt == nil {
+		t.Errorf("unexpected migration: %+v", migration)
+	}
+
+	if err := client.WebhooksService.CancelMigration(ctx, "whk_1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(requests) != 4 {
+		t.Errorf("expected 4 requests, got %v", requests)
+	}
+}
+
+func TestWebhooksService_MigrationValidation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	for _, req := range []StartWebhookMigrationRequest{
+		{},
+		{NewURL: "http://new.example.com/hooks"},
+		{NewURL: "https://new.example.com/hooks", AutoCutoverThreshold: 101},
+		{NewURL: "https://new.example.com/hooks", AutoCutoverThreshold: -1},
+	} {
+		if _, err := client.WebhooksService.StartMigration(ctx, "whk_1", req); !IsValidationError(err) {
+			t.Errorf("%+v: expected validation error, got %v", req, err)
+		}
+	}
+	if _, err := client.WebhooksService.StartMigration(ctx, "wh_1", StartWebhookMigrationRequest{NewURL: "https://new.example.com/hooks"}); !IsValidationError(err) {
+		t.Errorf("StartMigration: expected validation error for webhook ID, got %v", err)
+	}
+	if _, err := client.WebhooksService.GetMigration(ctx, ""); !IsValidationError(err) {
+		t.Errorf("GetMigration: expected validation error, got %v", err)
+	}
+	if _, err := client.WebhooksService.CutOver(ctx, "1"); !IsValidationError(err) {
+		t.Errorf("CutOver: expected validation error, got %v", err)
+	}
+	if err := client.WebhooksService.CancelMigration(ctx, ""); !IsValidationError(err) {
+		t.Errorf("CancelMigration: expected validation error, got %v", err)
+	}
+}
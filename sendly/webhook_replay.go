@@ -0,0 +1,133 @@
+package sendly
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultReplayTolerance is how old an event may be before CheckReplay
+// rejects it.
+const DefaultReplayTolerance = 5 * time.Minute
+
+var (
+	// ErrEventReplayed is returned by CheckReplay for an event that was
+	// already accepted within the tolerance window.
+	ErrEventReplayed = errors.New("sendly: webhook event replayed")
+	// ErrEventTooOld is returned by CheckReplay for an event created outside
+	// the tolerance window.
+	ErrEventTooOld = errors.New("sendly: webhook event outside replay tolerance")
+)
+
+// NonceStore records webhook event nonces so a captured delivery cannot be
+// replayed within the tolerance window. Implementations must be atomic
+// across every replica that receives webhooks; MemoryNonceStore only covers
+// a single process. See the sendlyredis package for a shared store.
+type NonceStore interface {
+	// Add records nonce until expiresAt. It returns false if the nonce is
+	// already recorded and has not expired.
+	Add(ctx context.Context, nonce string, expiresAt time.Time) (bool, error)
+	// Remove forgets nonce, so a delivery whose handler failed can be
+	// retried.
+	Remove(ctx context.Context, nonce string) error
+}
+
+// CheckReplay rejects events created more than tolerance ago (or in the
+// future) and events whose ID is already in store. The event's created_at is
+// covered by the signature, so it cannot be refreshed by an attacker.
+// tolerance defaults to DefaultReplayTolerance.
+//
+// Sendly retries failed deliveries with the original created_at, so retries
+// arriving after the tolerance are rejected too. Remove the nonce when
+// handling fails so retries within the window are accepted.
+func CheckReplay(ctx context.Context, store NonceStore, event *Event, tolerance time.Duration) error {
+	if tolerance <= 0 {
+		tolerance = DefaultReplayTolerance
+	}
+	created, err := time.Parse(time.RFC3339, event.CreatedAt)
+	if err != nil {
+		return ErrEventTooOld
+	}
+	if age := time.Since(created); age > tolerance || age < -tolerance {
+		return ErrEventTooOld
+	}
+
+	added, err := store.Add(ctx, event.ID, created.Add(tolerance))
+	if err != nil {
+		return err
+	}
+	if !added {
+		return ErrEventReplayed
+	}
+	return nil
+}
+
+// MemoryNonceStore is an in-process NonceStore that holds at most a fixed
+// number of nonces, evicting the least recently added when full.
+type MemoryNonceStore struct {
+	capacity int
+	mu       sync.Mutex
+	order    *list.List
+	entries  map[string]*list.Element
+}
+
+type nonceEntry struct {
+	nonce     string
+	expiresAt time.Time
+}
+
+// NewMemoryNonceStore creates an in-memory nonce store holding up to
+// capacity nonces (default: 10000). Size it above the number of events
+// received per tolerance window, or evicted nonces can be replayed.
+func NewMemoryNonceStore(capacity int) *MemoryNonceStore {
+	if capacity <= 0 {
+		capacity = 10000
+	}
+	return &MemoryNonceStore{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// Add implements NonceStore.
+func (s *MemoryNonceStore) Add(ctx context.Context, nonce string, expiresAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if el, ok := s.entries[nonce]; ok {
+		if now.Before(el.Value.(*nonceEntry).expiresAt) {
+			return false, nil
+		}
+		s.order.Remove(el)
+		delete(s.entries, nonce)
+	}
+
+	// Drop expired entries from the back before evicting live ones.
+	for back := s.order.Back(); back != nil && !now.Before(back.Value.(*nonceEntry).expiresAt); back = s.order.Back() {
+		s.order.Remove(back)
+		delete(s.entries, back.Value.(*nonceEntry).nonce)
+	}
+	for s.order.Len() >= s.capacity {
+		back := s.order.Back()
+		s.order.Remove(back)
+		delete(s.entries, back.Value.(*nonceEntry).nonce)
+	}
+
+	s.entries[nonce] = s.order.PushFront(&nonceEntry{nonce: nonce, expiresAt: expiresAt})
+	return true, nil
+}
+
+// Remove implements NonceStore.
+func (s *MemoryNonceStore) Remove(ctx context.Context, nonce string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if el, ok := s.entries[nonce]; ok {
+		s.order.Remove(el)
+		delete(s.entries, nonce)
+	}
+	return nil
+}
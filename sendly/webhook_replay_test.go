@@ -0,0 +1,86 @@
+package sendly
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type noopDeduplicator struct{}
+
+func (noopDeduplicator) Seen(string) bool     { return false }
+func (noopDeduplicator) MarkProcessed(string) {}
+
+func TestMemoryNonceStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryNonceStore(2)
+	exp := time.Now().Add(time.Minute)
+
+	if ok, _ := store.Add(ctx, "a", exp); !ok {
+		t.Error("expected first add to succeed")
+	}
+	if ok, _ := store.Add(ctx, "a", exp); ok {
+		t.Error("expected duplicate add to fail")
+	}
+	store.Add(ctx, "b", exp)
+	store.Add(ctx, "c", exp)
+	if ok, _ := store.Add(ctx, "a", exp); !ok {
+		t.Error("expected evicted nonce to be accepted again")
+	}
+
+	store.Remove(ctx, "c")
+	if ok, _ := store.Add(ctx, "c", exp); !ok {
+		t.Error("expected removed nonce to be accepted again")
+	}
+	if ok, _ := store.Add(ctx, "d", time.Now().Add(-time.Second)); !ok {
+		t.Error("expected add to succeed")
+	}
+	if ok, _ := store.Add(ctx, "d", exp); !ok {
+		t.Error("expected expired nonce to be accepted again")
+	}
+}
+
+func TestWebhookHandler_ReplayProtection(t *testing.T) {
+	const secret = "whsec_test"
+	event := func(id string, created time.Time) string {
+		return fmt.Sprintf(`{"id":%q,"type":"message.delivered","created_at":%q,"data":{"message_id":"msg_1","status":"delivered"}}`, id, created.UTC().Format(time.RFC3339))
+	}
+
+	fail := false
+	handler := Webhooks{}.HandlerWithOptions(secret, func(e *Event) error {
+		if fail {
+			return fmt.Errorf("downstream unavailable")
+		}
+		return nil
+	}, WebhookHandlerOptions{
+		Deduplicator: noopDeduplicator{},
+		NonceStore:   NewMemoryNonceStore(0),
+	})
+	send := func(body string) int {
+		req := httptest.NewRequest("POST", "/webhooks", strings.NewReader(body))
+		req.Header.Set(SignatureHeader, Webhooks{}.GenerateSignature(body, secret))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	fresh := event("evt_1", time.Now())
+	fail = true
+	if code := send(fresh); code != http.StatusInternalServerError {
+		t.Errorf("expected 500 when handler fails, got %d", code)
+	}
+	fail = false
+	if code := send(fresh); code != http.StatusOK {
+		t.Errorf("expected 200 on retry after failure, got %d", code)
+	}
+	if code := send(fresh); code != http.StatusConflict {
+		t.Errorf("expected 409 for replay, got %d", code)
+	}
+	if code := send(event("evt_2", time.Now().Add(-time.Hour))); code != http.StatusBadRequest {
+		t.Errorf("expected 400 for stale event, got %d", code)
+	}
+}
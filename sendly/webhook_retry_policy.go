@@ -0,0 +1,73 @@
+package sendly
+
+import (
+	"fmt"
+	"time"
+)
+
+// WebhookBackoff is how the delay between delivery retries grows.
+type WebhookBackoff string
+
+const (
+	// WebhookBackoffExponential doubles the delay after each attempt (the
+	// default).
+	WebhookBackoffExponential WebhookBackoff = "exponential"
+	// WebhookBackoffLinear adds InitialInterval to the delay after each
+	// attempt.
+	WebhookBackoffLinear WebhookBackoff = "linear"
+	// WebhookBackoffFixed waits InitialInterval between every attempt.
+	WebhookBackoffFixed WebhookBackoff = "fixed"
+)
+
+const (
+	// MaxWebhookAttempts is the maximum number of delivery attempts per
+	// event, including the first.
+	MaxWebhookAttempts = 10
+	// MaxWebhookAttemptTimeout is the longest a delivery attempt may wait
+	// for the endpoint to respond.
+	MaxWebhookAttemptTimeout = 30 * time.Second
+)
+
+// WebhookRetryPolicy controls how a webhook's deliveries are retried. In
+// requests, zero fields use the account defaults; on a Webhook every field
+// holds the effective value.
+type WebhookRetryPolicy struct {
+	// MaxAttempts is the number of delivery attempts per event, including
+	// the first (1 to MaxWebhookAttempts). Set it to 1 to disable retries.
+	MaxAttempts int            `json:"max_attempts,omitempty"`
+	Backoff     WebhookBackoff `json:"backoff,omitempty"`
+	// InitialInterval is the delay before the first retry. It is sent in
+	// whole seconds.
+	InitialInterval time.Duration `json:"-"`
+	// MaxInterval caps the delay between retries. It is sent in whole
+	// seconds.
+	MaxInterval time.Duration `json:"-"`
+	// AttemptTimeout is how long each attempt waits for a response before
+	// it counts as failed (at most MaxWebhookAttemptTimeout). It is sent
+	// in whole seconds.
+	AttemptTimeout time.Duration `json:"-"`
+}
+
+func (p *WebhookRetryPolicy) validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.MaxAttempts < 0 || p.MaxAttempts > MaxWebhookAttempts {
+		return invalidParamError("retry_policy.max_attempts", fmt.Sprintf("max attempts must be between 1 and %d", MaxWebhookAttempts))
+	}
+	switch p.Backoff {
+	case "", WebhookBackoffExponential, WebhookBackoffLinear, WebhookBackoffFixed:
+	default:
+		return invalidParamError("retry_policy.backoff", "backoff must be exponential, linear or fixed")
+	}
+	if p.InitialInterval < 0 || p.MaxInterval < 0 {
+		return invalidParamError("retry_policy", "retry intervals must not be negative")
+	}
+	if p.InitialInterval > 0 && p.MaxInterval > 0 && p.MaxInterval < p.InitialInterval {
+		return invalidParamError("retry_policy.max_interval_secs", "max interval must not be less than the initial interval")
+	}
+	if p.AttemptTimeout < 0 || p.AttemptTimeout > MaxWebhookAttemptTimeout {
+		return invalidParamError("retry_policy.attempt_timeout_secs", fmt.Sprintf("attempt timeout must be at most %s", MaxWebhookAttemptTimeout))
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWebhooksService_RetryPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/webhooks/whk_1" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		policy, _ := body["retry_policy"].(map[string]interface{})
+		if policy["max_attempts"] != float64(3) || policy["backoff"] != "fixed" || policy["attempt_timeout_secs"] != float64(5) || policy["initial_interval_secs"] != float64(2) {
+			t.Errorf("unexpected retry policy: %v", body["retry_policy"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"whk_1","url":"https://example.com/hook","events":["message.delivered"],
+			"retry_policy":{"max_attempts":3,"backoff":"fixed","initial_interval_secs":2,"max_interval_secs":2,"attempt_timeout_secs":5}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	webhook, err := client.WebhooksService.Update(context.Background(), "whk_1", UpdateWebhookRequest{
+		RetryPolicy: &WebhookRetryPolicy{
+			MaxAttempts:     3,
+			Backoff:         WebhookBackoffFixed,
+			InitialInterval: 2 * time.Second,
+			AttemptTimeout:  5 * time.Second,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := WebhookRetryPolicy{MaxAttempts: 3, Backoff: WebhookBackoffFixed, InitialInterval: 2 * time.Second, MaxInterval: 2 * time.Second, AttemptTimeout: 5 * time.Second}
+	if webhook.RetryPolicy != want {
+		t.Errorf("expected retry policy %+v, got %+v", want, webhook.RetryPolicy)
+	}
+}
+
+func TestWebhookRetryPolicy_Validation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	tests := []*WebhookRetryPolicy{
+		{MaxAttempts: -1},
+		{MaxAttempts: MaxWebhookAttempts + 1},
+		{Backoff: "random"},
+		{InitialInterval: -time.Second},
+		{InitialInterval: time.Minute, MaxInterval: time.Second},
+		{AttemptTimeout: time.Minute},
+	}
+	for i, policy := range tests {
+		_, err := client.WebhooksService.Create(ctx, CreateWebhookRequest{
+			URL:         "https://example.com/hook",
+			Events:      []string{"message.delivered"},
+			RetryPolicy: policy,
+		})
+		if !IsValidationError(err) {
+			t.Errorf("case %d: expected validation error, got %v", i, err)
+		}
+	}
+}
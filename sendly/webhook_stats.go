@@ -0,0 +1,99 @@
+package sendly
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// WebhookStatsGranularity is the bucket size of webhook delivery stats.
+type WebhookStatsGranularity string
+
+const (
+	WebhookStatsHourly WebhookStatsGranularity = "hour"
+	WebhookStatsDaily  WebhookStatsGranularity = "day"
+)
+
+// WebhookStatsOptions are options for WebhooksService.GetStats.
+type WebhookStatsOptions struct {
+	// Granularity is the bucket size (default: hour).
+	Granularity WebhookStatsGranularity
+	// From is the beginning of the period (default: 24 hours before To).
+	From time.Time
+	// To is the end of the period (default: now).
+	To time.Time
+}
+
+// WebhookStatsBucket is delivery activity for one period. Counts are
+// delivery attempts, so an event retried twice counts three times.
+type WebhookStatsBucket struct {
+	// Start is the beginning of the bucket. It is zero for totals.
+	Start     time.Time `json:"start"`
+	Attempts  int       `json:"attempts"`
+	Delivered int       `json:"delivered"`
+	Failed    int       `json:"failed"`
+	// SuccessRate is Delivered as a percentage of Attempts (0-100).
+	SuccessRate float64 `json:"success_rate"`
+	// FailureReasons counts failed attempts by error code, such as
+	// "timeout", "connection_refused" or "http_503".
+	FailureReasons map[string]int `json:"failure_reasons,omitempty"`
+	// ResponseTimeP50Ms and ResponseTimeP95Ms are endpoint response time
+	// percentiles in milliseconds, 0 when there were no responses.
+	ResponseTimeP50Ms int `json:"response_time_p50_ms"`
+	ResponseTimeP95Ms int `json:"response_time_p95_ms"`
+}
+
+// WebhookStats is a time series of a webhook's delivery activity.
+type WebhookStats struct {
+	WebhookID   string                  `json:"webhook_id"`
+	Granularity WebhookStatsGranularity `json:"granularity"`
+	From        time.Time               `json:"from"`
+	To          time.Time               `json:"to"`
+	Buckets     []WebhookStatsBucket    `json:"buckets"`
+	// Totals aggregates every bucket.
+	Totals WebhookStatsBucket `json:"totals"`
+}
+
+// GetStats returns delivery counts, failure reasons and response time
+// percentiles for a webhook, bucketed by hour or day. Unlike the lifetime
+// totals on Webhook, it shows when an endpoint started failing or slowing
+// down.
+//
+// Example:
+//
+//	stats, err := client.WebhooksService.GetStats(ctx, "whk_xxx", &sendly.WebhookStatsOptions{
+//	    From: time.Now().Add(-6 * time.Hour),
+//	})
+//	for _, b := range stats.Buckets {
+//	    fmt.Printf("%s %.1f%% p95=%dms %v\n", b.Start.Format(time.Kitchen), b.SuccessRate, b.ResponseTimeP95Ms, b.FailureReasons)
+//	}
+func (s *WebhooksService) GetStats(ctx context.Context, webhookID string, opts *WebhookStatsOptions) (*WebhookStats, error) {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+
+	params := make(map[string]string)
+	if opts != nil {
+		switch opts.Granularity {
+		case "", WebhookStatsHourly, WebhookStatsDaily:
+		default:
+			return nil, invalidParamError("granularity", "granularity must be hour or day")
+		}
+		if !opts.From.IsZero() && !opts.To.IsZero() && !opts.To.After(opts.From) {
+			return nil, invalidParamError("to", "to must be after from")
+		}
+		params["granularity"] = string(opts.Granularity)
+		if !opts.From.IsZero() {
+			params["from"] = opts.From.UTC().Format(time.RFC3339)
+		}
+		if !opts.To.IsZero() {
+			params["to"] = opts.To.UTC().Format(time.RFC3339)
+		}
+	}
+
+	var resp WebhookStats
+	if err := s.client.request(ctx, "GET", "/webhooks/"+webhookID+"/stats"+buildQueryString(params), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
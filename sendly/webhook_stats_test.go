@@ -0,0 +1,66 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWebhooksService_GetStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks/whk_1/stats" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("granularity") != "day" || q.Get("from") != "2025-01-01T00:00:00Z" || q.Has("to") {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"webhook_id":"whk_1","granularity":"day","from":"2025-01-01T00:00:00Z","to":"2025-01-03T00:00:00Z",
+			"buckets":[
+				{"start":"2025-01-01T00:00:00Z","attempts":100,"delivered":100,"success_rate":100,"response_time_p50_ms":80,"response_time_p95_ms":210},
+				{"start":"2025-01-02T00:00:00Z","attempts":120,"delivered":90,"failed":30,"success_rate":75,"failure_reasons":{"timeout":25,"http_503":5},"response_time_p50_ms":900,"response_time_p95_ms":4800}
+			],
+			"totals":{"attempts":220,"delivered":190,"failed":30,"success_rate":86.4,"failure_reasons":{"timeout":25,"http_503":5}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	stats, err := client.WebhooksService.GetStats(context.Background(), "whk_1", &WebhookStatsOptions{
+		Granularity: WebhookStatsDaily,
+		From:        time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stats.Buckets) != 2 {
+		t.Fatalf("expected 2 buckets, got %d", len(stats.Buckets))
+	}
+	b := stats.Buckets[1]
+	if !b.Start.Equal(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)) || b.FailureReasons["timeout"] != 25 || b.ResponseTimeP95Ms != 4800 {
+		t.Errorf("unexpected bucket: %+v", b)
+	}
+	if stats.Totals.Attempts != 220 || !stats.Totals.Start.IsZero() {
+		t.Errorf("unexpected totals: %+v", stats.Totals)
+	}
+}
+
+func TestWebhooksService_GetStatsValidation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+	now := time.Now()
+
+	if _, err := client.WebhooksService.GetStats(ctx, "wh_1", nil); !IsValidationError(err) {
+		t.Errorf("expected validation error for webhook ID, got %v", err)
+	}
+	for _, opts := range []*WebhookStatsOptions{
+		{Granularity: "minute"},
+		{From: now, To: now.Add(-time.Hour)},
+	} {
+		if _, err := client.WebhooksService.GetStats(ctx, "whk_1", opts); !IsValidationError(err) {
+			t.Errorf("expected validation error for %+v, got %v", opts, err)
+		}
+	}
+}
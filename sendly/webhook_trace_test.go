@@ -0,0 +1,73 @@
+package sendly
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWebhooksService_GetDeliveryTrace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks/whk_1/deliveries/del_1/trace" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("attempt"); got != "2" {
+			t.Errorf("expected attempt to be '2', got '%s'", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"delivery_id":"del_1","attempt_number":2,"started_at":"2025-01-01T00:00:00Z","remote_addr":"203.0.113.7:443",
+			"timings":{"dns_ms":3,"connect_ms":20,"tls_handshake_ms":45,"time_to_first_byte_ms":4900,"total_ms":5000},
+			"tls":{"version":"TLS 1.3","cipher_suite":"TLS_AES_128_GCM_SHA256","server_name":"example.com"},
+			"request":{"method":"POST","url":"https://example.com/hooks","headers":{"Content-Type":"application/json"},"body":"{}"},
+			"response":{"status_code":504,"headers":{},"body":"gateway timeout","body_truncated":false}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	trace, err := client.WebhooksService.GetDeliveryTrace(context.Background(), "whk_1", "del_1", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if trace.AttemptNumber != 2 || trace.Timings.TimeToFirstByteMs != 4900 || trace.Timings.TotalMs != 5000 {
+		t.Errorf("unexpected trace: %+v", trace)
+	}
+	if trace.TLS == nil || trace.TLS.Version != "TLS 1.3" {
+		t.Errorf("unexpected TLS: %+v", trace.TLS)
+	}
+	if trace.Request.Method != "POST" || trace.Response == nil || trace.Response.StatusCode != 504 {
+		t.Errorf("unexpected request/response: %+v %+v", trace.Request, trace.Response)
+	}
+}
+
+func TestWebhooksService_GetDeliveryTraceLatestAttempt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("expected no query for the latest attempt, got '%s'", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"delivery_id":"del_1","attempt_number":3,"timings":{"dns_ms":3000,"total_ms":3000},"request":{"method":"POST","url":"https://example.com/hooks"},"error":"dns lookup timed out"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	trace, err := client.WebhooksService.GetDeliveryTrace(context.Background(), "whk_1", "del_1", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if trace.Response != nil || trace.Error != "dns lookup timed out" {
+		t.Errorf("expected a transport failure without a response, got %+v", trace)
+	}
+}
+
+func TestWebhooksService_GetDeliveryTraceValidation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	if _, err := client.WebhooksService.GetDeliveryTrace(ctx, "wh_1", "del_1", 0); !IsValidationError(err) {
+		t.Errorf("expected validation error for webhook ID, got %v", err)
+	}
+	if _, err := client.WebhooksService.GetDeliveryTrace(ctx, "whk_1", "1", 0); !IsValidationError(err) {
+		t.Errorf("expected validation error for delivery ID, got %v", err)
+	}
+}
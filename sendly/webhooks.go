@@ -14,12 +14,34 @@ import (
 // WebhookEventType represents the type of webhook event
 type WebhookEventType string
 
+// The WebhookEvent* names predate the generated Event* constants and are
+// kept as aliases of them.
 const (
-	WebhookEventMessageQueued      WebhookEventType = "message.queued"
-	WebhookEventMessageSent        WebhookEventType = "message.sent"
-	WebhookEventMessageDelivered   WebhookEventType = "message.delivered"
-	WebhookEventMessageFailed      WebhookEventType = "message.failed"
-	WebhookEventMessageUndelivered WebhookEventType = "message.undelivered"
+	WebhookEventMessageQueued      = EventMessageQueued
+	WebhookEventMessageSent        = EventMessageSent
+	WebhookEventMessageDelivered   = EventMessageDelivered
+	WebhookEventMessageFailed      = EventMessageFailed
+	WebhookEventMessageUndelivered = EventMessageUndelivered
+	WebhookEventMessageReceived    = EventMessageReceived
+	WebhookEventMessageExpired     = EventMessageExpired
+
+	WebhookEventWebhookCreated    = EventWebhookCreated
+	WebhookEventWebhookUpdated    = EventWebhookUpdated
+	WebhookEventWebhookDeleted    = EventWebhookDeleted
+	WebhookEventTemplateCreated   = EventTemplateCreated
+	WebhookEventTemplateUpdated   = EventTemplateUpdated
+	WebhookEventTemplatePublished = EventTemplatePublished
+	WebhookEventTemplateDeleted   = EventTemplateDeleted
+	WebhookEventNumberUpdated     = EventNumberUpdated
+
+	WebhookEventVerifyCompleted = EventVerifyCompleted
+	WebhookEventVerifyFailed    = EventVerifyFailed
+	WebhookEventVerifyExpired   = EventVerifyExpired
+
+	WebhookEventVerifySessionCompleted = EventVerifySessionCompleted
+	WebhookEventVerifySessionExpired   = EventVerifySessionExpired
+
+	WebhookEventContactOptedIn = EventContactOptedIn
 )
 
 // WebhookMessageStatus represents the status of a message in webhook events
@@ -32,8 +54,19 @@ const (
 	WebhookStatusFailed      WebhookMessageStatus = "failed"
 	WebhookStatusBounced     WebhookMessageStatus = "bounced"
 	WebhookStatusUndelivered WebhookMessageStatus = "undelivered"
+	WebhookStatusExpired     WebhookMessageStatus = "expired"
 )
 
+// IsTerminal reports whether the status is final for the message.
+func (s WebhookMessageStatus) IsTerminal() bool {
+	switch s {
+	case WebhookStatusDelivered, WebhookStatusFailed, WebhookStatusBounced, WebhookStatusUndelivered, WebhookStatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
 // WebhookMessageData contains the data payload for message webhook events
 type WebhookMessageData struct {
 	MessageID   string               `json:"message_id"`
@@ -44,6 +77,7 @@ type WebhookMessageData struct {
 	ErrorCode   string               `json:"error_code,omitempty"`
 	DeliveredAt string               `json:"delivered_at,omitempty"`
 	FailedAt    string               `json:"failed_at,omitempty"`
+	ExpiredAt   string               `json:"expired_at,omitempty"`
 	Segments    int                  `json:"segments"`
 	CreditsUsed int                  `json:"credits_used"`
 }
@@ -57,6 +91,28 @@ type WebhookEvent struct {
 	APIVersion string             `json:"api_version"`
 }
 
+// ResourceEventData contains the data payload for configuration change events
+// (webhook.*, template.*, number.*)
+type ResourceEventData struct {
+	ResourceType  string                 `json:"resource_type"`
+	ResourceID    string                 `json:"resource_id"`
+	Action        string                 `json:"action"`
+	ChangedFields []string               `json:"changed_fields,omitempty"`
+	Previous      map[string]interface{} `json:"previous,omitempty"`
+	Current       map[string]interface{} `json:"current,omitempty"`
+	ActorType     string                 `json:"actor_type,omitempty"`
+	ActorID       string                 `json:"actor_id,omitempty"`
+}
+
+// ResourceEvent represents a configuration change event from Sendly
+type ResourceEvent struct {
+	ID         string            `json:"id"`
+	Type       WebhookEventType  `json:"type"`
+	Data       ResourceEventData `json:"data"`
+	CreatedAt  string            `json:"created_at"`
+	APIVersion string            `json:"api_version"`
+}
+
 // ErrInvalidSignature is returned when webhook signature verification fails
 var ErrInvalidSignature = errors.New("invalid webhook signature")
 
@@ -126,6 +182,31 @@ func (w Webhooks) ParseEvent(payload, signature, secret string) (*WebhookEvent,
 	return &event, nil
 }
 
+// ParseResourceEvent parses and validates a configuration change event
+//
+// Example:
+//
+//	event, err := sendly.Webhooks{}.ParseResourceEvent(rawBody, signature, secret)
+//	if err == nil && event.Type == sendly.WebhookEventTemplateUpdated {
+//	    fmt.Printf("Template %s changed: %v\n", event.Data.ResourceID, event.Data.ChangedFields)
+//	}
+func (w Webhooks) ParseResourceEvent(payload, signature, secret string) (*ResourceEvent, error) {
+	if !w.VerifySignature(payload, signature, secret) {
+		return nil, ErrInvalidSignature
+	}
+
+	var event ResourceEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook payload: %w", err)
+	}
+
+	if event.ID == "" || event.Type == "" || event.CreatedAt == "" {
+		return nil, errors.New("invalid event structure")
+	}
+
+	return &event, nil
+}
+
 // GenerateSignature generates a webhook signature for testing purposes
 //
 // Parameters:
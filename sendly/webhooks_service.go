@@ -2,8 +2,8 @@ package sendly
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -21,36 +21,45 @@ type webhookAPIResponse struct {
 	Mode                 string                 `json:"mode"`
 	IsActive             bool                   `json:"is_active"`
 	FailureCount         int                    `json:"failure_count"`
-	LastFailureAt        *string                `json:"last_failure_at,omitempty"`
+	LastFailureAt        *apiTime               `json:"last_failure_at,omitempty"`
 	CircuitState         string                 `json:"circuit_state"`
-	CircuitOpenedAt      *string                `json:"circuit_opened_at,omitempty"`
+	CircuitOpenedAt      *apiTime               `json:"circuit_opened_at,omitempty"`
+	PausedAt             *apiTime               `json:"paused_at,omitempty"`
 	APIVersion           string                 `json:"api_version"`
 	Metadata             map[string]interface{} `json:"metadata,omitempty"`
-	CreatedAt            string                 `json:"created_at"`
-	UpdatedAt            string                 `json:"updated_at"`
+	CreatedAt            apiTime                `json:"created_at"`
+	UpdatedAt            apiTime                `json:"updated_at"`
 	TotalDeliveries      int                    `json:"total_deliveries"`
 	SuccessfulDeliveries int                    `json:"successful_deliveries"`
 	SuccessRate          float64                `json:"success_rate"`
-	LastDeliveryAt       *string                `json:"last_delivery_at,omitempty"`
+	LastDeliveryAt       *apiTime               `json:"last_delivery_at,omitempty"`
 	Secret               string                 `json:"secret,omitempty"`
+	TracingEnabled       bool                   `json:"tracing_enabled"`
+	EventSecretTypes     []string               `json:"event_secret_types,omitempty"`
+	BlueprintID          string                 `json:"blueprint_id,omitempty"`
+	TenantVars           map[string]string      `json:"tenant_vars,omitempty"`
+	PayloadFormat        string                 `json:"payload_format,omitempty"`
+	HeaderNames          []string               `json:"header_names,omitempty"`
+	AuthType             string                 `json:"auth_type,omitempty"`
+	RetryPolicy          WebhookRetryPolicy     `json:"retry_policy"`
 }
 
 // webhookDeliveryAPIResponse is the API response for webhook delivery.
 type webhookDeliveryAPIResponse struct {
-	ID                 string  `json:"id"`
-	WebhookID          string  `json:"webhook_id"`
-	EventID            string  `json:"event_id"`
-	EventType          string  `json:"event_type"`
-	AttemptNumber      int     `json:"attempt_number"`
-	MaxAttempts        int     `json:"max_attempts"`
-	Status             string  `json:"status"`
-	ResponseStatusCode *int    `json:"response_status_code,omitempty"`
-	ResponseTimeMs     *int    `json:"response_time_ms,omitempty"`
-	ErrorMessage       *string `json:"error_message,omitempty"`
-	ErrorCode          *string `json:"error_code,omitempty"`
-	NextRetryAt        *string `json:"next_retry_at,omitempty"`
-	CreatedAt          string  `json:"created_at"`
-	DeliveredAt        *string `json:"delivered_at,omitempty"`
+	ID                 string   `json:"id"`
+	WebhookID          string   `json:"webhook_id"`
+	EventID            string   `json:"event_id"`
+	EventType          string   `json:"event_type"`
+	AttemptNumber      int      `json:"attempt_number"`
+	MaxAttempts        int      `json:"max_attempts"`
+	Status             string   `json:"status"`
+	ResponseStatusCode *int     `json:"response_status_code,omitempty"`
+	ResponseTimeMs     *int     `json:"response_time_ms,omitempty"`
+	ErrorMessage       *string  `json:"error_message,omitempty"`
+	ErrorCode          *string  `json:"error_code,omitempty"`
+	NextRetryAt        *apiTime `json:"next_retry_at,omitempty"`
+	CreatedAt          apiTime  `json:"created_at"`
+	DeliveredAt        *apiTime `json:"delivered_at,omitempty"`
 }
 
 // transformWebhook converts API response to SDK type.
@@ -59,6 +68,14 @@ func transformWebhook(api webhookAPIResponse) Webhook {
 	if mode == "" {
 		mode = WebhookModeAll
 	}
+	format := WebhookPayloadFormat(api.PayloadFormat)
+	if format == "" {
+		format = WebhookFormatSendly
+	}
+	authType := WebhookAuthType(api.AuthType)
+	if authType == "" {
+		authType = WebhookAuthNone
+	}
 	return Webhook{
 		ID:                   api.ID,
 		URL:                  api.URL,
@@ -67,17 +84,26 @@ func transformWebhook(api webhookAPIResponse) Webhook {
 		Mode:                 mode,
 		IsActive:             api.IsActive,
 		FailureCount:         api.FailureCount,
-		LastFailureAt:        api.LastFailureAt,
+		LastFailureAt:        api.LastFailureAt.ptr(),
 		CircuitState:         CircuitState(api.CircuitState),
-		CircuitOpenedAt:      api.CircuitOpenedAt,
+		CircuitOpenedAt:      api.CircuitOpenedAt.ptr(),
+		PausedAt:             api.PausedAt.ptr(),
 		APIVersion:           api.APIVersion,
 		Metadata:             api.Metadata,
-		CreatedAt:            api.CreatedAt,
-		UpdatedAt:            api.UpdatedAt,
+		CreatedAt:            api.CreatedAt.Time,
+		UpdatedAt:            api.UpdatedAt.Time,
 		TotalDeliveries:      api.TotalDeliveries,
 		SuccessfulDeliveries: api.SuccessfulDeliveries,
 		SuccessRate:          api.SuccessRate,
-		LastDeliveryAt:       api.LastDeliveryAt,
+		LastDeliveryAt:       api.LastDeliveryAt.ptr(),
+		TracingEnabled:       api.TracingEnabled,
+		EventSecretTypes:     api.EventSecretTypes,
+		BlueprintID:          api.BlueprintID,
+		TenantVars:           api.TenantVars,
+		PayloadFormat:        format,
+		HeaderNames:          api.HeaderNames,
+		AuthType:             authType,
+		RetryPolicy:          api.RetryPolicy,
 	}
 }
 
@@ -95,19 +121,31 @@ func transformDelivery(api webhookDeliveryAPIResponse) WebhookDelivery {
 		ResponseTimeMs:     api.ResponseTimeMs,
 		ErrorMessage:       api.ErrorMessage,
 		ErrorCode:          api.ErrorCode,
-		NextRetryAt:        api.NextRetryAt,
-		CreatedAt:          api.CreatedAt,
-		DeliveredAt:        api.DeliveredAt,
+		NextRetryAt:        api.NextRetryAt.ptr(),
+		CreatedAt:          api.CreatedAt.Time,
+		DeliveredAt:        api.DeliveredAt.ptr(),
 	}
 }
 
 // Create creates a new webhook endpoint.
 func (s *WebhooksService) Create(ctx context.Context, req CreateWebhookRequest) (*WebhookCreatedResponse, error) {
 	if req.URL == "" || !strings.HasPrefix(req.URL, "https://") {
-		return nil, errors.New("webhook URL must be HTTPS")
+		return nil, invalidParamError("url", "webhook URL must be HTTPS")
 	}
 	if len(req.Events) == 0 {
-		return nil, errors.New("at least one event type is required")
+		return nil, invalidParamError("events", "at least one event type is required")
+	}
+	if err := validateEventTypes(req.Events, s.client.AllowUnknownEventTypes); err != nil {
+		return nil, err
+	}
+	if err := validatePayloadFormat(req.PayloadFormat); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookAuth(req.Headers, req.Auth); err != nil {
+		return nil, err
+	}
+	if err := req.RetryPolicy.validate(); err != nil {
+		return nil, err
 	}
 
 	var apiResp webhookAPIResponse
@@ -139,7 +177,7 @@ func (s *WebhooksService) List(ctx context.Context) ([]Webhook, error) {
 // Get retrieves a specific webhook by ID.
 func (s *WebhooksService) Get(ctx context.Context, webhookID string) (*Webhook, error) {
 	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
-		return nil, errors.New("invalid webhook ID format")
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
 	}
 
 	var apiResp webhookAPIResponse
@@ -154,11 +192,25 @@ func (s *WebhooksService) Get(ctx context.Context, webhookID string) (*Webhook,
 // Update updates a webhook configuration.
 func (s *WebhooksService) Update(ctx context.Context, webhookID string, req UpdateWebhookRequest) (*Webhook, error) {
 	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
-		return nil, errors.New("invalid webhook ID format")
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
 	}
 
 	if req.URL != nil && !strings.HasPrefix(*req.URL, "https://") {
-		return nil, errors.New("webhook URL must be HTTPS")
+		return nil, invalidParamError("url", "webhook URL must be HTTPS")
+	}
+	if err := validateEventTypes(req.Events, s.client.AllowUnknownEventTypes); err != nil {
+		return nil, err
+	}
+	if req.PayloadFormat != nil {
+		if err := validatePayloadFormat(*req.PayloadFormat); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateWebhookAuth(req.Headers, req.Auth); err != nil {
+		return nil, err
+	}
+	if err := req.RetryPolicy.validate(); err != nil {
+		return nil, err
 	}
 
 	var apiResp webhookAPIResponse
@@ -173,16 +225,72 @@ func (s *WebhooksService) Update(ctx context.Context, webhookID string, req Upda
 // Delete removes a webhook.
 func (s *WebhooksService) Delete(ctx context.Context, webhookID string) error {
 	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
-		return errors.New("invalid webhook ID format")
+		return invalidParamError("webhook_id", "invalid webhook ID format")
 	}
 
 	return s.client.request(ctx, "DELETE", "/webhooks/"+webhookID, nil, nil)
 }
 
+// BulkDelete removes up to MaxWebhookBulkSize webhooks in one call.
+func (s *WebhooksService) BulkDelete(ctx context.Context, webhookIDs []string) (*WebhookBulkResponse, error) {
+	if len(webhookIDs) == 0 {
+		return nil, invalidParamError("ids", "at least one webhook ID is required")
+	}
+	if len(webhookIDs) > MaxWebhookBulkSize {
+		return nil, invalidParamError("ids", fmt.Sprintf("cannot delete more than %d webhooks at once", MaxWebhookBulkSize))
+	}
+	for _, id := range webhookIDs {
+		if id == "" || !strings.HasPrefix(id, "whk_") {
+			return nil, invalidParamError("ids", "invalid webhook ID format: "+id)
+		}
+	}
+
+	body := map[string]interface{}{"ids": webhookIDs}
+	var resp WebhookBulkResponse
+	if err := s.client.request(ctx, "POST", "/webhooks/bulk-delete", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// BulkUpdate applies up to MaxWebhookBulkSize updates in one call.
+func (s *WebhooksService) BulkUpdate(ctx context.Context, patches []WebhookPatch) (*WebhookBulkResponse, error) {
+	if len(patches) == 0 {
+		return nil, invalidParamError("webhooks", "at least one webhook patch is required")
+	}
+	if len(patches) > MaxWebhookBulkSize {
+		return nil, invalidParamError("webhooks", fmt.Sprintf("cannot update more than %d webhooks at once", MaxWebhookBulkSize))
+	}
+	for _, p := range patches {
+		if p.ID == "" || !strings.HasPrefix(p.ID, "whk_") {
+			return nil, invalidParamError("webhooks", "invalid webhook ID format: "+p.ID)
+		}
+		if p.URL != nil && !strings.HasPrefix(*p.URL, "https://") {
+			return nil, invalidParamError("url", "webhook URL must be HTTPS")
+		}
+		if err := validateEventTypes(p.Events, s.client.AllowUnknownEventTypes); err != nil {
+			return nil, err
+		}
+		if err := validateWebhookAuth(p.Headers, p.Auth); err != nil {
+			return nil, err
+		}
+		if err := p.RetryPolicy.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	body := map[string]interface{}{"webhooks": patches}
+	var resp WebhookBulkResponse
+	if err := s.client.request(ctx, "PATCH", "/webhooks/bulk", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // Test sends a test event to a webhook endpoint.
 func (s *WebhooksService) Test(ctx context.Context, webhookID string) (*WebhookTestResult, error) {
 	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
-		return nil, errors.New("invalid webhook ID format")
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
 	}
 
 	var result WebhookTestResult
@@ -196,7 +304,7 @@ func (s *WebhooksService) Test(ctx context.Context, webhookID string) (*WebhookT
 // RotateSecret rotates the webhook signing secret.
 func (s *WebhooksService) RotateSecret(ctx context.Context, webhookID string) (*WebhookSecretRotation, error) {
 	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
-		return nil, errors.New("invalid webhook ID format")
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
 	}
 
 	// Raw response with snake_case
@@ -220,9 +328,12 @@ func (s *WebhooksService) RotateSecret(ctx context.Context, webhookID string) (*
 }
 
 // GetDeliveries retrieves delivery history for a webhook.
+//
+// Deprecated: Use ListDeliveries for filtering and pagination, or
+// IterDeliveries to page through all deliveries.
 func (s *WebhooksService) GetDeliveries(ctx context.Context, webhookID string) ([]WebhookDelivery, error) {
 	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
-		return nil, errors.New("invalid webhook ID format")
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
 	}
 
 	var apiResp []webhookDeliveryAPIResponse
@@ -237,19 +348,182 @@ func (s *WebhooksService) GetDeliveries(ctx context.Context, webhookID string) (
 	return deliveries, nil
 }
 
+// ListDeliveries retrieves a page of delivery history for a webhook.
+func (s *WebhooksService) ListDeliveries(ctx context.Context, webhookID string, opts *DeliveryListOptions) (*DeliveryListResponse, error) {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+
+	params := make(map[string]string)
+	if opts != nil {
+		if opts.Limit > 0 {
+			params["limit"] = strconv.Itoa(opts.Limit)
+		}
+		params["cursor"] = opts.Cursor
+		params["status"] = string(opts.Status)
+		params["event_type"] = opts.EventType
+		params["since"] = opts.Since
+		params["until"] = opts.Until
+		if opts.ResponseStatusCode > 0 {
+			params["response_status_code"] = strconv.Itoa(opts.ResponseStatusCode)
+		}
+		params["event_id"] = opts.EventID
+	}
+
+	var rawResp struct {
+		Data       []webhookDeliveryAPIResponse `json:"data"`
+		NextCursor string                       `json:"next_cursor"`
+		HasMore    bool                         `json:"has_more"`
+	}
+
+	path := "/webhooks/" + webhookID + "/deliveries" + buildQueryString(params)
+	if err := s.client.request(ctx, "GET", path, nil, &rawResp); err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]WebhookDelivery, len(rawResp.Data))
+	for i, api := range rawResp.Data {
+		deliveries[i] = transformDelivery(api)
+	}
+	return &DeliveryListResponse{
+		Data:       deliveries,
+		NextCursor: rawResp.NextCursor,
+		HasMore:    rawResp.HasMore,
+	}, nil
+}
+
+// DeliveryIterator pages through webhook deliveries.
+//
+// Example:
+//
+//	it := client.WebhooksService.IterDeliveries(ctx, "whk_xxx", nil)
+//	for it.Next() {
+//	    fmt.Println(it.Delivery().ID)
+//	}
+//	if err := it.Err(); err != nil {
+//	    log.Fatal(err)
+//	}
+type DeliveryIterator struct {
+	*Pager[WebhookDelivery]
+}
+
+// IterDeliveries returns an iterator over all deliveries matching opts,
+// fetching pages as needed.
+func (s *WebhooksService) IterDeliveries(ctx context.Context, webhookID string, opts *DeliveryListOptions) *DeliveryIterator {
+	return &DeliveryIterator{NewPager(ctx, s.deliveryPages(webhookID, opts))}
+}
+
+// Delivery returns the current delivery.
+func (it *DeliveryIterator) Delivery() WebhookDelivery {
+	return it.Current()
+}
+
+// ListAllDeliveries returns every delivery matching opts as an Iter,
+// fetching pages as needed.
+func (s *WebhooksService) ListAllDeliveries(ctx context.Context, webhookID string, opts *DeliveryListOptions) Iter[WebhookDelivery] {
+	return NewPager(ctx, s.deliveryPages(webhookID, opts)).Iter()
+}
+
+func (s *WebhooksService) deliveryPages(webhookID string, opts *DeliveryListOptions) PageFunc[WebhookDelivery] {
+	var o DeliveryListOptions
+	if opts != nil {
+		o = *opts
+	}
+	return func(ctx context.Context, cursor string) (*Page[WebhookDelivery], error) {
+		if cursor != "" {
+			o.Cursor = cursor
+		}
+		resp, err := s.ListDeliveries(ctx, webhookID, &o)
+		if err != nil {
+			return nil, err
+		}
+		page := &Page[WebhookDelivery]{Items: resp.Data}
+		if resp.HasMore {
+			page.NextCursor = resp.NextCursor
+		}
+		return page, nil
+	}
+}
+
+// SearchDeliveries returns every delivery attempt of an event across all of
+// the account's webhooks, oldest first, to trace where a single event went.
+//
+// Example:
+//
+//	deliveries, err := client.WebhooksService.SearchDeliveries(ctx, "evt_xxx")
+//	for _, d := range deliveries {
+//	    fmt.Println(d.WebhookID, d.AttemptNumber, d.Status, d.ErrorMessage)
+//	}
+func (s *WebhooksService) SearchDeliveries(ctx context.Context, eventID string) ([]WebhookDelivery, error) {
+	if eventID == "" {
+		return nil, invalidParamError("event_id", "event ID is required")
+	}
+
+	var rawResp struct {
+		Data []webhookDeliveryAPIResponse `json:"data"`
+	}
+	path := "/webhooks/deliveries/search" + buildQueryString(map[string]string{"event_id": eventID})
+	if err := s.client.request(ctx, "GET", path, nil, &rawResp); err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]WebhookDelivery, len(rawResp.Data))
+	for i, api := range rawResp.Data {
+		deliveries[i] = transformDelivery(api)
+	}
+	return deliveries, nil
+}
+
 // RetryDelivery retries a failed delivery.
 func (s *WebhooksService) RetryDelivery(ctx context.Context, webhookID, deliveryID string) error {
 	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
-		return errors.New("invalid webhook ID format")
+		return invalidParamError("webhook_id", "invalid webhook ID format")
 	}
 	if deliveryID == "" || !strings.HasPrefix(deliveryID, "del_") {
-		return errors.New("invalid delivery ID format")
+		return invalidParamError("delivery_id", "invalid delivery ID format")
 	}
 
 	path := fmt.Sprintf("/webhooks/%s/deliveries/%s/retry", webhookID, deliveryID)
 	return s.client.request(ctx, "POST", path, nil, nil)
 }
 
+// GetDeliveryTrace retrieves the HTTP trace of a delivery attempt. Pass
+// attempt 0 for the most recent attempt. Traces exist only for attempts made
+// while tracing was enabled on the webhook.
+func (s *WebhooksService) GetDeliveryTrace(ctx context.Context, webhookID, deliveryID string, attempt int) (*DeliveryTrace, error) {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+	if deliveryID == "" || !strings.HasPrefix(deliveryID, "del_") {
+		return nil, invalidParamError("delivery_id", "invalid delivery ID format")
+	}
+
+	path := fmt.Sprintf("/webhooks/%s/deliveries/%s/trace", webhookID, deliveryID)
+	if attempt > 0 {
+		path += "?attempt=" + strconv.Itoa(attempt)
+	}
+
+	var trace DeliveryTrace
+	if err := s.client.request(ctx, "GET", path, nil, &trace); err != nil {
+		return nil, err
+	}
+	return &trace, nil
+}
+
+// Backlog reports how many events are queued for a webhook and how old the
+// oldest one is, so consumers can alert before the circuit opens.
+func (s *WebhooksService) Backlog(ctx context.Context, webhookID string) (*WebhookBacklog, error) {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+
+	var backlog WebhookBacklog
+	if err := s.client.request(ctx, "GET", "/webhooks/"+webhookID+"/backlog", nil, &backlog); err != nil {
+		return nil, err
+	}
+	return &backlog, nil
+}
+
 // ListEventTypes returns available event types.
 func (s *WebhooksService) ListEventTypes(ctx context.Context) ([]string, error) {
 	var resp struct {
@@ -268,3 +542,107 @@ func (s *WebhooksService) ListEventTypes(ctx context.Context) ([]string, error)
 	}
 	return eventTypes, nil
 }
+
+// GetChaosConfig retrieves the sandbox fault injection settings for a webhook.
+func (s *WebhooksService) GetChaosConfig(ctx context.Context, webhookID string) (*WebhookChaosConfig, error) {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+
+	var config WebhookChaosConfig
+	if err := s.client.request(ctx, "GET", "/webhooks/"+webhookID+"/chaos", nil, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// UpdateChaosConfig enables or adjusts sandbox fault injection for a webhook,
+// so consumers can exercise their retry and deduplication handling.
+func (s *WebhooksService) UpdateChaosConfig(ctx context.Context, webhookID string, config WebhookChaosConfig) (*WebhookChaosConfig, error) {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+	if config.FailureRate < 0 || config.FailureRate > 1 {
+		return nil, invalidParamError("failure_rate", "failure rate must be between 0 and 1")
+	}
+	if config.DuplicateRate < 0 || config.DuplicateRate > 1 {
+		return nil, invalidParamError("duplicate_rate", "duplicate rate must be between 0 and 1")
+	}
+	if config.MinDelayMs < 0 || config.MaxDelayMs < 0 {
+		return nil, invalidParamError("min_delay_ms", "delays must not be negative")
+	}
+	if config.MaxDelayMs > 0 && config.MaxDelayMs < config.MinDelayMs {
+		return nil, invalidParamError("max_delay_ms", "max delay must not be less than min delay")
+	}
+
+	var resp WebhookChaosConfig
+	if err := s.client.request(ctx, "PUT", "/webhooks/"+webhookID+"/chaos", config, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DisableChaos turns off sandbox fault injection for a webhook.
+func (s *WebhooksService) DisableChaos(ctx context.Context, webhookID string) error {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+
+	return s.client.request(ctx, "DELETE", "/webhooks/"+webhookID+"/chaos", nil, nil)
+}
+
+// StartMigration begins moving a webhook to a new URL. During the shadow
+// window every delivery is sent to both URLs, and the webhook cuts over
+// automatically once the new URL meets the success rate threshold.
+func (s *WebhooksService) StartMigration(ctx context.Context, webhookID string, req StartWebhookMigrationRequest) (*WebhookMigration, error) {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+	if req.NewURL == "" || !strings.HasPrefix(req.NewURL, "https://") {
+		return nil, invalidParamError("new_url", "webhook URL must be HTTPS")
+	}
+	if req.AutoCutoverThreshold < 0 || req.AutoCutoverThreshold > 100 {
+		return nil, invalidParamError("auto_cutover_threshold", "auto cutover threshold must be between 0 and 100")
+	}
+
+	var migration WebhookMigration
+	if err := s.client.request(ctx, "POST", "/webhooks/"+webhookID+"/migration", req, &migration); err != nil {
+		return nil, err
+	}
+	return &migration, nil
+}
+
+// GetMigration retrieves the current migration and per-endpoint comparison stats.
+func (s *WebhooksService) GetMigration(ctx context.Context, webhookID string) (*WebhookMigration, error) {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+
+	var migration WebhookMigration
+	if err := s.client.request(ctx, "GET", "/webhooks/"+webhookID+"/migration", nil, &migration); err != nil {
+		return nil, err
+	}
+	return &migration, nil
+}
+
+// CutOver immediately switches a migrating webhook to its new URL.
+func (s *WebhooksService) CutOver(ctx context.Context, webhookID string) (*WebhookMigration, error) {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return nil, invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+
+	var migration WebhookMigration
+	if err := s.client.request(ctx, "POST", "/webhooks/"+webhookID+"/migration/cutover", nil, &migration); err != nil {
+		return nil, err
+	}
+	return &migration, nil
+}
+
+// CancelMigration stops shadowing and keeps the webhook on its current URL.
+func (s *WebhooksService) CancelMigration(ctx context.Context, webhookID string) error {
+	if webhookID == "" || !strings.HasPrefix(webhookID, "whk_") {
+		return invalidParamError("webhook_id", "invalid webhook ID format")
+	}
+
+	return s.client.request(ctx, "DELETE", "/webhooks/"+webhookID+"/migration", nil, nil)
+}
@@ -0,0 +1,150 @@
+package sendly
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWebhooksService_IterDeliveries(t *testing.T) {
+	var cursors []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks/whk_123/deliveries" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("status") != "failed" {
+			t.Errorf("expected status to be 'failed', got '%s'", r.URL.Query().Get("status"))
+		}
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+
+		w.Header().Set("Content-Type", "application/json")
+		if cursor == "" {
+			w.Write([]byte(`{"data":[{"id":"del_1","status":"failed"},{"id":"del_2","status":"failed"}],"next_cursor":"c2","has_more":true}`))
+			return
+		}
+		w.Write([]byte(`{"data":[{"id":"del_3","status":"failed"}],"has_more":false}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	it := client.WebhooksService.IterDeliveries(context.Background(), "whk_123", &DeliveryListOptions{
+		Status: DeliveryStatusFailed,
+	})
+
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Delivery().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ids) != 3 || ids[2] != "del_3" {
+		t.Errorf("expected 3 deliveries ending in del_3, got %v", ids)
+	}
+	if len(cursors) != 2 || cursors[1] != "c2" {
+		t.Errorf("expected second request to use cursor 'c2', got %v", cursors)
+	}
+}
+
+func TestWebhooksService_ListDeliveries_InvalidID(t *testing.T) {
+	client := NewClient("test-api-key")
+	_, err := client.WebhooksService.ListDeliveries(context.Background(), "bad", nil)
+	if err == nil {
+		t.Error("expected error for invalid webhook ID")
+	}
+}
+
+func TestWebhooksService_BulkUpdate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/webhooks/bulk" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Webhooks []map[string]interface{} `json:"webhooks"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body.Webhooks) != 2 || body.Webhooks[0]["id"] != "whk_1" || body.Webhooks[0]["is_active"] != false {
+			t.Errorf("unexpected body: %v", body.Webhooks)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results":[{"id":"whk_1","success":true},{"id":"whk_2","success":false,"error":{"code":"NOT_FOUND","message":"webhook not found"}}],"succeeded":1,"failed":1}`))
+	}))
+	defer server.Close()
+
+	inactive := false
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	resp, err := client.WebhooksService.BulkUpdate(context.Background(), []WebhookPatch{
+		{ID: "whk_1", UpdateWebhookRequest: UpdateWebhookRequest{IsActive: &inactive}},
+		{ID: "whk_2", UpdateWebhookRequest: UpdateWebhookRequest{IsActive: &inactive}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Failed != 1 || resp.Results[1].Error == nil || resp.Results[1].Error.Code != "NOT_FOUND" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
+
+func TestWebhooksService_BulkDelete_Validation(t *testing.T) {
+	client := NewClient("test-api-key")
+	ctx := context.Background()
+
+	if _, err := client.WebhooksService.BulkDelete(ctx, nil); !IsValidationError(err) {
+		t.Errorf("expected validation error for empty IDs, got %v", err)
+	}
+	if _, err := client.WebhooksService.BulkDelete(ctx, []string{"whk_1", "bad"}); !IsValidationError(err) {
+		t.Errorf("expected validation error for invalid ID, got %v", err)
+	}
+}
+
+func TestWebhooksService_ListDeliveries_Filters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("status") != "failed" || q.Get("response_status_code") != "503" || q.Get("since") != "2025-01-01T00:00:00Z" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"id":"del_1","webhook_id":"whk_1","status":"failed","response_status_code":503}],"has_more":false}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	resp, err := client.WebhooksService.ListDeliveries(context.Background(), "whk_1", &DeliveryListOptions{
+		Status:             DeliveryStatusFailed,
+		ResponseStatusCode: 503,
+		Since:              "2025-01-01T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Data) != 1 || *resp.Data[0].ResponseStatusCode != 503 {
+		t.Errorf("unexpected deliveries: %+v", resp.Data)
+	}
+}
+
+func TestWebhooksService_SearchDeliveries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks/deliveries/search" || r.URL.Query().Get("event_id") != "evt_1" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"id":"del_1","webhook_id":"whk_1","event_id":"evt_1","status":"failed"},{"id":"del_2","webhook_id":"whk_2","event_id":"evt_1","status":"delivered"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	deliveries, err := client.WebhooksService.SearchDeliveries(context.Background(), "evt_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(deliveries) != 2 || deliveries[1].WebhookID != "whk_2" || deliveries[1].Status != DeliveryStatusDelivered {
+		t.Errorf("unexpected deliveries: %+v", deliveries)
+	}
+
+	if _, err := client.WebhooksService.SearchDeliveries(context.Background(), ""); !IsValidationError(err) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}